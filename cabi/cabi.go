@@ -0,0 +1,170 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command cabi builds the SDK as a C shared library for the hosts that can not use
+// gomobile or wasm:
+//
+//	go build -buildmode=c-shared -tags sqlite_fts5 -o libopenim_sdk.so ./cabi
+//
+// The library drives the instance of InitSDK, the same as the gomobile bindings. The
+// types and the memory ownership rules of its functions are documented in openim_sdk.h.
+package main
+
+/*
+#include <stdlib.h>
+#include "openim_sdk.h"
+*/
+import "C"
+
+import (
+	"encoding/json"
+	"sync"
+	"unsafe"
+
+	"github.com/openimsdk/openim-sdk-core/v3/client"
+	"github.com/openimsdk/openim-sdk-core/v3/ffi"
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	pb "github.com/openimsdk/openim-sdk-core/v3/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+func main() {}
+
+var (
+	mu         sync.RWMutex
+	dispatcher *ffi.Dispatcher
+)
+
+// result is the JSON returned by the calls, data is the JSON the call succeeded with.
+type result struct {
+	ErrCode int32           `json:"errCode"`
+	ErrMsg  string          `json:"errMsg"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// cString returns a copy of r in C memory, released by openim_free.
+func (r *result) cString() *C.char {
+	if r.Data == nil {
+		r.Data = json.RawMessage("null")
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		data, _ = json.Marshal(&result{ErrCode: sdkerrs.SdkInternalError, ErrMsg: err.Error(), Data: json.RawMessage("null")})
+	}
+	return C.CString(string(data))
+}
+
+// openim_set_event_callback registers the callback of the listener events, user_data is
+// passed back to every call. A NULL callback drops the events.
+//
+//export openim_set_event_callback
+func openim_set_event_callback(callback C.openim_event_callback, userData unsafe.Pointer) {
+	setEventCallback(callback, userData)
+}
+
+// openim_init_sdk initializes the SDK with the JSON IMConfig and registers every listener,
+// it returns 1 on success and 0 when the config is invalid.
+//
+//export openim_init_sdk
+func openim_init_sdk(operationID, config *C.char) C.int32_t {
+	mu.Lock()
+	defer mu.Unlock()
+	if !open_im_sdk.InitSDK(listener, C.GoString(operationID), C.GoString(config)) {
+		return 0
+	}
+	open_im_sdk.SetConversationListener(listener)
+	open_im_sdk.SetAdvancedMsgListener(listener)
+	open_im_sdk.SetGroupListener(listener)
+	open_im_sdk.SetFriendListener(listener)
+	open_im_sdk.SetUserListener(listener)
+	open_im_sdk.SetCustomBusinessListener(listener)
+	open_im_sdk.SetMessageKvInfoListener(listener)
+	dispatcher = ffi.Attach(client.Attach(open_im_sdk.UserForSDK))
+	return 1
+}
+
+//export openim_uninit_sdk
+func openim_uninit_sdk(operationID *C.char) {
+	mu.Lock()
+	defer mu.Unlock()
+	open_im_sdk.UnInitSDK(C.GoString(operationID))
+	dispatcher = nil
+}
+
+// openim_login logs user_id in and blocks until the login finishes, the connection is
+// reported by the OnConnect events.
+//
+//export openim_login
+func openim_login(operationID, userID, token *C.char) *C.char {
+	mu.RLock()
+	defer mu.RUnlock()
+	cb := newCallback()
+	open_im_sdk.Login(cb, C.GoString(operationID), C.GoString(userID), C.GoString(token))
+	return cb.wait().cString()
+}
+
+//export openim_logout
+func openim_logout(operationID *C.char) *C.char {
+	mu.RLock()
+	defer mu.RUnlock()
+	cb := newCallback()
+	open_im_sdk.Logout(cb, C.GoString(operationID))
+	return cb.wait().cString()
+}
+
+// openim_call calls the function func_name of the SDK, named as in open_im_sdk, with
+// args, a JSON array of its arguments after operationID. It blocks until the function
+// returns and reports OnProgress as an event.
+//
+//export openim_call
+func openim_call(operationID, funcName, args *C.char) *C.char {
+	mu.RLock()
+	defer mu.RUnlock()
+	return callJSON(C.GoString(operationID), C.GoString(funcName), C.GoString(args)).cString()
+}
+
+// openim_call_proto runs an encoded FfiRequest and returns the encoded FfiResult, its
+// length is stored in out_len. The InitSDK request is handled by openim_init_sdk.
+//
+//export openim_call_proto
+func openim_call_proto(data unsafe.Pointer, length C.int32_t, outLen *C.int32_t) unsafe.Pointer {
+	mu.RLock()
+	d := dispatcher
+	mu.RUnlock()
+	var out []byte
+	if d == nil {
+		out = notInitialized(C.GoBytes(data, C.int(length)))
+	} else {
+		out = d.CallBytes(C.GoBytes(data, C.int(length)))
+	}
+	*outLen = C.int32_t(len(out))
+	return C.CBytes(out)
+}
+
+// notInitialized returns the encoded FfiResult of a request sent before openim_init_sdk.
+func notInitialized(data []byte) []byte {
+	var req pb.FfiRequest
+	_ = proto.Unmarshal(data, &req)
+	out, _ := proto.Marshal(&pb.FfiResult{FuncName: req.FuncName, HandleID: req.HandleID,
+		ErrCode: sdkerrs.ResourceLoadNotCompleteError, ErrMsg: "sdk not initialized, call openim_init_sdk first"})
+	return out
+}
+
+// openim_free releases a string or buffer returned by the library.
+//
+//export openim_free
+func openim_free(p unsafe.Pointer) {
+	C.free(p)
+}
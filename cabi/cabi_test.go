@@ -0,0 +1,101 @@
+//go:build !js
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/openimsdk/openim-sdk-core/v3/internal/interaction"
+)
+
+// newGateway returns a gateway answering every request of the SDK with an empty response,
+// its HTTP API answers 404.
+func newGateway(t *testing.T) *httptest.Server {
+	encoder, err := interaction.NewEncoder("gob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	compressor, err := interaction.NewCompressor("gzip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !websocket.IsWebSocketUpgrade(r) {
+			http.NotFound(w, r)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			messageType, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if messageType != websocket.BinaryMessage {
+				continue
+			}
+			if compressor.IsCompressed(message) {
+				if message, err = compressor.DeCompress(message); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+			var req interaction.GeneralWsReq
+			if err := encoder.Decode(message, &req); err != nil {
+				t.Error(err)
+				return
+			}
+			data, err := encoder.Encode(interaction.GeneralWsResp{ReqIdentifier: req.ReqIdentifier, MsgIncr: req.MsgIncr, OperationID: req.OperationID})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if data, err = compressor.Compress(data); err != nil {
+				t.Error(err)
+				return
+			}
+			if err := conn.WriteMessage(websocket.BinaryMessage, data); err != nil {
+				return
+			}
+		}
+	}))
+}
+
+func TestHarness(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the shared library")
+	}
+	if _, err := exec.LookPath("gcc"); err != nil {
+		t.Skip("gcc not found")
+	}
+	dir := t.TempDir()
+	run := func(name string, args ...string) string {
+		out, err := exec.Command(name, args...).CombinedOutput()
+		if err != nil {
+			t.Fatalf("%s %s: %v\n%s", name, strings.Join(args, " "), err, out)
+		}
+		return string(out)
+	}
+	run("go", "build", "-buildmode=c-shared", "-o", filepath.Join(dir, "libopenim_sdk.so"), ".")
+	harness := filepath.Join(dir, "harness")
+	run("gcc", "-o", harness, filepath.Join("testdata", "harness.c"), "-I", dir, "-I", ".", "-L", dir, "-lopenim_sdk", "-lpthread", "-Wl,-rpath,"+dir)
+
+	gateway := newGateway(t)
+	defer gateway.Close()
+	out := run(harness, gateway.URL, "ws"+strings.TrimPrefix(gateway.URL, "http"), dir)
+	for _, want := range []string{"event OnConnecting", "event OnConnectSuccess", "ok\n"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("harness output misses %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk"
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/tools/errs"
+)
+
+// callback waits for the result of an asynchronous function of open_im_sdk.
+type callback struct {
+	done chan *result
+}
+
+var _ open_im_sdk_callback.SendMsgCallBack = (*callback)(nil)
+
+func newCallback() *callback {
+	return &callback{done: make(chan *result, 1)}
+}
+
+func (c *callback) OnError(errCode int32, errMsg string) {
+	c.done <- &result{ErrCode: errCode, ErrMsg: errMsg}
+}
+
+func (c *callback) OnSuccess(data string) {
+	c.done <- &result{Data: jsonData(data)}
+}
+
+func (c *callback) OnProgress(progress int) {
+	emit("OnProgress", 0, "", utils.IntToString(progress))
+}
+
+func (c *callback) wait() *result {
+	return <-c.done
+}
+
+var (
+	baseType            = reflect.TypeOf((*open_im_sdk_callback.Base)(nil)).Elem()
+	sendMsgCallBackType = reflect.TypeOf((*open_im_sdk_callback.SendMsgCallBack)(nil)).Elem()
+)
+
+// callJSON calls the method funcName of the default instance. A method taking a callback
+// is waited for, the return values of the others are the data of the result.
+func callJSON(operationID, funcName, args string) (res *result) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = errorResult(sdkerrs.ErrSdkInternal.WrapMsg(fmt.Sprintf("recover: %+v", r)))
+		}
+	}()
+	method := reflect.ValueOf(open_im_sdk.Default()).MethodByName(funcName)
+	if !method.IsValid() {
+		return errorResult(sdkerrs.ErrArgs.WrapMsg("unknown function", "funcName", funcName))
+	}
+	var params []json.RawMessage
+	if args != "" {
+		if err := json.Unmarshal([]byte(args), &params); err != nil {
+			return errorResult(sdkerrs.ErrArgs.WrapMsg("args is not a JSON array: " + err.Error()))
+		}
+	}
+	typ := method.Type()
+	var (
+		in []reflect.Value
+		cb *callback
+	)
+	if typ.NumIn() > 0 && (typ.In(0) == baseType || typ.In(0) == sendMsgCallBackType) {
+		cb = newCallback()
+		in = append(in, reflect.ValueOf(cb))
+	}
+	if typ.NumIn() <= len(in) || typ.In(len(in)).Kind() != reflect.String || typ.IsVariadic() {
+		return errorResult(sdkerrs.ErrArgs.WrapMsg("function can not be called through openim_call", "funcName", funcName))
+	}
+	in = append(in, reflect.ValueOf(operationID))
+	if len(params) != typ.NumIn()-len(in) {
+		return errorResult(sdkerrs.ErrArgs.WrapMsg(fmt.Sprintf("function takes %d args, got %d", typ.NumIn()-len(in), len(params)), "funcName", funcName))
+	}
+	for _, param := range params {
+		arg, err := decodeArg(param, typ.In(len(in)))
+		if err != nil {
+			return errorResult(sdkerrs.ErrArgs.WrapMsg(fmt.Sprintf("arg %d is invalid: %s", len(in)-1, err), "funcName", funcName))
+		}
+		in = append(in, arg)
+	}
+	out := method.Call(in)
+	if cb != nil {
+		return cb.wait()
+	}
+	switch len(out) {
+	case 0:
+		return &result{}
+	case 1:
+		if out[0].Kind() == reflect.String {
+			return &result{Data: jsonData(out[0].String())}
+		}
+		data, err := json.Marshal(out[0].Interface())
+		if err != nil {
+			return errorResult(sdkerrs.ErrSdkInternal.WrapMsg("marshal result: " + err.Error()))
+		}
+		return &result{Data: data}
+	default:
+		values := make([]any, 0, len(out))
+		for _, v := range out {
+			values = append(values, v.Interface())
+		}
+		data, err := json.Marshal(values)
+		if err != nil {
+			return errorResult(sdkerrs.ErrSdkInternal.WrapMsg("marshal result: " + err.Error()))
+		}
+		return &result{Data: data}
+	}
+}
+
+// decodeArg decodes param as an argument of type typ. The string arguments of open_im_sdk
+// are often JSON themselves, a JSON object or array is passed to them as its text.
+func decodeArg(param json.RawMessage, typ reflect.Type) (reflect.Value, error) {
+	arg := reflect.New(typ)
+	if typ.Kind() == reflect.String && len(param) > 0 && param[0] != '"' {
+		arg.Elem().SetString(string(param))
+		return arg.Elem(), nil
+	}
+	if err := json.Unmarshal(param, arg.Interface()); err != nil {
+		return reflect.Value{}, err
+	}
+	return arg.Elem(), nil
+}
+
+// jsonData returns data as JSON, quoted when it is not JSON already.
+func jsonData(data string) json.RawMessage {
+	if data == "" {
+		return nil
+	}
+	if json.Valid([]byte(data)) {
+		return json.RawMessage(data)
+	}
+	quoted, _ := json.Marshal(data)
+	return quoted
+}
+
+func errorResult(err error) *result {
+	res := &result{ErrCode: sdkerrs.UnknownCode, ErrMsg: err.Error()}
+	if code, ok := errs.Unwrap(err).(errs.CodeError); ok {
+		res.ErrCode = int32(code.Code())
+	}
+	return res
+}
@@ -0,0 +1,329 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+/*
+#include <stdlib.h>
+#include "openim_sdk.h"
+
+static void openim_emit_event(openim_event_callback callback, const char *event, int32_t err_code, const char *err_msg, const char *data, void *user_data) {
+	callback(event, err_code, err_msg, data, user_data);
+}
+*/
+import "C"
+
+import (
+	"strconv"
+	"sync"
+	"unsafe"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+)
+
+var (
+	callbackMu       sync.RWMutex
+	eventCallback    C.openim_event_callback
+	eventCallbackArg unsafe.Pointer
+)
+
+func setEventCallback(callback C.openim_event_callback, userData unsafe.Pointer) {
+	callbackMu.Lock()
+	defer callbackMu.Unlock()
+	eventCallback = callback
+	eventCallbackArg = userData
+}
+
+// emit calls the registered callback with the event, the C strings are released once it
+// returns.
+func emit(event string, errCode int32, errMsg string, data string) {
+	callbackMu.RLock()
+	defer callbackMu.RUnlock()
+	if eventCallback == nil {
+		return
+	}
+	cEvent, cErrMsg, cData := C.CString(event), C.CString(errMsg), C.CString(data)
+	defer func() {
+		C.free(unsafe.Pointer(cEvent))
+		C.free(unsafe.Pointer(cErrMsg))
+		C.free(unsafe.Pointer(cData))
+	}()
+	C.openim_emit_event(eventCallback, cEvent, C.int32_t(errCode), cErrMsg, cData, eventCallbackArg)
+}
+
+// eventListener forwards every listener of the SDK to the registered callback, the event
+// is the name of the listener method.
+type eventListener struct{}
+
+var listener = eventListener{}
+
+var (
+	_ open_im_sdk_callback.OnConnListener           = listener
+	_ open_im_sdk_callback.OnConversationListener   = listener
+	_ open_im_sdk_callback.OnAdvancedMsgListener    = listener
+	_ open_im_sdk_callback.OnGroupListener          = listener
+	_ open_im_sdk_callback.OnFriendshipListener     = listener
+	_ open_im_sdk_callback.OnUserListener           = listener
+	_ open_im_sdk_callback.OnCustomBusinessListener = listener
+	_ open_im_sdk_callback.OnMessageKvInfoListener  = listener
+)
+
+func (eventListener) OnConnecting() {
+	emit(utils.GetSelfFuncName(), 0, "", "")
+}
+
+func (eventListener) OnConnectSuccess() {
+	emit(utils.GetSelfFuncName(), 0, "", "")
+}
+
+func (eventListener) OnConnectFailed(errCode int32, errMsg string) {
+	emit(utils.GetSelfFuncName(), errCode, errMsg, "")
+}
+
+func (eventListener) OnKickedOffline() {
+	emit(utils.GetSelfFuncName(), 0, "", "")
+}
+
+func (eventListener) OnUserTokenExpired() {
+	emit(utils.GetSelfFuncName(), 0, "", "")
+}
+
+func (eventListener) OnUserTokenInvalid(errMsg string) {
+	emit(utils.GetSelfFuncName(), 0, errMsg, "")
+}
+
+func (eventListener) OnReconnecting(attempt int32, delayMs int64) {
+	m := make(map[string]interface{})
+	m["attempt"] = attempt
+	m["delayMs"] = delayMs
+	emit(utils.GetSelfFuncName(), 0, "", utils.StructToJsonString(m))
+}
+
+func (eventListener) OnSyncServerStart(reinstalled bool) {
+	emit(utils.GetSelfFuncName(), 0, "", strconv.FormatBool(reinstalled))
+}
+
+func (eventListener) OnSyncServerFinish(reinstalled bool) {
+	emit(utils.GetSelfFuncName(), 0, "", strconv.FormatBool(reinstalled))
+}
+
+func (eventListener) OnSyncServerProgress(progress int) {
+	emit(utils.GetSelfFuncName(), 0, "", strconv.Itoa(progress))
+}
+
+func (eventListener) OnSyncServerFailed(reinstalled bool) {
+	emit(utils.GetSelfFuncName(), 0, "", strconv.FormatBool(reinstalled))
+}
+
+func (eventListener) OnNewConversation(conversationList string) {
+	emit(utils.GetSelfFuncName(), 0, "", conversationList)
+}
+
+func (eventListener) OnConversationChanged(conversationList string) {
+	emit(utils.GetSelfFuncName(), 0, "", conversationList)
+}
+
+func (eventListener) OnTotalUnreadMessageCountChanged(totalUnreadCount int32) {
+	emit(utils.GetSelfFuncName(), 0, "", strconv.FormatInt(int64(totalUnreadCount), 10))
+}
+
+func (eventListener) OnConversationUserInputStatusChanged(change string) {
+	emit(utils.GetSelfFuncName(), 0, "", change)
+}
+
+func (eventListener) OnUnreadMentionCountChanged(totalUnreadCount int32) {
+	emit(utils.GetSelfFuncName(), 0, "", strconv.FormatInt(int64(totalUnreadCount), 10))
+}
+
+func (eventListener) OnRecvNewMessage(message string) {
+	emit(utils.GetSelfFuncName(), 0, "", message)
+}
+
+func (eventListener) OnRecvC2CReadReceipt(msgReceiptList string) {
+	emit(utils.GetSelfFuncName(), 0, "", msgReceiptList)
+}
+
+func (eventListener) OnRecvGroupReadReceipt(groupMsgReceiptList string) {
+	emit(utils.GetSelfFuncName(), 0, "", groupMsgReceiptList)
+}
+
+func (eventListener) OnNewRecvMessageRevoked(messageRevoked string) {
+	emit(utils.GetSelfFuncName(), 0, "", messageRevoked)
+}
+
+func (eventListener) OnRecvOfflineNewMessage(message string) {
+	emit(utils.GetSelfFuncName(), 0, "", message)
+}
+
+func (eventListener) OnMsgDeleted(message string) {
+	emit(utils.GetSelfFuncName(), 0, "", message)
+}
+
+func (eventListener) OnRecvOnlineOnlyMessage(message string) {
+	emit(utils.GetSelfFuncName(), 0, "", message)
+}
+
+func (eventListener) OnMsgEdited(message string) {
+	emit(utils.GetSelfFuncName(), 0, "", message)
+}
+
+func (eventListener) OnRecvMessageExtensionsChanged(clientMsgID string, reactionExtensionList string) {
+	m := make(map[string]interface{})
+	m["clientMsgID"] = clientMsgID
+	m["reactionExtensionList"] = reactionExtensionList
+	emit(utils.GetSelfFuncName(), 0, "", utils.StructToJsonString(m))
+}
+
+func (eventListener) OnRecvMessageExtensionsDeleted(clientMsgID string, reactionExtensionKeyList string) {
+	m := make(map[string]interface{})
+	m["clientMsgID"] = clientMsgID
+	m["reactionExtensionKeyList"] = reactionExtensionKeyList
+	emit(utils.GetSelfFuncName(), 0, "", utils.StructToJsonString(m))
+}
+
+func (eventListener) OnRecvMessageExtensionsAdded(clientMsgID string, reactionExtensionList string) {
+	m := make(map[string]interface{})
+	m["clientMsgID"] = clientMsgID
+	m["reactionExtensionList"] = reactionExtensionList
+	emit(utils.GetSelfFuncName(), 0, "", utils.StructToJsonString(m))
+}
+
+func (eventListener) OnScheduledMessageSent(message string) {
+	emit(utils.GetSelfFuncName(), 0, "", message)
+}
+
+func (eventListener) OnScheduledMessageFailed(message string, errCode int32, errMsg string) {
+	emit(utils.GetSelfFuncName(), errCode, errMsg, message)
+}
+
+func (eventListener) OnOutboxMessageStatusChanged(message string, status int32, attempts int32) {
+	m := make(map[string]interface{})
+	m["message"] = message
+	m["status"] = status
+	m["attempts"] = attempts
+	emit(utils.GetSelfFuncName(), 0, "", utils.StructToJsonString(m))
+}
+
+func (eventListener) OnMsgPinChanged(pinChange string) {
+	emit(utils.GetSelfFuncName(), 0, "", pinChange)
+}
+
+func (eventListener) OnJoinedGroupAdded(groupInfo string) {
+	emit(utils.GetSelfFuncName(), 0, "", groupInfo)
+}
+
+func (eventListener) OnJoinedGroupDeleted(groupInfo string) {
+	emit(utils.GetSelfFuncName(), 0, "", groupInfo)
+}
+
+func (eventListener) OnGroupMemberAdded(groupMemberInfo string) {
+	emit(utils.GetSelfFuncName(), 0, "", groupMemberInfo)
+}
+
+func (eventListener) OnGroupMemberDeleted(groupMemberInfo string) {
+	emit(utils.GetSelfFuncName(), 0, "", groupMemberInfo)
+}
+
+func (eventListener) OnGroupApplicationAdded(groupApplication string) {
+	emit(utils.GetSelfFuncName(), 0, "", groupApplication)
+}
+
+func (eventListener) OnGroupApplicationDeleted(groupApplication string) {
+	emit(utils.GetSelfFuncName(), 0, "", groupApplication)
+}
+
+func (eventListener) OnGroupInfoChanged(groupInfo string) {
+	emit(utils.GetSelfFuncName(), 0, "", groupInfo)
+}
+
+func (eventListener) OnGroupDismissed(groupInfo string) {
+	emit(utils.GetSelfFuncName(), 0, "", groupInfo)
+}
+
+func (eventListener) OnGroupMemberInfoChanged(groupMemberInfo string) {
+	emit(utils.GetSelfFuncName(), 0, "", groupMemberInfo)
+}
+
+func (eventListener) OnGroupApplicationAccepted(groupApplication string) {
+	emit(utils.GetSelfFuncName(), 0, "", groupApplication)
+}
+
+func (eventListener) OnGroupApplicationRejected(groupApplication string) {
+	emit(utils.GetSelfFuncName(), 0, "", groupApplication)
+}
+
+func (eventListener) OnFriendApplicationAdded(friendApplication string) {
+	emit(utils.GetSelfFuncName(), 0, "", friendApplication)
+}
+
+func (eventListener) OnFriendApplicationDeleted(friendApplication string) {
+	emit(utils.GetSelfFuncName(), 0, "", friendApplication)
+}
+
+func (eventListener) OnFriendApplicationAccepted(friendApplication string) {
+	emit(utils.GetSelfFuncName(), 0, "", friendApplication)
+}
+
+func (eventListener) OnFriendApplicationRejected(friendApplication string) {
+	emit(utils.GetSelfFuncName(), 0, "", friendApplication)
+}
+
+func (eventListener) OnFriendAdded(friendInfo string) {
+	emit(utils.GetSelfFuncName(), 0, "", friendInfo)
+}
+
+func (eventListener) OnFriendDeleted(friendInfo string) {
+	emit(utils.GetSelfFuncName(), 0, "", friendInfo)
+}
+
+func (eventListener) OnFriendInfoChanged(friendInfo string) {
+	emit(utils.GetSelfFuncName(), 0, "", friendInfo)
+}
+
+func (eventListener) OnBlackAdded(blackInfo string) {
+	emit(utils.GetSelfFuncName(), 0, "", blackInfo)
+}
+
+func (eventListener) OnBlackDeleted(blackInfo string) {
+	emit(utils.GetSelfFuncName(), 0, "", blackInfo)
+}
+
+func (eventListener) OnSelfInfoUpdated(userInfo string) {
+	emit(utils.GetSelfFuncName(), 0, "", userInfo)
+}
+
+func (eventListener) OnUserStatusChanged(userOnlineStatus string) {
+	emit(utils.GetSelfFuncName(), 0, "", userOnlineStatus)
+}
+
+func (eventListener) OnUserCommandAdd(userCommand string) {
+	emit(utils.GetSelfFuncName(), 0, "", userCommand)
+}
+
+func (eventListener) OnUserCommandDelete(userCommand string) {
+	emit(utils.GetSelfFuncName(), 0, "", userCommand)
+}
+
+func (eventListener) OnUserCommandUpdate(userCommand string) {
+	emit(utils.GetSelfFuncName(), 0, "", userCommand)
+}
+
+func (eventListener) OnRecvCustomBusinessMessage(businessMessage string) {
+	emit(utils.GetSelfFuncName(), 0, "", businessMessage)
+}
+
+func (eventListener) OnMessageKvInfoChanged(messageChangedList string) {
+	emit(utils.GetSelfFuncName(), 0, "", messageChangedList)
+}
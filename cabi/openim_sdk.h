@@ -0,0 +1,38 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Types of the C ABI of libopenim_sdk, the functions are declared by the libopenim_sdk.h
+// generated next to the library.
+//
+// Memory ownership:
+//   - strings and buffers passed to the library are borrowed, the library copies them
+//     before the call returns.
+//   - strings and buffers returned by the library are owned by the caller and must be
+//     released with openim_free.
+//   - the arguments of openim_event_callback are owned by the library and only valid
+//     until the callback returns.
+
+#ifndef OPENIM_SDK_H
+#define OPENIM_SDK_H
+
+#include <stdint.h>
+
+// openim_event_callback receives every listener event of the SDK: event is the name of
+// the listener method, for example "OnConnectSuccess" or "OnRecvNewMessage", data is its
+// argument, a JSON object for the methods taking several arguments. err_code and err_msg
+// are set by the failure events. The callback is called from SDK threads, it must be
+// thread safe and return quickly.
+typedef void (*openim_event_callback)(const char *event, int32_t err_code, const char *err_msg, const char *data, void *user_data);
+
+#endif
@@ -0,0 +1,113 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// harness drives libopenim_sdk through its C ABI: usage harness <apiAddr> <wsAddr> <dataDir>.
+// It exits 0 when the login, a JSON call, a protobuf call and the logout succeed.
+
+#include <pthread.h>
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+#include <time.h>
+
+#include "libopenim_sdk.h"
+
+static pthread_mutex_t mu = PTHREAD_MUTEX_INITIALIZER;
+static pthread_cond_t cond = PTHREAD_COND_INITIALIZER;
+static int connected = 0;
+
+static void on_event(const char *event, int32_t err_code, const char *err_msg, const char *data, void *user_data) {
+	printf("event %s %d %s %s\n", event, err_code, err_msg, data);
+	if (strcmp(event, "OnConnectSuccess") == 0) {
+		pthread_mutex_lock(&mu);
+		connected = 1;
+		pthread_cond_broadcast(&cond);
+		pthread_mutex_unlock(&mu);
+	}
+	__atomic_fetch_add((int *)user_data, 1, __ATOMIC_SEQ_CST);
+}
+
+static int wait_connected(int seconds) {
+	struct timespec deadline;
+	clock_gettime(CLOCK_REALTIME, &deadline);
+	deadline.tv_sec += seconds;
+	pthread_mutex_lock(&mu);
+	while (!connected) {
+		if (pthread_cond_timedwait(&cond, &mu, &deadline) != 0) {
+			break;
+		}
+	}
+	int ok = connected;
+	pthread_mutex_unlock(&mu);
+	return ok;
+}
+
+// check_result prints and releases a JSON result, it returns 1 when its errCode is 0.
+static int check_result(const char *name, char *res) {
+	int ok = res != NULL && strstr(res, "\"errCode\":0,") != NULL;
+	printf("%s %s\n", name, res);
+	openim_free(res);
+	return ok;
+}
+
+int main(int argc, char **argv) {
+	if (argc != 4) {
+		fprintf(stderr, "usage: %s <apiAddr> <wsAddr> <dataDir>\n", argv[0]);
+		return 2;
+	}
+	static int events = 0;
+	openim_set_event_callback(on_event, &events);
+
+	char config[4096];
+	snprintf(config, sizeof(config),
+			 "{\"platformID\":3,\"apiAddr\":\"%s\",\"wsAddr\":\"%s\",\"dataDir\":\"%s\",\"logLevel\":1,\"logFilePath\":\"%s\"}",
+			 argv[1], argv[2], argv[3], argv[3]);
+	if (!openim_init_sdk("harness-init", config)) {
+		fprintf(stderr, "init failed\n");
+		return 1;
+	}
+	if (!check_result("login", openim_login("harness-login", "harness_user", "harness_token"))) {
+		return 1;
+	}
+	if (!wait_connected(10)) {
+		fprintf(stderr, "not connected\n");
+		return 1;
+	}
+	if (!check_result("call", openim_call("harness-call", "GetAllConversationList", "[]"))) {
+		return 1;
+	}
+
+	// FfiRequest{funcName: GetLoginStatus}, answered by FfiResult{funcName: GetLoginStatus, data: GetLoginStatusResp}
+	unsigned char req[] = {0x08, 0x07};
+	int32_t out_len = 0;
+	unsigned char *out = openim_call_proto(req, sizeof(req), &out_len);
+	int ok = out_len >= 2 && out[0] == 0x18 && out[1] == 0x07;
+	printf("call_proto %d bytes\n", out_len);
+	openim_free(out);
+	if (!ok) {
+		fprintf(stderr, "unexpected FfiResult\n");
+		return 1;
+	}
+
+	if (!check_result("logout", openim_logout("harness-logout"))) {
+		return 1;
+	}
+	openim_uninit_sdk("harness-uninit");
+	if (__atomic_load_n(&events, __ATOMIC_SEQ_CST) == 0) {
+		fprintf(stderr, "no event received\n");
+		return 1;
+	}
+	printf("ok\n");
+	return 0;
+}
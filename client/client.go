@@ -0,0 +1,139 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package client is a typed Go API over LoginMgr for applications that embed the SDK.
+// Unlike open_im_sdk it takes and returns Go values instead of JSON strings, and every
+// Client owns its LoginMgr instead of sharing the global UserForSDK.
+package client
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk"
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/ccontext"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+type Client struct {
+	mgr *open_im_sdk.LoginMgr
+}
+
+// New initializes a Client with config, connection events are reported to listener.
+func New(config sdk_struct.IMConfig, listener open_im_sdk_callback.OnConnListener) (*Client, error) {
+	if err := open_im_sdk.CheckIMConfig(config); err != nil {
+		return nil, err
+	}
+	if listener == nil {
+		return nil, sdkerrs.ErrArgs.WrapMsg("listener is nil")
+	}
+	mgr := open_im_sdk.NewLoginMgr()
+	if !mgr.InitSDK(config, listener) {
+		return nil, sdkerrs.ErrSdkInternal.WrapMsg("init sdk failed")
+	}
+	return &Client{mgr: mgr}, nil
+}
+
+// Attach returns a Client over a LoginMgr initialized elsewhere, for example the UserForSDK
+// of InitSDK. Close releases mgr as well.
+func Attach(mgr *open_im_sdk.LoginMgr) *Client {
+	return &Client{mgr: mgr}
+}
+
+// LoginMgr returns the LoginMgr behind the client, for the calls the client does not wrap.
+func (c *Client) LoginMgr() *open_im_sdk.LoginMgr {
+	return c.mgr
+}
+
+// Login logs userID in. The background tasks started by a login live until Logout,
+// they are not bound to ctx.
+func (c *Client) Login(ctx context.Context, userID, token string) error {
+	return c.mgr.Login(context.WithoutCancel(c.withInfo(ctx)), userID, token)
+}
+
+func (c *Client) Logout(ctx context.Context) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Logout(ctx)
+}
+
+// Close releases the client, it must be logged out.
+func (c *Client) Close() error {
+	if c.LoginStatus() == open_im_sdk.Logged {
+		return sdkerrs.ErrArgs.WrapMsg("sdk not logout, please logout first")
+	}
+	c.mgr.UnInitSDK()
+	return nil
+}
+
+func (c *Client) LoginStatus() int {
+	return c.mgr.GetLoginStatus(context.Background())
+}
+
+func (c *Client) UserID() string {
+	return c.mgr.GetLoginUserID()
+}
+
+func (c *Client) SetAppBackgroundStatus(ctx context.Context, isBackground bool) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.SetAppBackgroundStatus(ctx, isBackground)
+}
+
+func (c *Client) NetworkStatusChanged(ctx context.Context) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	c.mgr.NetworkStatusChanged(ctx)
+	return nil
+}
+
+// context returns the context for a call that needs a logged in user.
+func (c *Client) context(ctx context.Context) (context.Context, error) {
+	if c.LoginStatus() != open_im_sdk.Logged {
+		return nil, sdkerrs.ErrLoginOut.WrapMsg("not logged in")
+	}
+	return c.withInfo(ctx), nil
+}
+
+// withInfo adds the values the SDK reads from its contexts, such as the IMConfig,
+// to ctx and gives it an operation ID when it has none.
+func (c *Client) withInfo(ctx context.Context) context.Context {
+	ctx = &infoContext{Context: ctx, info: c.mgr.Context()}
+	if mcontext.GetOperationID(ctx) == "" {
+		ctx = ccontext.WithOperationID(ctx, utils.OperationIDGenerator())
+	}
+	return ctx
+}
+
+// infoContext is the caller's context, looking up values it does not hold in the LoginMgr context.
+type infoContext struct {
+	context.Context
+	info context.Context
+}
+
+func (i *infoContext) Value(key any) any {
+	if value := i.Context.Value(key); value != nil {
+		return value
+	}
+	return i.info.Value(key)
+}
@@ -0,0 +1,157 @@
+//go:build !js
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk"
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+type connListener struct {
+	open_im_sdk_callback.OnConnListener
+}
+
+func testConfig(t *testing.T) sdk_struct.IMConfig {
+	return sdk_struct.IMConfig{PlatformID: 1, ApiAddr: "http://127.0.0.1:10002", WsAddr: "ws://127.0.0.1:10001", DataDir: t.TempDir()}
+}
+
+func errCode(err error) int {
+	if codeErr, ok := errs.Unwrap(err).(errs.CodeError); ok {
+		return codeErr.Code()
+	}
+	return 0
+}
+
+func TestNew(t *testing.T) {
+	config := testConfig(t)
+	config.WsAddr = "127.0.0.1:10001"
+	if _, err := New(config, connListener{}); errCode(err) != sdkerrs.ArgsError {
+		t.Fatalf("invalid config: %v", err)
+	}
+	if _, err := New(testConfig(t), nil); errCode(err) != sdkerrs.ArgsError {
+		t.Fatalf("nil listener: %v", err)
+	}
+	c, err := New(testConfig(t), connListener{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.LoginStatus() != open_im_sdk.LogoutStatus {
+		t.Fatalf("login status %d", c.LoginStatus())
+	}
+	if _, err := c.GetAllConversationList(context.Background()); errCode(err) != sdkerrs.LoginOutError {
+		t.Fatalf("call before login: %v", err)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type messageListener struct {
+	open_im_sdk_callback.OnAdvancedMsgListenerSdk
+	offline  [][]string
+	receipts []*sdk_struct.MessageReceipt
+}
+
+func (l *messageListener) OnRecvOfflineNewMessage(messages []*sdk_struct.MsgStruct) {
+	var ids []string
+	for _, message := range messages {
+		ids = append(ids, message.ClientMsgID)
+	}
+	l.offline = append(l.offline, ids)
+}
+
+func (l *messageListener) OnRecvGroupReadReceipt(groupMsgReceiptList []*sdk_struct.MessageReceipt) {
+	l.receipts = append(l.receipts, groupMsgReceiptList...)
+}
+
+type conversationChangedListener struct {
+	open_im_sdk_callback.OnConversationListenerSdk
+	changed []*model_struct.LocalConversation
+}
+
+func (l *conversationChangedListener) OnConversationChanged(conversationList []*model_struct.LocalConversation) {
+	l.changed = append(l.changed, conversationList...)
+}
+
+func TestListenerDecode(t *testing.T) {
+	typed := &messageListener{}
+	listener := &advancedMsgListener{typed}
+	listener.OnRecvOfflineNewMessage(utils.StructToJsonString(&sdk_struct.MsgStruct{ClientMsgID: "m1"}))
+	listener.OnRecvOfflineNewMessage(utils.StructToJsonString([]*sdk_struct.MsgStruct{{ClientMsgID: "m2"}, {ClientMsgID: "m3"}}))
+	if len(typed.offline) != 2 || len(typed.offline[0]) != 1 || typed.offline[0][0] != "m1" || len(typed.offline[1]) != 2 || typed.offline[1][1] != "m3" {
+		t.Fatalf("offline messages %v", typed.offline)
+	}
+	listener.OnRecvGroupReadReceipt(`[{"groupID":"g1","userID":"u1","msgIDList":["m1"],"readTime":100,"sessionType":3}]`)
+	if len(typed.receipts) != 1 || typed.receipts[0].UserID != "u1" || typed.receipts[0].MsgIDList[0] != "m1" {
+		t.Fatalf("receipts %s", utils.StructToJsonString(typed.receipts))
+	}
+
+	conversations := &conversationChangedListener{}
+	(&conversationListener{conversations}).OnConversationChanged(utils.StructToJsonString([]*model_struct.LocalConversation{{ConversationID: "si_a_b", UnreadCount: 2}}))
+	if len(conversations.changed) != 1 || conversations.changed[0].ConversationID != "si_a_b" || conversations.changed[0].UnreadCount != 2 {
+		t.Fatalf("changed %s", utils.StructToJsonString(conversations.changed))
+	}
+}
+
+type friendAddedListener struct {
+	open_im_sdk_callback.OnFriendshipListenerSdk
+	added []model_struct.LocalFriend
+}
+
+func (l *friendAddedListener) OnFriendAdded(friendInfo model_struct.LocalFriend) {
+	l.added = append(l.added, friendInfo)
+}
+
+func TestFriendshipListenerTyped(t *testing.T) {
+	typed := &friendAddedListener{}
+	// the SDK adapter finds the typed listener behind the string one and skips the JSON
+	sdk := open_im_sdk_callback.NewOnFriendshipListenerSdk(func() open_im_sdk_callback.OnFriendshipListener {
+		return &friendshipListener{typed}
+	})
+	sdk.OnFriendAdded(model_struct.LocalFriend{FriendUserID: "u1", Remark: "remark"})
+	if len(typed.added) != 1 || typed.added[0].FriendUserID != "u1" || typed.added[0].Remark != "remark" {
+		t.Fatalf("added %s", utils.StructToJsonString(typed.added))
+	}
+}
+
+type groupDismissedListener struct {
+	open_im_sdk_callback.OnGroupListenerSdk
+	dismissed []model_struct.LocalGroup
+}
+
+func (l *groupDismissedListener) OnGroupDismissed(groupInfo model_struct.LocalGroup) {
+	l.dismissed = append(l.dismissed, groupInfo)
+}
+
+func TestGroupListenerTyped(t *testing.T) {
+	typed := &groupDismissedListener{}
+	sdk := open_im_sdk_callback.NewOnGroupListenerSdk(func() open_im_sdk_callback.OnGroupListener {
+		return &groupListener{typed}
+	})
+	sdk.OnGroupDismissed(model_struct.LocalGroup{GroupID: "g1", MemberCount: 3})
+	if len(typed.dismissed) != 1 || typed.dismissed[0].GroupID != "g1" || typed.dismissed[0].MemberCount != 3 {
+		t.Fatalf("dismissed %s", utils.StructToJsonString(typed.dismissed))
+	}
+}
+
+func TestListenerDecodeMalformed(t *testing.T) {
+	typed := &messageListener{}
+	listener := &advancedMsgListener{typed}
+	listener.OnRecvGroupReadReceipt(`[{"groupID":`)
+	if len(typed.receipts) != 0 {
+		t.Fatalf("receipts %s", utils.StructToJsonString(typed.receipts))
+	}
+	// a message that does not decode is dropped, not delivered as nil
+	listener.OnRecvOfflineNewMessage(`{"clientMsgID":`)
+	if len(typed.offline) != 0 {
+		t.Fatalf("offline messages %v", typed.offline)
+	}
+}
@@ -0,0 +1,619 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/server_api_params"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	pbConversation "github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+func (c *Client) GetAllConversationList(ctx context.Context) ([]*model_struct.LocalConversation, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetAllConversationList(ctx)
+}
+
+func (c *Client) GetConversationListSplit(ctx context.Context, offset int, count int) ([]*model_struct.LocalConversation, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetConversationListSplit(ctx, offset, count)
+}
+
+func (c *Client) CreateConversationLabel(ctx context.Context, name string) (*sdk_params_callback.ConversationLabel, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateConversationLabel(ctx, name)
+}
+
+func (c *Client) RenameConversationLabel(ctx context.Context, labelID, name string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().RenameConversationLabel(ctx, labelID, name)
+}
+
+func (c *Client) DeleteConversationLabel(ctx context.Context, labelID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().DeleteConversationLabel(ctx, labelID)
+}
+
+func (c *Client) ReorderConversationLabels(ctx context.Context, labelIDs []string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().ReorderConversationLabels(ctx, labelIDs)
+}
+
+func (c *Client) AddConversationsToLabel(ctx context.Context, labelID string, conversationIDs []string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().AddConversationsToLabel(ctx, labelID, conversationIDs)
+}
+
+func (c *Client) RemoveConversationsFromLabel(ctx context.Context, labelID string, conversationIDs []string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().RemoveConversationsFromLabel(ctx, labelID, conversationIDs)
+}
+
+func (c *Client) GetConversationLabels(ctx context.Context) ([]*sdk_params_callback.ConversationLabel, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetConversationLabels(ctx)
+}
+
+func (c *Client) GetConversationListByLabel(ctx context.Context, labelID string) ([]*model_struct.LocalConversation, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetConversationListByLabel(ctx, labelID)
+}
+
+func (c *Client) GetOneConversation(ctx context.Context, sessionType int32, sourceID string) (*model_struct.LocalConversation, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetOneConversation(ctx, sessionType, sourceID)
+}
+
+func (c *Client) GetMultipleConversation(ctx context.Context, conversationIDList []string) ([]*model_struct.LocalConversation, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetMultipleConversation(ctx, conversationIDList)
+}
+
+func (c *Client) SetConversation(ctx context.Context, conversationID string, req *pbConversation.ConversationReq) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().SetConversation(ctx, conversationID, req)
+}
+
+func (c *Client) HideConversation(ctx context.Context, conversationID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().HideConversation(ctx, conversationID)
+}
+
+func (c *Client) HideAllConversations(ctx context.Context) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().HideAllConversations(ctx)
+}
+
+func (c *Client) SetConversationDraft(ctx context.Context, conversationID string, draftText string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().SetConversationDraft(ctx, conversationID, draftText)
+}
+
+func (c *Client) GetTotalUnreadMsgCount(ctx context.Context) (int32, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return c.mgr.Conversation().GetTotalUnreadMsgCount(ctx)
+}
+
+func (c *Client) SearchConversation(ctx context.Context, searchParam string) ([]*server_api_params.Conversation, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().SearchConversation(ctx, searchParam)
+}
+
+func (c *Client) CreateTextMessage(ctx context.Context, text string) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateTextMessage(ctx, text)
+}
+
+func (c *Client) CreateAdvancedTextMessage(ctx context.Context, text string, messageEntities []*sdk_struct.MessageEntity) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateAdvancedTextMessage(ctx, text, messageEntities)
+}
+
+func (c *Client) CreateTextAtMessage(ctx context.Context, text string, userIDList []string, usersInfo []*sdk_struct.AtInfo, quoteMessage *sdk_struct.MsgStruct) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateTextAtMessage(ctx, text, userIDList, usersInfo, quoteMessage)
+}
+
+func (c *Client) CreateLocationMessage(ctx context.Context, description string, longitude float64, latitude float64) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateLocationMessage(ctx, description, longitude, latitude)
+}
+
+func (c *Client) CreateCustomMessage(ctx context.Context, data string, extension string, description string) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateCustomMessage(ctx, data, extension, description)
+}
+
+func (c *Client) CreateQuoteMessage(ctx context.Context, text string, quoteMessage *sdk_struct.MsgStruct) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateQuoteMessage(ctx, text, quoteMessage)
+}
+
+func (c *Client) CreateAdvancedQuoteMessage(ctx context.Context, text string, quoteMessage *sdk_struct.MsgStruct, messageEntities []*sdk_struct.MessageEntity) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateAdvancedQuoteMessage(ctx, text, quoteMessage, messageEntities)
+}
+
+func (c *Client) CreateCardMessage(ctx context.Context, card *sdk_struct.CardElem) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateCardMessage(ctx, card)
+}
+
+func (c *Client) CreateImageMessage(ctx context.Context, imageSourcePath string, sourcePicture *sdk_struct.PictureBaseInfo, bigPicture *sdk_struct.PictureBaseInfo, snapshotPicture *sdk_struct.PictureBaseInfo) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateImageMessage(ctx, imageSourcePath, sourcePicture, bigPicture, snapshotPicture)
+}
+
+func (c *Client) CreateSoundMessage(ctx context.Context, soundPath string, duration int64, soundElem *sdk_struct.SoundBaseInfo) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateSoundMessage(ctx, soundPath, duration, soundElem)
+}
+
+func (c *Client) CreateVideoMessage(ctx context.Context, videoSourcePath string, videoType string, duration int64, snapshotSourcePath string, videoElem *sdk_struct.VideoBaseInfo) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateVideoMessage(ctx, videoSourcePath, videoType, duration, snapshotSourcePath, videoElem)
+}
+
+func (c *Client) CreateFileMessage(ctx context.Context, fileSourcePath string, fileName string, fileElem *sdk_struct.FileBaseInfo) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateFileMessage(ctx, fileSourcePath, fileName, fileElem)
+}
+
+func (c *Client) CreateMergerMessage(ctx context.Context, messages []*sdk_struct.MsgStruct, title string, summaries []string) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateMergerMessage(ctx, messages, title, summaries)
+}
+
+func (c *Client) CreateFaceMessage(ctx context.Context, index int, data string) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateFaceMessage(ctx, index, data)
+}
+
+func (c *Client) CreateForwardMessage(ctx context.Context, message *sdk_struct.MsgStruct) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().CreateForwardMessage(ctx, message)
+}
+
+func (c *Client) FindMessageList(ctx context.Context, req []*sdk_params_callback.ConversationArgs) (*sdk_params_callback.FindMessageListCallback, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().FindMessageList(ctx, req)
+}
+
+func (c *Client) GetAdvancedHistoryMessageList(ctx context.Context, req sdk_params_callback.GetAdvancedHistoryMessageListParams) (*sdk_params_callback.GetAdvancedHistoryMessageListCallback, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetAdvancedHistoryMessageList(ctx, req)
+}
+
+func (c *Client) GetThreadMessages(ctx context.Context, req sdk_params_callback.GetThreadMessagesParams) (*sdk_params_callback.GetThreadMessagesCallback, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetThreadMessages(ctx, req)
+}
+
+func (c *Client) GetAdvancedHistoryMessageListReverse(ctx context.Context, req sdk_params_callback.GetAdvancedHistoryMessageListParams) (*sdk_params_callback.GetAdvancedHistoryMessageListCallback, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetAdvancedHistoryMessageListReverse(ctx, req)
+}
+
+func (c *Client) SearchLocalMessages(ctx context.Context, searchParam *sdk_params_callback.SearchLocalMessagesParams) (*sdk_params_callback.SearchLocalMessagesCallback, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().SearchLocalMessages(ctx, searchParam)
+}
+
+func (c *Client) RevokeMessage(ctx context.Context, conversationID string, clientMsgID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().RevokeMessage(ctx, conversationID, clientMsgID)
+}
+
+func (c *Client) EditMessage(ctx context.Context, conversationID string, clientMsgID string, newContent string) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().EditMessage(ctx, conversationID, clientMsgID, newContent)
+}
+
+func (c *Client) ScheduleMessage(ctx context.Context, message *sdk_struct.MsgStruct, recvID string, groupID string, sendAt int64, offlinePushInfo *sdkws.OfflinePushInfo) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().ScheduleMessage(ctx, message, recvID, groupID, sendAt, offlinePushInfo)
+}
+
+func (c *Client) CancelScheduledMessage(ctx context.Context, clientMsgID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().CancelScheduledMessage(ctx, clientMsgID)
+}
+
+func (c *Client) GetScheduledMessages(ctx context.Context) ([]*sdk_params_callback.ScheduledMessage, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetScheduledMessages(ctx)
+}
+
+func (c *Client) AddMessageReaction(ctx context.Context, conversationID string, clientMsgID string, reactionType int, info string) (*sdk_params_callback.MessageReactions, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().AddMessageReaction(ctx, conversationID, clientMsgID, reactionType, info)
+}
+
+func (c *Client) RemoveMessageReaction(ctx context.Context, conversationID string, clientMsgID string, reactionType int) (*sdk_params_callback.MessageReactions, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().RemoveMessageReaction(ctx, conversationID, clientMsgID, reactionType)
+}
+
+func (c *Client) GetMessageReactions(ctx context.Context, conversationID string, clientMsgIDs []string) ([]*sdk_params_callback.MessageReactions, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetMessageReactions(ctx, conversationID, clientMsgIDs)
+}
+
+func (c *Client) PinMessage(ctx context.Context, conversationID string, clientMsgID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().PinMessage(ctx, conversationID, clientMsgID)
+}
+
+func (c *Client) UnpinMessage(ctx context.Context, conversationID string, clientMsgID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().UnpinMessage(ctx, conversationID, clientMsgID)
+}
+
+func (c *Client) GetPinnedMessages(ctx context.Context, conversationID string) ([]*sdk_params_callback.PinnedMessage, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetPinnedMessages(ctx, conversationID)
+}
+
+func (c *Client) GetMentionMessages(ctx context.Context, req sdk_params_callback.GetMentionMessagesParams) (*sdk_params_callback.GetMentionMessagesCallback, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetMentionMessages(ctx, req)
+}
+
+func (c *Client) MarkMentionsRead(ctx context.Context, conversationID string, clientMsgIDs []string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().MarkMentionsRead(ctx, conversationID, clientMsgIDs)
+}
+
+func (c *Client) GetUnreadMentionCount(ctx context.Context) (int32, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return c.mgr.Conversation().GetUnreadMentionCount(ctx)
+}
+
+func (c *Client) GetGroupMessageReaderList(ctx context.Context, conversationID string, clientMsgID string, filter int32, offset int32, count int32) (*sdk_params_callback.GroupMessageReaderList, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetGroupMessageReaderList(ctx, conversationID, clientMsgID, filter, offset, count)
+}
+
+func (c *Client) TypingStatusUpdate(ctx context.Context, recvID string, msgTip string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().TypingStatusUpdate(ctx, recvID, msgTip)
+}
+
+func (c *Client) ChangeInputStates(ctx context.Context, conversationID string, focus bool) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().ChangeInputStates(ctx, conversationID, focus)
+}
+
+func (c *Client) GetInputStates(ctx context.Context, conversationID string, userID string) ([]int32, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().GetInputStates(ctx, conversationID, userID)
+}
+
+func (c *Client) MarkConversationMessageAsRead(ctx context.Context, conversationID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().MarkConversationMessageAsRead(ctx, conversationID)
+}
+
+func (c *Client) MarkAllConversationMessageAsRead(ctx context.Context) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().MarkAllConversationMessageAsRead(ctx)
+}
+
+func (c *Client) MarkMessagesAsReadByMsgID(ctx context.Context, conversationID string, clientMsgIDs []string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().MarkMessagesAsReadByMsgID(ctx, conversationID, clientMsgIDs)
+}
+
+func (c *Client) DeleteMessageFromLocalStorage(ctx context.Context, conversationID string, clientMsgID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().DeleteMessageFromLocalStorage(ctx, conversationID, clientMsgID)
+}
+
+func (c *Client) DeleteMessage(ctx context.Context, conversationID string, clientMsgID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().DeleteMessage(ctx, conversationID, clientMsgID)
+}
+
+func (c *Client) DeleteAllMsgFromLocalAndServer(ctx context.Context) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().DeleteAllMsgFromLocalAndServer(ctx)
+}
+
+func (c *Client) DeleteAllMessageFromLocalStorage(ctx context.Context) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().DeleteAllMessageFromLocalStorage(ctx)
+}
+
+func (c *Client) ClearConversationAndDeleteAllMsg(ctx context.Context, conversationID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().ClearConversationAndDeleteAllMsg(ctx, conversationID)
+}
+
+func (c *Client) DeleteConversationAndDeleteAllMsg(ctx context.Context, conversationID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().DeleteConversationAndDeleteAllMsg(ctx, conversationID)
+}
+
+func (c *Client) InsertSingleMessageToLocalStorage(ctx context.Context, message *sdk_struct.MsgStruct, recvID string, sendID string) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().InsertSingleMessageToLocalStorage(ctx, message, recvID, sendID)
+}
+
+func (c *Client) InsertGroupMessageToLocalStorage(ctx context.Context, message *sdk_struct.MsgStruct, groupID string, sendID string) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().InsertGroupMessageToLocalStorage(ctx, message, groupID, sendID)
+}
+
+func (c *Client) SetMessageLocalEx(ctx context.Context, conversationID string, clientMsgID string, localEx string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().SetMessageLocalEx(ctx, conversationID, clientMsgID, localEx)
+}
+
+func (c *Client) ExportConversations(ctx context.Context, conversationIDs []string, path string, withMedia bool, progress open_im_sdk_callback.ArchiveProgress) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Conversation().ExportConversations(ctx, conversationIDs, path, withMedia, progress)
+}
+
+func (c *Client) ImportArchive(ctx context.Context, path string, progress open_im_sdk_callback.ArchiveProgress) (*sdk_params_callback.ImportArchiveResp, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Conversation().ImportArchive(ctx, path, progress)
+}
+
+// SendMessage sends message to recvID or groupID. progress, when not nil, receives the
+// upload progress of the files of media messages.
+func (c *Client) SendMessage(ctx context.Context, message *sdk_struct.MsgStruct, recvID, groupID string, offlinePushInfo *sdkws.OfflinePushInfo, isOnlineOnly bool, progress func(progress int)) (*sdk_struct.MsgStruct, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, "callback", sendMsgCallback(progress))
+	return c.mgr.Conversation().SendMessage(ctx, message, recvID, groupID, offlinePushInfo, isOnlineOnly)
+}
+
+func (c *Client) GetConversationIDBySessionType(ctx context.Context, sourceID string, sessionType int) (string, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return "", err
+	}
+	return c.mgr.Conversation().GetConversationIDBySessionType(ctx, sourceID, sessionType), nil
+}
+
+// sendMsgCallback reports the upload progress of SendMessage, the result is its return value.
+type sendMsgCallback func(progress int)
+
+func (f sendMsgCallback) OnError(int32, string) {}
+
+func (f sendMsgCallback) OnSuccess(string) {}
+
+func (f sendMsgCallback) OnProgress(progress int) {
+	if f != nil {
+		f(progress)
+	}
+}
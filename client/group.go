@@ -0,0 +1,232 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+func (c *Client) CreateGroup(ctx context.Context, req *group.CreateGroupReq) (*sdkws.GroupInfo, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Group().CreateGroup(ctx, req)
+}
+
+func (c *Client) JoinGroup(ctx context.Context, groupID string, reqMsg string, joinSource int32, ex string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Group().JoinGroup(ctx, groupID, reqMsg, joinSource, ex)
+}
+
+func (c *Client) QuitGroup(ctx context.Context, groupID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Group().QuitGroup(ctx, groupID)
+}
+
+func (c *Client) DismissGroup(ctx context.Context, groupID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Group().DismissGroup(ctx, groupID)
+}
+
+func (c *Client) ChangeGroupMute(ctx context.Context, groupID string, isMute bool) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Group().ChangeGroupMute(ctx, groupID, isMute)
+}
+
+func (c *Client) ChangeGroupMemberMute(ctx context.Context, groupID string, userID string, mutedSeconds int) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Group().ChangeGroupMemberMute(ctx, groupID, userID, mutedSeconds)
+}
+
+func (c *Client) TransferGroupOwner(ctx context.Context, groupID string, newOwnerUserID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Group().TransferGroupOwner(ctx, groupID, newOwnerUserID)
+}
+
+func (c *Client) KickGroupMember(ctx context.Context, groupID string, reason string, userIDList []string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Group().KickGroupMember(ctx, groupID, reason, userIDList)
+}
+
+func (c *Client) SetGroupInfo(ctx context.Context, groupInfo *group.SetGroupInfoExReq) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Group().SetGroupInfo(ctx, groupInfo)
+}
+
+func (c *Client) SetGroupMemberInfo(ctx context.Context, groupMemberInfo *group.SetGroupMemberInfo) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Group().SetGroupMemberInfo(ctx, groupMemberInfo)
+}
+
+func (c *Client) GetJoinedGroupList(ctx context.Context) ([]*model_struct.LocalGroup, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Group().GetJoinedGroupList(ctx)
+}
+
+func (c *Client) GetJoinedGroupListPage(ctx context.Context, offset int32, count int32) ([]*model_struct.LocalGroup, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Group().GetJoinedGroupListPage(ctx, offset, count)
+}
+
+func (c *Client) GetSpecifiedGroupsInfo(ctx context.Context, groupIDs []string) ([]*model_struct.LocalGroup, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Group().GetSpecifiedGroupsInfo(ctx, groupIDs)
+}
+
+func (c *Client) SearchGroups(ctx context.Context, param sdk_params_callback.SearchGroupsParam) ([]*model_struct.LocalGroup, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Group().SearchGroups(ctx, param)
+}
+
+func (c *Client) GetGroupMemberOwnerAndAdmin(ctx context.Context, groupID string) ([]*model_struct.LocalGroupMember, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Group().GetGroupMemberOwnerAndAdmin(ctx, groupID)
+}
+
+func (c *Client) GetGroupMemberListByJoinTimeFilter(ctx context.Context, groupID string, offset int32, count int32, joinTimeBegin int64, joinTimeEnd int64, userIDs []string) ([]*model_struct.LocalGroupMember, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Group().GetGroupMemberListByJoinTimeFilter(ctx, groupID, offset, count, joinTimeBegin, joinTimeEnd, userIDs)
+}
+
+func (c *Client) GetSpecifiedGroupMembersInfo(ctx context.Context, groupID string, userIDList []string) ([]*model_struct.LocalGroupMember, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Group().GetSpecifiedGroupMembersInfo(ctx, groupID, userIDList)
+}
+
+func (c *Client) GetGroupMemberList(ctx context.Context, groupID string, filter int32, offset int32, count int32) ([]*model_struct.LocalGroupMember, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Group().GetGroupMemberList(ctx, groupID, filter, offset, count)
+}
+
+func (c *Client) GetGroupApplicationListAsRecipient(ctx context.Context) ([]*model_struct.LocalAdminGroupRequest, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Group().GetGroupApplicationListAsRecipient(ctx)
+}
+
+func (c *Client) GetGroupApplicationListAsApplicant(ctx context.Context) ([]*model_struct.LocalGroupRequest, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Group().GetGroupApplicationListAsApplicant(ctx)
+}
+
+func (c *Client) SearchGroupMembers(ctx context.Context, searchParam *sdk_params_callback.SearchGroupMembersParam) ([]*model_struct.LocalGroupMember, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Group().SearchGroupMembers(ctx, searchParam)
+}
+
+func (c *Client) IsJoinGroup(ctx context.Context, groupID string) (bool, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return false, err
+	}
+	return c.mgr.Group().IsJoinGroup(ctx, groupID)
+}
+
+func (c *Client) GetUsersInGroup(ctx context.Context, groupID string, userIDList []string) ([]string, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Group().GetUsersInGroup(ctx, groupID, userIDList)
+}
+
+func (c *Client) InviteUserToGroup(ctx context.Context, groupID string, reason string, userIDList []string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Group().InviteUserToGroup(ctx, groupID, reason, userIDList)
+}
+
+func (c *Client) AcceptGroupApplication(ctx context.Context, groupID string, fromUserID string, handleMsg string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Group().AcceptGroupApplication(ctx, groupID, fromUserID, handleMsg)
+}
+
+func (c *Client) RefuseGroupApplication(ctx context.Context, groupID string, fromUserID string, handleMsg string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Group().RefuseGroupApplication(ctx, groupID, fromUserID, handleMsg)
+}
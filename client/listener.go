@@ -0,0 +1,389 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	userPb "github.com/openimsdk/protocol/user"
+	"github.com/openimsdk/tools/log"
+)
+
+func (c *Client) SetConversationListener(listener open_im_sdk_callback.OnConversationListenerSdk) {
+	c.mgr.SetConversationListener(&conversationListener{listener})
+}
+
+func (c *Client) SetAdvancedMsgListener(listener open_im_sdk_callback.OnAdvancedMsgListenerSdk) {
+	c.mgr.SetAdvancedMsgListener(&advancedMsgListener{listener})
+}
+
+func (c *Client) SetFriendshipListener(listener open_im_sdk_callback.OnFriendshipListenerSdk) {
+	c.mgr.SetFriendshipListener(&friendshipListener{listener})
+}
+
+func (c *Client) SetGroupListener(listener open_im_sdk_callback.OnGroupListenerSdk) {
+	c.mgr.SetGroupListener(&groupListener{listener})
+}
+
+func (c *Client) SetUserListener(listener open_im_sdk_callback.OnUserListenerSdk) {
+	c.mgr.SetUserListener(&userListener{listener})
+}
+
+// decode unmarshals the JSON a string listener received. A payload that does not decode is
+// logged and reported as not ok, the event is then dropped rather than delivered empty.
+func decode[T any](data string) (T, bool) {
+	var value T
+	if err := utils.JsonStringToStruct(data, &value); err != nil {
+		log.ZError(context.Background(), "decode listener event failed", err, "type", fmt.Sprintf("%T", value), "data", data)
+		return value, false
+	}
+	return value, true
+}
+
+// decodeMessages decodes a single message or a list of messages as a list.
+func decodeMessages(data string) ([]*sdk_struct.MsgStruct, bool) {
+	if strings.HasPrefix(strings.TrimSpace(data), "[") {
+		return decode[[]*sdk_struct.MsgStruct](data)
+	}
+	message, ok := decode[*sdk_struct.MsgStruct](data)
+	return []*sdk_struct.MsgStruct{message}, ok
+}
+
+type conversationListener struct {
+	listener open_im_sdk_callback.OnConversationListenerSdk
+}
+
+func (l *conversationListener) OnSyncServerStart(reinstalled bool) {
+	l.listener.OnSyncServerStart(reinstalled)
+}
+
+func (l *conversationListener) OnSyncServerFinish(reinstalled bool) {
+	l.listener.OnSyncServerFinish(reinstalled)
+}
+
+func (l *conversationListener) OnSyncServerProgress(progress int) {
+	l.listener.OnSyncServerProgress(progress)
+}
+
+func (l *conversationListener) OnSyncServerFailed(reinstalled bool) {
+	l.listener.OnSyncServerFailed(reinstalled)
+}
+
+func (l *conversationListener) OnNewConversation(conversationList string) {
+	if value, ok := decode[[]*model_struct.LocalConversation](conversationList); ok {
+		l.listener.OnNewConversation(value)
+	}
+}
+
+func (l *conversationListener) OnConversationChanged(conversationList string) {
+	if value, ok := decode[[]*model_struct.LocalConversation](conversationList); ok {
+		l.listener.OnConversationChanged(value)
+	}
+}
+
+func (l *conversationListener) OnTotalUnreadMessageCountChanged(totalUnreadCount int32) {
+	l.listener.OnTotalUnreadMessageCountChanged(totalUnreadCount)
+}
+
+func (l *conversationListener) OnConversationUserInputStatusChanged(change string) {
+	if value, ok := decode[sdk_struct.InputStatesChangedData](change); ok {
+		l.listener.OnConversationUserInputStatusChanged(value)
+	}
+}
+
+func (l *conversationListener) OnUnreadMentionCountChanged(totalUnreadCount int32) {
+	l.listener.OnUnreadMentionCountChanged(totalUnreadCount)
+}
+
+type advancedMsgListener struct {
+	listener open_im_sdk_callback.OnAdvancedMsgListenerSdk
+}
+
+func (l *advancedMsgListener) OnRecvNewMessage(message string) {
+	if value, ok := decode[*sdk_struct.MsgStruct](message); ok {
+		l.listener.OnRecvNewMessage(value)
+	}
+}
+
+func (l *advancedMsgListener) OnRecvC2CReadReceipt(msgReceiptList string) {
+	if value, ok := decode[[]*sdk_struct.MessageReceipt](msgReceiptList); ok {
+		l.listener.OnRecvC2CReadReceipt(value)
+	}
+}
+
+func (l *advancedMsgListener) OnRecvGroupReadReceipt(groupMsgReceiptList string) {
+	if value, ok := decode[[]*sdk_struct.MessageReceipt](groupMsgReceiptList); ok {
+		l.listener.OnRecvGroupReadReceipt(value)
+	}
+}
+
+func (l *advancedMsgListener) OnNewRecvMessageRevoked(messageRevoked string) {
+	if value, ok := decode[sdk_struct.MessageRevoked](messageRevoked); ok {
+		l.listener.OnNewRecvMessageRevoked(value)
+	}
+}
+
+func (l *advancedMsgListener) OnRecvOfflineNewMessage(message string) {
+	if value, ok := decodeMessages(message); ok {
+		l.listener.OnRecvOfflineNewMessage(value)
+	}
+}
+
+func (l *advancedMsgListener) OnMsgDeleted(message string) {
+	if value, ok := decode[model_struct.LocalChatLog](message); ok {
+		l.listener.OnMsgDeleted(value)
+	}
+}
+
+func (l *advancedMsgListener) OnRecvOnlineOnlyMessage(message string) {
+	if value, ok := decodeMessages(message); ok {
+		l.listener.OnRecvOnlineOnlyMessage(value)
+	}
+}
+
+func (l *advancedMsgListener) OnMsgEdited(message string) {
+	if value, ok := decode[*sdk_struct.MsgStruct](message); ok {
+		l.listener.OnMsgEdited(value)
+	}
+}
+
+func (l *advancedMsgListener) OnRecvMessageExtensionsChanged(msgID string, reactionExtensionList string) {
+	if value, ok := decode[[]*sdk_struct.ReactionElem](reactionExtensionList); ok {
+		l.listener.OnRecvMessageExtensionsChanged(msgID, value)
+	}
+}
+
+func (l *advancedMsgListener) OnRecvMessageExtensionsDeleted(msgID string, reactionExtensionKeyList string) {
+	if value, ok := decode[[]string](reactionExtensionKeyList); ok {
+		l.listener.OnRecvMessageExtensionsDeleted(msgID, value)
+	}
+}
+
+func (l *advancedMsgListener) OnRecvMessageExtensionsAdded(msgID string, reactionExtensionList string) {
+	if value, ok := decode[[]*sdk_struct.ReactionElem](reactionExtensionList); ok {
+		l.listener.OnRecvMessageExtensionsAdded(msgID, value)
+	}
+}
+
+func (l *advancedMsgListener) OnScheduledMessageSent(message string) {
+	if value, ok := decode[*sdk_struct.MsgStruct](message); ok {
+		l.listener.OnScheduledMessageSent(value)
+	}
+}
+
+func (l *advancedMsgListener) OnScheduledMessageFailed(message string, errCode int32, errMsg string) {
+	if value, ok := decode[*sdk_struct.MsgStruct](message); ok {
+		l.listener.OnScheduledMessageFailed(value, errCode, errMsg)
+	}
+}
+
+func (l *advancedMsgListener) OnOutboxMessageStatusChanged(message string, status int32, attempts int32) {
+	if value, ok := decode[*sdk_struct.MsgStruct](message); ok {
+		l.listener.OnOutboxMessageStatusChanged(value, status, attempts)
+	}
+}
+
+func (l *advancedMsgListener) OnMsgPinChanged(pinChange string) {
+	if value, ok := decode[*sdk_params_callback.MessagePinChange](pinChange); ok {
+		l.listener.OnMsgPinChanged(value)
+	}
+}
+
+// friendshipListener hands the typed listener to the SDK, which holds friendship events as Go
+// values, so its string methods only serve callers that do not look for the typed listener.
+type friendshipListener struct {
+	listener open_im_sdk_callback.OnFriendshipListenerSdk
+}
+
+func (l *friendshipListener) FriendshipListenerSdk() open_im_sdk_callback.OnFriendshipListenerSdk {
+	return l.listener
+}
+
+func (l *friendshipListener) OnFriendApplicationAdded(friendApplication string) {
+	if value, ok := decode[model_struct.LocalFriendRequest](friendApplication); ok {
+		l.listener.OnFriendApplicationAdded(value)
+	}
+}
+
+func (l *friendshipListener) OnFriendApplicationDeleted(friendApplication string) {
+	if value, ok := decode[model_struct.LocalFriendRequest](friendApplication); ok {
+		l.listener.OnFriendApplicationDeleted(value)
+	}
+}
+
+func (l *friendshipListener) OnFriendApplicationAccepted(friendApplication string) {
+	if value, ok := decode[model_struct.LocalFriendRequest](friendApplication); ok {
+		l.listener.OnFriendApplicationAccepted(value)
+	}
+}
+
+func (l *friendshipListener) OnFriendApplicationRejected(friendApplication string) {
+	if value, ok := decode[model_struct.LocalFriendRequest](friendApplication); ok {
+		l.listener.OnFriendApplicationRejected(value)
+	}
+}
+
+func (l *friendshipListener) OnFriendAdded(friendInfo string) {
+	if value, ok := decode[model_struct.LocalFriend](friendInfo); ok {
+		l.listener.OnFriendAdded(value)
+	}
+}
+
+func (l *friendshipListener) OnFriendDeleted(friendInfo string) {
+	if value, ok := decode[model_struct.LocalFriend](friendInfo); ok {
+		l.listener.OnFriendDeleted(value)
+	}
+}
+
+func (l *friendshipListener) OnFriendInfoChanged(friendInfo string) {
+	if value, ok := decode[model_struct.LocalFriend](friendInfo); ok {
+		l.listener.OnFriendInfoChanged(value)
+	}
+}
+
+func (l *friendshipListener) OnBlackAdded(blackInfo string) {
+	if value, ok := decode[model_struct.LocalBlack](blackInfo); ok {
+		l.listener.OnBlackAdded(value)
+	}
+}
+
+func (l *friendshipListener) OnBlackDeleted(blackInfo string) {
+	if value, ok := decode[model_struct.LocalBlack](blackInfo); ok {
+		l.listener.OnBlackDeleted(value)
+	}
+}
+
+// groupListener hands the typed listener to the SDK, which holds group events as Go values, so
+// its string methods only serve callers that do not look for the typed listener.
+type groupListener struct {
+	listener open_im_sdk_callback.OnGroupListenerSdk
+}
+
+func (l *groupListener) GroupListenerSdk() open_im_sdk_callback.OnGroupListenerSdk {
+	return l.listener
+}
+
+func (l *groupListener) OnJoinedGroupAdded(groupInfo string) {
+	if value, ok := decode[model_struct.LocalGroup](groupInfo); ok {
+		l.listener.OnJoinedGroupAdded(value)
+	}
+}
+
+func (l *groupListener) OnJoinedGroupDeleted(groupInfo string) {
+	if value, ok := decode[model_struct.LocalGroup](groupInfo); ok {
+		l.listener.OnJoinedGroupDeleted(value)
+	}
+}
+
+func (l *groupListener) OnGroupMemberAdded(groupMemberInfo string) {
+	if value, ok := decode[model_struct.LocalGroupMember](groupMemberInfo); ok {
+		l.listener.OnGroupMemberAdded(value)
+	}
+}
+
+func (l *groupListener) OnGroupMemberDeleted(groupMemberInfo string) {
+	if value, ok := decode[model_struct.LocalGroupMember](groupMemberInfo); ok {
+		l.listener.OnGroupMemberDeleted(value)
+	}
+}
+
+func (l *groupListener) OnGroupApplicationAdded(groupApplication string) {
+	if value, ok := decode[model_struct.LocalGroupRequest](groupApplication); ok {
+		l.listener.OnGroupApplicationAdded(value)
+	}
+}
+
+func (l *groupListener) OnGroupApplicationDeleted(groupApplication string) {
+	if value, ok := decode[model_struct.LocalGroupRequest](groupApplication); ok {
+		l.listener.OnGroupApplicationDeleted(value)
+	}
+}
+
+func (l *groupListener) OnGroupInfoChanged(groupInfo string) {
+	if value, ok := decode[model_struct.LocalGroup](groupInfo); ok {
+		l.listener.OnGroupInfoChanged(value)
+	}
+}
+
+func (l *groupListener) OnGroupDismissed(groupInfo string) {
+	if value, ok := decode[model_struct.LocalGroup](groupInfo); ok {
+		l.listener.OnGroupDismissed(value)
+	}
+}
+
+func (l *groupListener) OnGroupMemberInfoChanged(groupMemberInfo string) {
+	if value, ok := decode[model_struct.LocalGroupMember](groupMemberInfo); ok {
+		l.listener.OnGroupMemberInfoChanged(value)
+	}
+}
+
+func (l *groupListener) OnGroupApplicationAccepted(groupApplication string) {
+	if value, ok := decode[model_struct.LocalGroupRequest](groupApplication); ok {
+		l.listener.OnGroupApplicationAccepted(value)
+	}
+}
+
+func (l *groupListener) OnGroupApplicationRejected(groupApplication string) {
+	if value, ok := decode[model_struct.LocalGroupRequest](groupApplication); ok {
+		l.listener.OnGroupApplicationRejected(value)
+	}
+}
+
+// userListener hands the typed listener to the SDK, which holds user events as Go values, so
+// its string methods only serve callers that do not look for the typed listener.
+type userListener struct {
+	listener open_im_sdk_callback.OnUserListenerSdk
+}
+
+func (l *userListener) UserListenerSdk() open_im_sdk_callback.OnUserListenerSdk {
+	return l.listener
+}
+
+func (l *userListener) OnSelfInfoUpdated(userInfo string) {
+	if value, ok := decode[model_struct.LocalUser](userInfo); ok {
+		l.listener.OnSelfInfoUpdated(value)
+	}
+}
+
+func (l *userListener) OnUserStatusChanged(userOnlineStatus string) {
+	if value, ok := decode[*userPb.OnlineStatus](userOnlineStatus); ok {
+		l.listener.OnUserStatusChanged(value)
+	}
+}
+
+func (l *userListener) OnUserCommandAdd(userCommand string) {
+	if value, ok := decode[model_struct.LocalUserCommand](userCommand); ok {
+		l.listener.OnUserCommandAdd(value)
+	}
+}
+
+func (l *userListener) OnUserCommandDelete(userCommand string) {
+	if value, ok := decode[model_struct.LocalUserCommand](userCommand); ok {
+		l.listener.OnUserCommandDelete(value)
+	}
+}
+
+func (l *userListener) OnUserCommandUpdate(userCommand string) {
+	if value, ok := decode[model_struct.LocalUserCommand](userCommand); ok {
+		l.listener.OnUserCommandUpdate(value)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	userPb "github.com/openimsdk/protocol/user"
+)
+
+func (c *Client) SubscribeUsersStatus(ctx context.Context, userIDs []string) ([]*userPb.OnlineStatus, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.LongConnMgr().SubscribeUsersStatus(ctx, userIDs)
+}
+
+func (c *Client) UnsubscribeUsersStatus(ctx context.Context, userIDs []string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.LongConnMgr().UnsubscribeUsersStatus(ctx, userIDs)
+}
+
+func (c *Client) GetSubscribeUsersStatus(ctx context.Context) ([]*userPb.OnlineStatus, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.LongConnMgr().GetSubscribeUsersStatus(ctx)
+}
@@ -0,0 +1,144 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/server_api_params"
+	"github.com/openimsdk/protocol/relation"
+)
+
+func (c *Client) GetSpecifiedFriendsInfo(ctx context.Context, friendUserIDList []string, filterBlack bool) ([]*model_struct.LocalFriend, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Relation().GetSpecifiedFriendsInfo(ctx, friendUserIDList, filterBlack)
+}
+
+func (c *Client) GetFriendList(ctx context.Context, filterBlack bool) ([]*model_struct.LocalFriend, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Relation().GetFriendList(ctx, filterBlack)
+}
+
+func (c *Client) GetFriendListPage(ctx context.Context, offset int32, count int32, filterBlack bool) ([]*model_struct.LocalFriend, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Relation().GetFriendListPage(ctx, offset, count, filterBlack)
+}
+
+func (c *Client) SearchFriends(ctx context.Context, param *sdk_params_callback.SearchFriendsParam) ([]*sdk_params_callback.SearchFriendItem, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Relation().SearchFriends(ctx, param)
+}
+
+func (c *Client) CheckFriend(ctx context.Context, friendUserIDList []string) ([]*server_api_params.UserIDResult, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Relation().CheckFriend(ctx, friendUserIDList)
+}
+
+func (c *Client) AddFriend(ctx context.Context, req *relation.ApplyToAddFriendReq) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Relation().AddFriend(ctx, req)
+}
+
+func (c *Client) UpdateFriends(ctx context.Context, req *relation.UpdateFriendsReq) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Relation().UpdateFriends(ctx, req)
+}
+
+func (c *Client) DeleteFriend(ctx context.Context, friendUserID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Relation().DeleteFriend(ctx, friendUserID)
+}
+
+func (c *Client) GetFriendApplicationListAsRecipient(ctx context.Context) ([]*model_struct.LocalFriendRequest, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Relation().GetFriendApplicationListAsRecipient(ctx)
+}
+
+func (c *Client) GetFriendApplicationListAsApplicant(ctx context.Context) ([]*model_struct.LocalFriendRequest, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Relation().GetFriendApplicationListAsApplicant(ctx)
+}
+
+func (c *Client) AcceptFriendApplication(ctx context.Context, userIDHandleMsg *sdk_params_callback.ProcessFriendApplicationParams) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Relation().AcceptFriendApplication(ctx, userIDHandleMsg)
+}
+
+func (c *Client) RefuseFriendApplication(ctx context.Context, userIDHandleMsg *sdk_params_callback.ProcessFriendApplicationParams) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Relation().RefuseFriendApplication(ctx, userIDHandleMsg)
+}
+
+func (c *Client) AddBlack(ctx context.Context, blackUserID string, ex string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Relation().AddBlack(ctx, blackUserID, ex)
+}
+
+func (c *Client) RemoveBlack(ctx context.Context, blackUserID string) error {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return err
+	}
+	return c.mgr.Relation().RemoveBlack(ctx, blackUserID)
+}
+
+func (c *Client) GetBlackList(ctx context.Context) ([]*model_struct.LocalBlack, error) {
+	ctx, err := c.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.mgr.Relation().GetBlackList(ctx)
+}
@@ -0,0 +1,296 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ffi
+
+import (
+	"github.com/openimsdk/openim-sdk-core/v3/internal/conversation_msg"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	pb "github.com/openimsdk/openim-sdk-core/v3/proto"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+func fromIMConfig(config *pb.IMConfig) sdk_struct.IMConfig {
+	return sdk_struct.IMConfig{
+		SystemType:           config.SystemType,
+		PlatformID:           int32(config.PlatformID),
+		ApiAddr:              config.ApiAddr,
+		WsAddr:               config.WsAddr,
+		DataDir:              config.DataDir,
+		LogLevel:             config.LogLevel,
+		IsLogStandardOutput:  config.IsLogStandardOutput,
+		LogFilePath:          config.LogFilePath,
+		IsExternalExtensions: config.IsExternalExtensions,
+		WsCodec:              config.WsCodec,
+		Compression:          config.Compression,
+		CompressionThreshold: int(config.CompressionThreshold),
+		Transport:            config.Transport,
+		DBKey:                config.DbKey,
+		Storage:              config.Storage,
+	}
+}
+
+func toConversation(conversation *model_struct.LocalConversation) *pb.Conversation {
+	return &pb.Conversation{
+		ConversationID:        conversation.ConversationID,
+		ConversationType:      conversation.ConversationType,
+		UserID:                conversation.UserID,
+		GroupID:               conversation.GroupID,
+		ShowName:              conversation.ShowName,
+		FaceURL:               conversation.FaceURL,
+		RecvMsgOpt:            conversation.RecvMsgOpt,
+		UnreadCount:           conversation.UnreadCount,
+		GroupAtType:           conversation.GroupAtType,
+		LatestMsg:             conversation.LatestMsg,
+		LatestMsgSendTime:     conversation.LatestMsgSendTime,
+		DraftText:             conversation.DraftText,
+		DraftTextTime:         conversation.DraftTextTime,
+		IsPinned:              conversation.IsPinned,
+		IsPrivateChat:         conversation.IsPrivateChat,
+		BurnDuration:          conversation.BurnDuration,
+		IsNotInGroup:          conversation.IsNotInGroup,
+		UpdateUnreadCountTime: conversation.UpdateUnreadCountTime,
+		AttachedInfo:          conversation.AttachedInfo,
+		Ex:                    conversation.Ex,
+		MaxSeq:                conversation.MaxSeq,
+		MinSeq:                conversation.MinSeq,
+		MsgDestructTime:       conversation.MsgDestructTime,
+		IsMsgDestruct:         conversation.IsMsgDestruct,
+	}
+}
+
+func toConversations(conversations []*model_struct.LocalConversation) []*pb.Conversation {
+	return datautil.Slice(conversations, toConversation)
+}
+
+// toMessage encodes the element of message as the content, the way it is stored.
+func toMessage(message *sdk_struct.MsgStruct) *pb.Message {
+	return &pb.Message{
+		ClientMsgID:      message.ClientMsgID,
+		ServerMsgID:      message.ServerMsgID,
+		CreateTime:       message.CreateTime,
+		SendTime:         message.SendTime,
+		SessionType:      message.SessionType,
+		SendID:           message.SendID,
+		RecvID:           message.RecvID,
+		MsgFrom:          message.MsgFrom,
+		ContentType:      message.ContentType,
+		SenderPlatformID: message.SenderPlatformID,
+		SenderNickname:   message.SenderNickname,
+		SenderFaceURL:    message.SenderFaceURL,
+		GroupID:          message.GroupID,
+		Content:          conversation_msg.MsgStructToLocalChatLog(message).Content,
+		Seq:              message.Seq,
+		IsRead:           message.IsRead,
+		Status:           message.Status,
+		AttachedInfo:     message.AttachedInfo,
+		Ex:               message.Ex,
+		LocalEx:          message.LocalEx,
+		ThreadRootID:     message.ThreadRootID,
+	}
+}
+
+func toMessages(messages []*sdk_struct.MsgStruct) []*pb.Message {
+	return datautil.Slice(messages, toMessage)
+}
+
+// fromMessage decodes the content of message into the element of its content type.
+func fromMessage(message *pb.Message) *sdk_struct.MsgStruct {
+	msg := conversation_msg.LocalChatLogToMsgStruct(&model_struct.LocalChatLog{
+		ClientMsgID:      message.ClientMsgID,
+		ServerMsgID:      message.ServerMsgID,
+		SendID:           message.SendID,
+		RecvID:           message.RecvID,
+		SenderPlatformID: message.SenderPlatformID,
+		SenderNickname:   message.SenderNickname,
+		SenderFaceURL:    message.SenderFaceURL,
+		SessionType:      message.SessionType,
+		MsgFrom:          message.MsgFrom,
+		ContentType:      message.ContentType,
+		Content:          message.Content,
+		IsRead:           message.IsRead,
+		Status:           message.Status,
+		Seq:              message.Seq,
+		SendTime:         message.SendTime,
+		CreateTime:       message.CreateTime,
+		AttachedInfo:     message.AttachedInfo,
+		Ex:               message.Ex,
+		LocalEx:          message.LocalEx,
+		ThreadRootID:     message.ThreadRootID,
+	})
+	msg.GroupID = message.GroupID
+	msg.AttachedInfo = message.AttachedInfo
+	return msg
+}
+
+func toGroupInfo(group *model_struct.LocalGroup) *pb.GroupInfo {
+	return &pb.GroupInfo{
+		GroupID:                group.GroupID,
+		GroupName:              group.GroupName,
+		Notification:           group.Notification,
+		Introduction:           group.Introduction,
+		FaceURL:                group.FaceURL,
+		CreateTime:             group.CreateTime,
+		Status:                 group.Status,
+		CreatorUserID:          group.CreatorUserID,
+		GroupType:              group.GroupType,
+		OwnerUserID:            group.OwnerUserID,
+		MemberCount:            group.MemberCount,
+		Ex:                     group.Ex,
+		NeedVerification:       group.NeedVerification,
+		LookMemberInfo:         group.LookMemberInfo,
+		ApplyMemberFriend:      group.ApplyMemberFriend,
+		NotificationUpdateTime: group.NotificationUpdateTime,
+		NotificationUserID:     group.NotificationUserID,
+	}
+}
+
+func toGroupInfos(groups []*model_struct.LocalGroup) []*pb.GroupInfo {
+	return datautil.Slice(groups, toGroupInfo)
+}
+
+func toServerGroupInfo(group *sdkws.GroupInfo) *pb.GroupInfo {
+	return &pb.GroupInfo{
+		GroupID:                group.GroupID,
+		GroupName:              group.GroupName,
+		Notification:           group.Notification,
+		Introduction:           group.Introduction,
+		FaceURL:                group.FaceURL,
+		CreateTime:             group.CreateTime,
+		Status:                 group.Status,
+		CreatorUserID:          group.CreatorUserID,
+		GroupType:              group.GroupType,
+		OwnerUserID:            group.OwnerUserID,
+		MemberCount:            int32(group.MemberCount),
+		Ex:                     group.Ex,
+		NeedVerification:       group.NeedVerification,
+		LookMemberInfo:         group.LookMemberInfo,
+		ApplyMemberFriend:      group.ApplyMemberFriend,
+		NotificationUpdateTime: group.NotificationUpdateTime,
+		NotificationUserID:     group.NotificationUserID,
+	}
+}
+
+func fromGroupInfo(group *pb.GroupInfo) *sdkws.GroupInfo {
+	return &sdkws.GroupInfo{
+		GroupID:           group.GroupID,
+		GroupName:         group.GroupName,
+		Notification:      group.Notification,
+		Introduction:      group.Introduction,
+		FaceURL:           group.FaceURL,
+		OwnerUserID:       group.OwnerUserID,
+		Ex:                group.Ex,
+		GroupType:         group.GroupType,
+		NeedVerification:  group.NeedVerification,
+		LookMemberInfo:    group.LookMemberInfo,
+		ApplyMemberFriend: group.ApplyMemberFriend,
+	}
+}
+
+func toGroupMember(member *model_struct.LocalGroupMember) *pb.GroupMember {
+	return &pb.GroupMember{
+		GroupID:        member.GroupID,
+		UserID:         member.UserID,
+		Nickname:       member.Nickname,
+		FaceURL:        member.FaceURL,
+		RoleLevel:      member.RoleLevel,
+		JoinTime:       member.JoinTime,
+		JoinSource:     member.JoinSource,
+		InviterUserID:  member.InviterUserID,
+		MuteEndTime:    member.MuteEndTime,
+		OperatorUserID: member.OperatorUserID,
+		Ex:             member.Ex,
+	}
+}
+
+func toGroupMembers(members []*model_struct.LocalGroupMember) []*pb.GroupMember {
+	return datautil.Slice(members, toGroupMember)
+}
+
+func toFriend(friend *model_struct.LocalFriend) *pb.Friend {
+	return &pb.Friend{
+		OwnerUserID:    friend.OwnerUserID,
+		FriendUserID:   friend.FriendUserID,
+		Remark:         friend.Remark,
+		CreateTime:     friend.CreateTime,
+		AddSource:      friend.AddSource,
+		OperatorUserID: friend.OperatorUserID,
+		Nickname:       friend.Nickname,
+		FaceURL:        friend.FaceURL,
+		Ex:             friend.Ex,
+		IsPinned:       friend.IsPinned,
+	}
+}
+
+func toFriends(friends []*model_struct.LocalFriend) []*pb.Friend {
+	return datautil.Slice(friends, toFriend)
+}
+
+func toFriendApplication(request *model_struct.LocalFriendRequest) *pb.FriendApplication {
+	return &pb.FriendApplication{
+		FromUserID:    request.FromUserID,
+		FromNickname:  request.FromNickname,
+		FromFaceURL:   request.FromFaceURL,
+		ToUserID:      request.ToUserID,
+		ToNickname:    request.ToNickname,
+		ToFaceURL:     request.ToFaceURL,
+		HandleResult:  request.HandleResult,
+		ReqMsg:        request.ReqMsg,
+		CreateTime:    request.CreateTime,
+		HandlerUserID: request.HandlerUserID,
+		HandleMsg:     request.HandleMsg,
+		HandleTime:    request.HandleTime,
+		Ex:            request.Ex,
+	}
+}
+
+func toFriendApplications(requests []*model_struct.LocalFriendRequest) []*pb.FriendApplication {
+	return datautil.Slice(requests, toFriendApplication)
+}
+
+func toBlack(black *model_struct.LocalBlack) *pb.Black {
+	return &pb.Black{
+		OwnerUserID:    black.OwnerUserID,
+		BlockUserID:    black.BlockUserID,
+		Nickname:       black.Nickname,
+		FaceURL:        black.FaceURL,
+		CreateTime:     black.CreateTime,
+		AddSource:      black.AddSource,
+		OperatorUserID: black.OperatorUserID,
+		Ex:             black.Ex,
+	}
+}
+
+func toBlacks(blacks []*model_struct.LocalBlack) []*pb.Black {
+	return datautil.Slice(blacks, toBlack)
+}
+
+func toUserInfo(user *model_struct.LocalUser) *pb.UserInfo {
+	return &pb.UserInfo{
+		UserID:           user.UserID,
+		Nickname:         user.Nickname,
+		FaceURL:          user.FaceURL,
+		CreateTime:       user.CreateTime,
+		Ex:               user.Ex,
+		GlobalRecvMsgOpt: user.GlobalRecvMsgOpt,
+	}
+}
+
+func toPublicUsers(users []*sdk_struct.PublicUser) []*pb.UserInfo {
+	return datautil.Slice(users, func(user *sdk_struct.PublicUser) *pb.UserInfo {
+		return &pb.UserInfo{UserID: user.UserID, Nickname: user.Nickname, FaceURL: user.FaceURL, CreateTime: user.CreateTime, Ex: user.Ex}
+	})
+}
@@ -0,0 +1,143 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ffi is the binary entry point of the SDK for native bindings. A binding sends an
+// FfiRequest naming the function and carrying its protobuf request message, and gets back an
+// FfiResult with the protobuf response message, so it needs neither JSON nor the reflection
+// of open_im_sdk. Listener events are delivered as protobuf frames to an EventHandler.
+package ffi
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	"github.com/openimsdk/openim-sdk-core/v3/client"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/ccontext"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	pb "github.com/openimsdk/openim-sdk-core/v3/proto"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// EventHandler receives the events of the SDK instance of a Dispatcher. It is called from the
+// goroutines of the SDK and must not block.
+type EventHandler interface {
+	// OnConnEvent reports a long connection event, errCode and errMsg are set for failures.
+	OnConnEvent(event pb.Event, errCode int32, errMsg string)
+	// OnConversationEvent reports a conversation listener event as an encoded ConversationEvent.
+	OnConversationEvent(frame []byte)
+	// OnReconnectingEvent reports a failed connection attempt as an encoded Event_Reconnecting.
+	OnReconnectingEvent(frame []byte)
+}
+
+// Dispatcher routes FfiRequests to one SDK instance, created by the InitSDK request.
+type Dispatcher struct {
+	events EventHandler
+
+	mu     sync.RWMutex
+	client *client.Client
+}
+
+// NewDispatcher returns a Dispatcher reporting the events of its instance to events, nil
+// drops them.
+func NewDispatcher(events EventHandler) *Dispatcher {
+	return &Dispatcher{events: events}
+}
+
+// Attach returns a Dispatcher over an initialized client, its InitSDK request fails and the
+// listeners of the client are left to the caller.
+func Attach(c *client.Client) *Dispatcher {
+	return &Dispatcher{client: c}
+}
+
+var defaultDispatcher = NewDispatcher(nil)
+
+// SetEventHandler sets the EventHandler of the default Dispatcher, it must be set before
+// the InitSDK request.
+func SetEventHandler(events EventHandler) {
+	defaultDispatcher.events = events
+}
+
+// Call decodes an FfiRequest, runs it on the default Dispatcher and returns the encoded
+// FfiResult.
+func Call(data []byte) []byte {
+	return defaultDispatcher.CallBytes(data)
+}
+
+// CallBytes decodes an FfiRequest, runs it and returns the encoded FfiResult.
+func (d *Dispatcher) CallBytes(data []byte) []byte {
+	var req pb.FfiRequest
+	var res *pb.FfiResult
+	if err := proto.Unmarshal(data, &req); err != nil {
+		res = errResult(&req, sdkerrs.ErrArgs.WrapMsg("invalid FfiRequest: "+err.Error()))
+	} else {
+		res = d.Call(&req)
+	}
+	out, err := proto.Marshal(res)
+	if err != nil {
+		out, _ = proto.Marshal(errResult(&req, sdkerrs.ErrSdkInternal.WrapMsg("marshal FfiResult: "+err.Error())))
+	}
+	return out
+}
+
+// Call runs the function of req and returns its result, Call blocks until the function
+// returns.
+func (d *Dispatcher) Call(req *pb.FfiRequest) (res *pb.FfiResult) {
+	ctx := context.Background()
+	if req.OperationID != "" {
+		ctx = ccontext.WithOperationID(ctx, req.OperationID)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			log.ZError(ctx, "ffi call panic", nil, "funcName", req.FuncName, "panic", r, "stack", string(debug.Stack()))
+			res = errResult(req, sdkerrs.ErrSdkInternal.WrapMsg(fmt.Sprintf("recover: %+v", r)))
+		}
+	}()
+	fn, ok := funcs[req.FuncName]
+	if !ok {
+		return errResult(req, sdkerrs.ErrArgs.WrapMsg("unknown funcName", "funcName", req.FuncName.String()))
+	}
+	log.ZDebug(ctx, "ffi call", "funcName", req.FuncName, "handleID", req.HandleID)
+	resp, err := fn(ctx, d, req)
+	if err != nil {
+		log.ZWarn(ctx, "ffi call failed", err, "funcName", req.FuncName, "handleID", req.HandleID)
+		return errResult(req, err)
+	}
+	data, err := proto.Marshal(resp)
+	if err != nil {
+		return errResult(req, sdkerrs.ErrSdkInternal.WrapMsg("marshal response: "+err.Error()))
+	}
+	return &pb.FfiResult{FuncName: req.FuncName, Data: data, HandleID: req.HandleID}
+}
+
+func errResult(req *pb.FfiRequest, err error) *pb.FfiResult {
+	res := &pb.FfiResult{FuncName: req.FuncName, HandleID: req.HandleID, ErrCode: sdkerrs.UnknownCode, ErrMsg: err.Error()}
+	if code, ok := errs.Unwrap(err).(errs.CodeError); ok {
+		res.ErrCode = int32(code.Code())
+	}
+	return res
+}
+
+// getClient returns the instance created by InitSDK.
+func (d *Dispatcher) getClient() (*client.Client, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.client == nil {
+		return nil, sdkerrs.ErrResourceLoad.WrapMsg("sdk not initialized, call InitSDK first")
+	}
+	return d.client, nil
+}
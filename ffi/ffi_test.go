@@ -0,0 +1,154 @@
+//go:build !js
+
+package ffi
+
+import (
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	pb "github.com/openimsdk/openim-sdk-core/v3/proto"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"google.golang.org/protobuf/proto"
+)
+
+func request(t *testing.T, funcName pb.FuncRequestEventName, handleID uint64, req proto.Message) *pb.FfiRequest {
+	data, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &pb.FfiRequest{FuncName: funcName, Data: data, HandleID: handleID, OperationID: "ffi_test"}
+}
+
+func TestFuncsCoverEnum(t *testing.T) {
+	for number, name := range pb.FuncRequestEventName_name {
+		if _, ok := funcs[pb.FuncRequestEventName(number)]; !ok && number != int32(pb.FuncRequestEventName_None) {
+			t.Errorf("no handler for %s", name)
+		}
+	}
+}
+
+func TestCall(t *testing.T) {
+	var res pb.FfiResult
+	if err := proto.Unmarshal(Call([]byte{0xff}), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.ErrCode != sdkerrs.ArgsError {
+		t.Fatalf("invalid request: %v", &res)
+	}
+
+	d := NewDispatcher(nil)
+	if res := d.Call(&pb.FfiRequest{FuncName: 1000, HandleID: 7}); res.ErrCode != sdkerrs.ArgsError || res.HandleID != 7 {
+		t.Fatalf("unknown function: %v", res)
+	}
+	if res := d.Call(request(t, pb.FuncRequestEventName_GetAllConversationList, 8, &pb.GetAllConversationListReq{})); res.ErrCode != sdkerrs.ResourceLoadNotCompleteError {
+		t.Fatalf("call before InitSDK: %v", res)
+	}
+
+	config := &pb.IMConfig{PlatformID: pb.PlatformId_ios, ApiAddr: "http://127.0.0.1:10002", WsAddr: "ws://127.0.0.1:10001", DataDir: t.TempDir()}
+	res2 := d.Call(request(t, pb.FuncRequestEventName_InitSDK, 9, &pb.InitSDKReq{Config: config}))
+	if res2.ErrCode != 0 || res2.HandleID != 9 || res2.FuncName != pb.FuncRequestEventName_InitSDK {
+		t.Fatalf("InitSDK: %v", res2)
+	}
+	t.Cleanup(func() { _ = d.client.Close() })
+	var initResp pb.InitSDKResp
+	if err := proto.Unmarshal(res2.Data, &initResp); err != nil || !initResp.Suc {
+		t.Fatalf("InitSDK response: %v %v", &initResp, err)
+	}
+	if res := d.Call(request(t, pb.FuncRequestEventName_InitSDK, 10, &pb.InitSDKReq{Config: config})); res.ErrCode != sdkerrs.ArgsError {
+		t.Fatalf("second InitSDK: %v", res)
+	}
+
+	res3 := d.Call(request(t, pb.FuncRequestEventName_GetLoginStatus, 11, &pb.GetLoginStatusReq{}))
+	var status pb.GetLoginStatusResp
+	if err := proto.Unmarshal(res3.Data, &status); err != nil || status.Status != open_im_sdk.LogoutStatus {
+		t.Fatalf("GetLoginStatus: %v %v", res3, err)
+	}
+	if res := d.Call(request(t, pb.FuncRequestEventName_GetAllConversationList, 12, &pb.GetAllConversationListReq{})); res.ErrCode != sdkerrs.LoginOutError {
+		t.Fatalf("call before Login: %v", res)
+	}
+	if res := d.Call(&pb.FfiRequest{FuncName: pb.FuncRequestEventName_Login, Data: []byte{0xff}}); res.ErrCode != sdkerrs.ArgsError {
+		t.Fatalf("invalid LoginReq: %v", res)
+	}
+}
+
+func TestMessageConversion(t *testing.T) {
+	message := &sdk_struct.MsgStruct{ClientMsgID: "m1", SessionType: constant.ReadGroupChatType, SendID: "u1", RecvID: "g1", GroupID: "g1",
+		ContentType: constant.Text, TextElem: &sdk_struct.TextElem{Content: "hello"}, Seq: 3}
+	pbMessage := toMessage(message)
+	if pbMessage.Content != `{"content":"hello"}` {
+		t.Fatalf("content %s", pbMessage.Content)
+	}
+	data, err := proto.Marshal(pbMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded pb.Message
+	if err := proto.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	msg := fromMessage(&decoded)
+	if msg.TextElem == nil || msg.TextElem.Content != "hello" || msg.GroupID != "g1" || msg.Seq != 3 {
+		t.Fatalf("message %+v", msg)
+	}
+}
+
+type eventRecorder struct {
+	frames       [][]byte
+	reconnecting [][]byte
+}
+
+func (r *eventRecorder) OnConnEvent(pb.Event, int32, string) {}
+
+func (r *eventRecorder) OnConversationEvent(frame []byte) {
+	r.frames = append(r.frames, frame)
+}
+
+func (r *eventRecorder) OnReconnectingEvent(frame []byte) {
+	r.reconnecting = append(r.reconnecting, frame)
+}
+
+func TestConversationEvents(t *testing.T) {
+	recorder := &eventRecorder{}
+	l := &conversationListener{events: recorder, handle: 5}
+	l.OnNewConversation([]*model_struct.LocalConversation{{ConversationID: "c1", UnreadCount: 2}})
+	l.OnTotalUnreadMessageCountChanged(2)
+	if len(recorder.frames) != 2 {
+		t.Fatalf("frames %d", len(recorder.frames))
+	}
+	var event pb.ConversationEvent
+	if err := proto.Unmarshal(recorder.frames[0], &event); err != nil {
+		t.Fatal(err)
+	}
+	conversations := event.GetNewConversation().GetConversationList()
+	if event.Handle != 5 || len(conversations) != 1 || conversations[0].ConversationID != "c1" || conversations[0].UnreadCount != 2 {
+		t.Fatalf("new conversation event %v", &event)
+	}
+	if err := proto.Unmarshal(recorder.frames[1], &event); err != nil {
+		t.Fatal(err)
+	}
+	if event.GetUnreadCountChanged().GetTotalUnreadCount() != 2 {
+		t.Fatalf("unread count event %v", &event)
+	}
+}
+
+func TestReconnectingEvent(t *testing.T) {
+	recorder := &eventRecorder{}
+	l := &connListener{events: recorder}
+	l.OnReconnecting(3, 4000)
+	l.OnReconnecting(4, -1)
+	if len(recorder.reconnecting) != 2 {
+		t.Fatalf("frames %d", len(recorder.reconnecting))
+	}
+	for i, want := range []*pb.Event_Reconnecting{{Attempt: 3, DelayMs: 4000}, {Attempt: 4, DelayMs: -1}} {
+		var event pb.Event_Reconnecting
+		if err := proto.Unmarshal(recorder.reconnecting[i], &event); err != nil {
+			t.Fatal(err)
+		}
+		if event.Attempt != want.Attempt || event.DelayMs != want.DelayMs {
+			t.Fatalf("reconnecting event %d %v, want %v", i, &event, want)
+		}
+	}
+}
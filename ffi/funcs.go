@@ -0,0 +1,265 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ffi
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/client"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	pb "github.com/openimsdk/openim-sdk-core/v3/proto"
+	"github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/relation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/protocol/wrapperspb"
+	"google.golang.org/protobuf/proto"
+)
+
+type handler func(ctx context.Context, d *Dispatcher, req *pb.FfiRequest) (proto.Message, error)
+
+// call returns the handler of a function of the instance, it decodes the request message
+// of the function and calls fn with it.
+func call[Req any, PReq interface {
+	*Req
+	proto.Message
+}](fn func(ctx context.Context, c *client.Client, req PReq) (proto.Message, error)) handler {
+	return func(ctx context.Context, d *Dispatcher, ffiReq *pb.FfiRequest) (proto.Message, error) {
+		c, err := d.getClient()
+		if err != nil {
+			return nil, err
+		}
+		req := PReq(new(Req))
+		if err := proto.Unmarshal(ffiReq.Data, req); err != nil {
+			return nil, sdkerrs.ErrArgs.WrapMsg("invalid " + string(req.ProtoReflect().Descriptor().Name()) + ": " + err.Error())
+		}
+		return fn(ctx, c, req)
+	}
+}
+
+var funcs = map[pb.FuncRequestEventName]handler{
+	pb.FuncRequestEventName_InitSDK: initSDK,
+	pb.FuncRequestEventName_Login: call(func(ctx context.Context, c *client.Client, req *pb.LoginReq) (proto.Message, error) {
+		return &pb.LoginResp{}, c.Login(ctx, req.UserID, req.Token)
+	}),
+	pb.FuncRequestEventName_Logout: call(func(ctx context.Context, c *client.Client, req *pb.LogoutReq) (proto.Message, error) {
+		return &pb.LogoutResp{}, c.Logout(ctx)
+	}),
+	pb.FuncRequestEventName_GetLoginStatus: call(func(ctx context.Context, c *client.Client, req *pb.GetLoginStatusReq) (proto.Message, error) {
+		return &pb.GetLoginStatusResp{Status: int32(c.LoginStatus())}, nil
+	}),
+
+	// conversation
+	pb.FuncRequestEventName_GetAllConversationList: call(func(ctx context.Context, c *client.Client, req *pb.GetAllConversationListReq) (proto.Message, error) {
+		conversations, err := c.GetAllConversationList(ctx)
+		return &pb.GetAllConversationListResp{ConversationList: toConversations(conversations)}, err
+	}),
+	pb.FuncRequestEventName_GetConversationList: call(func(ctx context.Context, c *client.Client, req *pb.GetConversationListReq) (proto.Message, error) {
+		conversations, err := c.GetConversationListSplit(ctx, int(req.Offset), int(req.Count))
+		return &pb.GetConversationListResp{ConversationList: toConversations(conversations)}, err
+	}),
+	pb.FuncRequestEventName_GetConversation: call(func(ctx context.Context, c *client.Client, req *pb.GetConversationReq) (proto.Message, error) {
+		conversation, err := c.GetOneConversation(ctx, req.SessionType, req.SourceID)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.GetConversationResp{Conversation: toConversation(conversation)}, nil
+	}),
+	pb.FuncRequestEventName_GetMultipleConversation: call(func(ctx context.Context, c *client.Client, req *pb.GetMultipleConversationReq) (proto.Message, error) {
+		conversations, err := c.GetMultipleConversation(ctx, req.ConversationIDs)
+		return &pb.GetMultipleConversationResp{ConversationList: toConversations(conversations)}, err
+	}),
+	pb.FuncRequestEventName_SetConversationDraft: call(func(ctx context.Context, c *client.Client, req *pb.SetConversationDraftReq) (proto.Message, error) {
+		return &pb.SetConversationDraftResp{}, c.SetConversationDraft(ctx, req.ConversationID, req.DraftText)
+	}),
+	pb.FuncRequestEventName_HideConversation: call(func(ctx context.Context, c *client.Client, req *pb.HideConversationReq) (proto.Message, error) {
+		return &pb.HideConversationResp{}, c.HideConversation(ctx, req.ConversationID)
+	}),
+	pb.FuncRequestEventName_GetTotalUnreadMsgCount: call(func(ctx context.Context, c *client.Client, req *pb.GetTotalUnreadMsgCountReq) (proto.Message, error) {
+		count, err := c.GetTotalUnreadMsgCount(ctx)
+		return &pb.GetTotalUnreadMsgCountResp{TotalUnreadCount: count}, err
+	}),
+	pb.FuncRequestEventName_MarkConversationMessageAsRead: call(func(ctx context.Context, c *client.Client, req *pb.MarkConversationMessageAsReadReq) (proto.Message, error) {
+		return &pb.MarkConversationMessageAsReadResp{}, c.MarkConversationMessageAsRead(ctx, req.ConversationID)
+	}),
+	pb.FuncRequestEventName_DeleteConversationAndDeleteAllMsg: call(func(ctx context.Context, c *client.Client, req *pb.DeleteConversationAndDeleteAllMsgReq) (proto.Message, error) {
+		return &pb.DeleteConversationAndDeleteAllMsgResp{}, c.DeleteConversationAndDeleteAllMsg(ctx, req.ConversationID)
+	}),
+	pb.FuncRequestEventName_GetConversationIDBySessionType: call(func(ctx context.Context, c *client.Client, req *pb.GetConversationIDBySessionTypeReq) (proto.Message, error) {
+		conversationID, err := c.GetConversationIDBySessionType(ctx, req.SourceID, int(req.SessionType))
+		return &pb.GetConversationIDBySessionTypeResp{ConversationID: conversationID}, err
+	}),
+
+	// message
+	pb.FuncRequestEventName_CreateTextMessage: call(func(ctx context.Context, c *client.Client, req *pb.CreateTextMessageReq) (proto.Message, error) {
+		message, err := c.CreateTextMessage(ctx, req.Text)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.CreateTextMessageResp{Message: toMessage(message)}, nil
+	}),
+	pb.FuncRequestEventName_SendMessage: call(func(ctx context.Context, c *client.Client, req *pb.SendMessageReq) (proto.Message, error) {
+		if req.Message == nil {
+			return nil, sdkerrs.ErrArgs.WrapMsg("message is nil")
+		}
+		var offlinePushInfo *sdkws.OfflinePushInfo
+		if info := req.OfflinePushInfo; info != nil {
+			offlinePushInfo = &sdkws.OfflinePushInfo{Title: info.Title, Desc: info.Desc, Ex: info.Ex, IOSPushSound: info.IOSPushSound, IOSBadgeCount: info.IOSBadgeCount}
+		}
+		message, err := c.SendMessage(ctx, fromMessage(req.Message), req.RecvID, req.GroupID, offlinePushInfo, req.IsOnlineOnly, nil)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.SendMessageResp{Message: toMessage(message)}, nil
+	}),
+	pb.FuncRequestEventName_GetAdvancedHistoryMessageList: call(func(ctx context.Context, c *client.Client, req *pb.GetAdvancedHistoryMessageListReq) (proto.Message, error) {
+		res, err := c.GetAdvancedHistoryMessageList(ctx, sdk_params_callback.GetAdvancedHistoryMessageListParams{ConversationID: req.ConversationID,
+			StartClientMsgID: req.StartClientMsgID, Count: int(req.Count), ViewType: int(req.ViewType)})
+		if err != nil {
+			return nil, err
+		}
+		return &pb.GetAdvancedHistoryMessageListResp{MessageList: toMessages(res.MessageList), IsEnd: res.IsEnd}, nil
+	}),
+	pb.FuncRequestEventName_RevokeMessage: call(func(ctx context.Context, c *client.Client, req *pb.RevokeMessageReq) (proto.Message, error) {
+		return &pb.RevokeMessageResp{}, c.RevokeMessage(ctx, req.ConversationID, req.ClientMsgID)
+	}),
+	pb.FuncRequestEventName_DeleteMessage: call(func(ctx context.Context, c *client.Client, req *pb.DeleteMessageReq) (proto.Message, error) {
+		return &pb.DeleteMessageResp{}, c.DeleteMessage(ctx, req.ConversationID, req.ClientMsgID)
+	}),
+	pb.FuncRequestEventName_MarkMessagesAsReadByMsgID: call(func(ctx context.Context, c *client.Client, req *pb.MarkMessagesAsReadByMsgIDReq) (proto.Message, error) {
+		return &pb.MarkMessagesAsReadByMsgIDResp{}, c.MarkMessagesAsReadByMsgID(ctx, req.ConversationID, req.ClientMsgIDs)
+	}),
+
+	// group
+	pb.FuncRequestEventName_CreateGroup: call(func(ctx context.Context, c *client.Client, req *pb.CreateGroupReq) (proto.Message, error) {
+		if req.GroupInfo == nil {
+			return nil, sdkerrs.ErrArgs.WrapMsg("groupInfo is nil")
+		}
+		groupInfo, err := c.CreateGroup(ctx, &group.CreateGroupReq{GroupInfo: fromGroupInfo(req.GroupInfo), MemberUserIDs: req.MemberUserIDs, AdminUserIDs: req.AdminUserIDs})
+		if err != nil {
+			return nil, err
+		}
+		return &pb.CreateGroupResp{GroupInfo: toServerGroupInfo(groupInfo)}, nil
+	}),
+	pb.FuncRequestEventName_JoinGroup: call(func(ctx context.Context, c *client.Client, req *pb.JoinGroupReq) (proto.Message, error) {
+		return &pb.JoinGroupResp{}, c.JoinGroup(ctx, req.GroupID, req.ReqMsg, req.JoinSource, req.Ex)
+	}),
+	pb.FuncRequestEventName_QuitGroup: call(func(ctx context.Context, c *client.Client, req *pb.QuitGroupReq) (proto.Message, error) {
+		return &pb.QuitGroupResp{}, c.QuitGroup(ctx, req.GroupID)
+	}),
+	pb.FuncRequestEventName_DismissGroup: call(func(ctx context.Context, c *client.Client, req *pb.DismissGroupReq) (proto.Message, error) {
+		return &pb.DismissGroupResp{}, c.DismissGroup(ctx, req.GroupID)
+	}),
+	pb.FuncRequestEventName_GetJoinedGroupList: call(func(ctx context.Context, c *client.Client, req *pb.GetJoinedGroupListReq) (proto.Message, error) {
+		groups, err := c.GetJoinedGroupList(ctx)
+		return &pb.GetJoinedGroupListResp{GroupList: toGroupInfos(groups)}, err
+	}),
+	pb.FuncRequestEventName_GetSpecifiedGroupsInfo: call(func(ctx context.Context, c *client.Client, req *pb.GetSpecifiedGroupsInfoReq) (proto.Message, error) {
+		groups, err := c.GetSpecifiedGroupsInfo(ctx, req.GroupIDs)
+		return &pb.GetSpecifiedGroupsInfoResp{GroupList: toGroupInfos(groups)}, err
+	}),
+	pb.FuncRequestEventName_GetGroupMemberList: call(func(ctx context.Context, c *client.Client, req *pb.GetGroupMemberListReq) (proto.Message, error) {
+		members, err := c.GetGroupMemberList(ctx, req.GroupID, req.Filter, req.Offset, req.Count)
+		return &pb.GetGroupMemberListResp{MemberList: toGroupMembers(members)}, err
+	}),
+	pb.FuncRequestEventName_InviteUserToGroup: call(func(ctx context.Context, c *client.Client, req *pb.InviteUserToGroupReq) (proto.Message, error) {
+		return &pb.InviteUserToGroupResp{}, c.InviteUserToGroup(ctx, req.GroupID, req.Reason, req.UserIDs)
+	}),
+	pb.FuncRequestEventName_KickGroupMember: call(func(ctx context.Context, c *client.Client, req *pb.KickGroupMemberReq) (proto.Message, error) {
+		return &pb.KickGroupMemberResp{}, c.KickGroupMember(ctx, req.GroupID, req.Reason, req.UserIDs)
+	}),
+
+	// friend
+	pb.FuncRequestEventName_GetFriendList: call(func(ctx context.Context, c *client.Client, req *pb.GetFriendListReq) (proto.Message, error) {
+		friends, err := c.GetFriendList(ctx, req.FilterBlack)
+		return &pb.GetFriendListResp{FriendList: toFriends(friends)}, err
+	}),
+	pb.FuncRequestEventName_AddFriend: call(func(ctx context.Context, c *client.Client, req *pb.AddFriendReq) (proto.Message, error) {
+		return &pb.AddFriendResp{}, c.AddFriend(ctx, &relation.ApplyToAddFriendReq{ToUserID: req.ToUserID, ReqMsg: req.ReqMsg, Ex: req.Ex})
+	}),
+	pb.FuncRequestEventName_DeleteFriend: call(func(ctx context.Context, c *client.Client, req *pb.DeleteFriendReq) (proto.Message, error) {
+		return &pb.DeleteFriendResp{}, c.DeleteFriend(ctx, req.FriendUserID)
+	}),
+	pb.FuncRequestEventName_AcceptFriendApplication: call(func(ctx context.Context, c *client.Client, req *pb.AcceptFriendApplicationReq) (proto.Message, error) {
+		return &pb.AcceptFriendApplicationResp{}, c.AcceptFriendApplication(ctx, &sdk_params_callback.ProcessFriendApplicationParams{ToUserID: req.ToUserID, HandleMsg: req.HandleMsg})
+	}),
+	pb.FuncRequestEventName_RefuseFriendApplication: call(func(ctx context.Context, c *client.Client, req *pb.RefuseFriendApplicationReq) (proto.Message, error) {
+		return &pb.RefuseFriendApplicationResp{}, c.RefuseFriendApplication(ctx, &sdk_params_callback.ProcessFriendApplicationParams{ToUserID: req.ToUserID, HandleMsg: req.HandleMsg})
+	}),
+	pb.FuncRequestEventName_GetFriendApplicationListAsRecipient: call(func(ctx context.Context, c *client.Client, req *pb.GetFriendApplicationListAsRecipientReq) (proto.Message, error) {
+		applications, err := c.GetFriendApplicationListAsRecipient(ctx)
+		return &pb.GetFriendApplicationListAsRecipientResp{ApplicationList: toFriendApplications(applications)}, err
+	}),
+	pb.FuncRequestEventName_AddBlack: call(func(ctx context.Context, c *client.Client, req *pb.AddBlackReq) (proto.Message, error) {
+		return &pb.AddBlackResp{}, c.AddBlack(ctx, req.BlackUserID, req.Ex)
+	}),
+	pb.FuncRequestEventName_RemoveBlack: call(func(ctx context.Context, c *client.Client, req *pb.RemoveBlackReq) (proto.Message, error) {
+		return &pb.RemoveBlackResp{}, c.RemoveBlack(ctx, req.BlackUserID)
+	}),
+	pb.FuncRequestEventName_GetBlackList: call(func(ctx context.Context, c *client.Client, req *pb.GetBlackListReq) (proto.Message, error) {
+		blacks, err := c.GetBlackList(ctx)
+		return &pb.GetBlackListResp{BlackList: toBlacks(blacks)}, err
+	}),
+
+	// user
+	pb.FuncRequestEventName_GetSelfUserInfo: call(func(ctx context.Context, c *client.Client, req *pb.GetSelfUserInfoReq) (proto.Message, error) {
+		user, err := c.GetSelfUserInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.GetSelfUserInfoResp{UserInfo: toUserInfo(user)}, nil
+	}),
+	pb.FuncRequestEventName_SetSelfInfo: call(func(ctx context.Context, c *client.Client, req *pb.SetSelfInfoReq) (proto.Message, error) {
+		userInfo := &sdkws.UserInfoWithEx{UserID: c.UserID()}
+		if req.Nickname != "" {
+			userInfo.Nickname = wrapperspb.String(req.Nickname)
+		}
+		if req.FaceURL != "" {
+			userInfo.FaceURL = wrapperspb.String(req.FaceURL)
+		}
+		if req.Ex != "" {
+			userInfo.Ex = wrapperspb.String(req.Ex)
+		}
+		return &pb.SetSelfInfoResp{}, c.SetSelfInfo(ctx, userInfo)
+	}),
+	pb.FuncRequestEventName_GetUsersInfo: call(func(ctx context.Context, c *client.Client, req *pb.GetUsersInfoReq) (proto.Message, error) {
+		users, err := c.GetUsersInfo(ctx, req.UserIDs)
+		return &pb.GetUsersInfoResp{UserList: toPublicUsers(users)}, err
+	}),
+}
+
+// initSDK creates the instance of the Dispatcher, the conversation events carry the handleID
+// of the request.
+func initSDK(ctx context.Context, d *Dispatcher, ffiReq *pb.FfiRequest) (proto.Message, error) {
+	var req pb.InitSDKReq
+	if err := proto.Unmarshal(ffiReq.Data, &req); err != nil {
+		return nil, sdkerrs.ErrArgs.WrapMsg("invalid InitSDKReq: " + err.Error())
+	}
+	if req.Config == nil {
+		return nil, sdkerrs.ErrArgs.WrapMsg("config is nil")
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.client != nil {
+		return nil, sdkerrs.ErrArgs.WrapMsg("sdk already initialized")
+	}
+	c, err := client.New(fromIMConfig(req.Config), &connListener{events: d.events})
+	if err != nil {
+		return nil, err
+	}
+	c.SetConversationListener(&conversationListener{events: d.events, handle: int64(ffiReq.HandleID)})
+	d.client = c
+	return &pb.InitSDKResp{Suc: true}, nil
+}
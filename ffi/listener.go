@@ -0,0 +1,127 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ffi
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	pb "github.com/openimsdk/openim-sdk-core/v3/proto"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/tools/log"
+	"google.golang.org/protobuf/proto"
+)
+
+type connListener struct {
+	events EventHandler
+}
+
+func (l *connListener) emit(event pb.Event, errCode int32, errMsg string) {
+	if l.events != nil {
+		l.events.OnConnEvent(event, errCode, errMsg)
+	}
+}
+
+func (l *connListener) OnConnecting() {
+	l.emit(pb.Event_OnConnecting, 0, "")
+}
+
+func (l *connListener) OnConnectSuccess() {
+	l.emit(pb.Event_OnConnectSuccess, 0, "")
+}
+
+func (l *connListener) OnConnectFailed(errCode int32, errMsg string) {
+	l.emit(pb.Event_OnConnectFailed, errCode, errMsg)
+}
+
+func (l *connListener) OnKickedOffline() {
+	l.emit(pb.Event_OnKickedOffline, 0, "")
+}
+
+func (l *connListener) OnUserTokenExpired() {
+	l.emit(pb.Event_OnUserTokenExpired, 0, "")
+}
+
+func (l *connListener) OnUserTokenInvalid(errMsg string) {
+	l.emit(pb.Event_OnUserTokenInvalid, 0, errMsg)
+}
+
+func (l *connListener) OnReconnecting(attempt int32, delayMs int64) {
+	if l.events == nil {
+		return
+	}
+	frame, err := proto.Marshal(&pb.Event_Reconnecting{Attempt: attempt, DelayMs: delayMs})
+	if err != nil {
+		log.ZError(context.Background(), "marshal Event_Reconnecting failed", err)
+		return
+	}
+	l.events.OnReconnectingEvent(frame)
+}
+
+// conversationListener emits the conversation events as ConversationEvent frames.
+type conversationListener struct {
+	events EventHandler
+	handle int64
+}
+
+func (l *conversationListener) emit(event *pb.ConversationEvent) {
+	if l.events == nil {
+		return
+	}
+	event.Handle = l.handle
+	frame, err := proto.Marshal(event)
+	if err != nil {
+		log.ZError(context.Background(), "marshal ConversationEvent failed", err)
+		return
+	}
+	l.events.OnConversationEvent(frame)
+}
+
+func (l *conversationListener) OnSyncServerStart(reinstalled bool) {
+	l.emit(&pb.ConversationEvent{Message: &pb.ConversationEvent_SyncServerStart{SyncServerStart: &pb.Event_SyncServerStart{Reinstalled: reinstalled}}})
+}
+
+func (l *conversationListener) OnSyncServerFinish(reinstalled bool) {
+	l.emit(&pb.ConversationEvent{Message: &pb.ConversationEvent_SyncServerFinish{SyncServerFinish: &pb.Event_SyncServerFinish{Reinstalled: reinstalled}}})
+}
+
+func (l *conversationListener) OnSyncServerProgress(progress int) {
+	l.emit(&pb.ConversationEvent{Message: &pb.ConversationEvent_SyncServerProgress{SyncServerProgress: &pb.Event_SyncServerProgress{Progress: int32(progress)}}})
+}
+
+func (l *conversationListener) OnSyncServerFailed(reinstalled bool) {
+	l.emit(&pb.ConversationEvent{Message: &pb.ConversationEvent_SyncServerFailed{SyncServerFailed: &pb.Event_SyncServerFailed{Reinstalled: reinstalled}}})
+}
+
+func (l *conversationListener) OnNewConversation(conversationList []*model_struct.LocalConversation) {
+	l.emit(&pb.ConversationEvent{Message: &pb.ConversationEvent_NewConversation{NewConversation: &pb.Event_NewConversation{ConversationList: toConversations(conversationList)}}})
+}
+
+func (l *conversationListener) OnConversationChanged(conversationList []*model_struct.LocalConversation) {
+	l.emit(&pb.ConversationEvent{Message: &pb.ConversationEvent_ConversationChanged{ConversationChanged: &pb.Event_ConversationChanged{ConversationList: toConversations(conversationList)}}})
+}
+
+func (l *conversationListener) OnTotalUnreadMessageCountChanged(totalUnreadCount int32) {
+	l.emit(&pb.ConversationEvent{Message: &pb.ConversationEvent_UnreadCountChanged{UnreadCountChanged: &pb.Event_UnreadCountChanged{TotalUnreadCount: totalUnreadCount}}})
+}
+
+func (l *conversationListener) OnConversationUserInputStatusChanged(change sdk_struct.InputStatesChangedData) {
+	l.emit(&pb.ConversationEvent{Message: &pb.ConversationEvent_UserInputStatusChanged{UserInputStatusChanged: &pb.Event_UserInputStatusChanged{
+		ConversationID: change.ConversationID, UserID: change.UserID, PlatformIDs: change.PlatformIDs}}})
+}
+
+func (l *conversationListener) OnUnreadMentionCountChanged(totalUnreadCount int32) {
+	l.emit(&pb.ConversationEvent{Message: &pb.ConversationEvent_UnreadMentionCountChanged{UnreadMentionCountChanged: &pb.Event_UnreadMentionCountChanged{TotalUnreadCount: totalUnreadCount}}})
+}
@@ -28,6 +28,9 @@ func (c *conversationCallBack) OnConversationChanged(conversationList string) {
 func (c *conversationCallBack) OnTotalUnreadMessageCountChanged(totalUnreadCount int32) {
 }
 
+func (c *conversationCallBack) OnUnreadMentionCountChanged(totalUnreadCount int32) {
+}
+
 func (c *conversationCallBack) OnRecvMessageExtensionsChanged(msgID string, reactionExtensionList string) {
 }
 
@@ -118,6 +121,22 @@ func (m *MsgListenerCallBak) OnMsgEdited(message string) {
 
 }
 
+func (m *MsgListenerCallBak) OnScheduledMessageSent(message string) {
+
+}
+
+func (m *MsgListenerCallBak) OnScheduledMessageFailed(message string, errCode int32, errMsg string) {
+
+}
+
+func (m *MsgListenerCallBak) OnOutboxMessageStatusChanged(message string, status int32, attempts int32) {
+
+}
+
+func (m *MsgListenerCallBak) OnMsgPinChanged(pinChange string) {
+
+}
+
 func (m *MsgListenerCallBak) OnRecvC2CReadReceipt(msgReceiptList string) {
 }
 
@@ -258,6 +277,10 @@ func (t *testConnListener) OnKickedOffline() {
 	log.ZError(context.TODO(), "kicked offline", errs.New("kicked offline").Wrap(), "userID", t.UserID)
 }
 
+func (t *testConnListener) OnReconnecting(attempt int32, delayMs int64) {
+	log.ZWarn(context.TODO(), "reconnecting", nil, "userID", t.UserID, "attempt", attempt, "delayMs", delayMs)
+}
+
 func (t *testConnListener) OnSelfInfoUpdated(info string) {
 
 }
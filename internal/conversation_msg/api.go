@@ -43,6 +43,38 @@ func (c *Conversation) GetConversationListSplit(ctx context.Context, offset, cou
 	return c.db.GetConversationListSplitDB(ctx, offset, count)
 }
 
+func (c *Conversation) CreateConversationLabel(ctx context.Context, name string) (*sdk_params_callback.ConversationLabel, error) {
+	return c.createConversationLabel(ctx, name)
+}
+
+func (c *Conversation) RenameConversationLabel(ctx context.Context, labelID, name string) error {
+	return c.renameConversationLabel(ctx, labelID, name)
+}
+
+func (c *Conversation) DeleteConversationLabel(ctx context.Context, labelID string) error {
+	return c.deleteConversationLabel(ctx, labelID)
+}
+
+func (c *Conversation) ReorderConversationLabels(ctx context.Context, labelIDs []string) error {
+	return c.reorderConversationLabels(ctx, labelIDs)
+}
+
+func (c *Conversation) AddConversationsToLabel(ctx context.Context, labelID string, conversationIDs []string) error {
+	return c.addConversationsToLabel(ctx, labelID, conversationIDs)
+}
+
+func (c *Conversation) RemoveConversationsFromLabel(ctx context.Context, labelID string, conversationIDs []string) error {
+	return c.removeConversationsFromLabel(ctx, labelID, conversationIDs)
+}
+
+func (c *Conversation) GetConversationLabels(ctx context.Context) ([]*sdk_params_callback.ConversationLabel, error) {
+	return c.getConversationLabels(ctx)
+}
+
+func (c *Conversation) GetConversationListByLabel(ctx context.Context, labelID string) ([]*model_struct.LocalConversation, error) {
+	return c.getConversationListByLabel(ctx, labelID)
+}
+
 func (c *Conversation) HideConversation(ctx context.Context, conversationID string) error {
 	err := c.db.ResetConversation(ctx, conversationID)
 	if err != nil {
@@ -154,6 +186,10 @@ func (c *Conversation) updateMsgStatusAndTriggerConversation(ctx context.Context
 	if isOnlineOnly {
 		return
 	}
+	// the outbox decides whether a failed send of a queued message is retried
+	if status == constant.MsgStatusSendFailed && isOutboxSend(ctx) {
+		return
+	}
 	s.SendTime = sendTime
 	s.Status = status
 	s.ServerMsgID = serverMsgID
@@ -218,6 +254,9 @@ func (c *Conversation) checkID(ctx context.Context, s *sdk_struct.MsgStruct,
 		}
 		var attachedInfo sdk_struct.AttachedInfoElem
 		attachedInfo.GroupHasReadInfo.GroupMemberCount = g.MemberCount
+		if s.AttachedInfoElem != nil {
+			attachedInfo.GroupHasReadInfo.NeedReadReceipt = s.AttachedInfoElem.GroupHasReadInfo.NeedReadReceipt
+		}
 		s.AttachedInfoElem = &attachedInfo
 	} else {
 		s.SessionType = constant.SingleChatType
@@ -306,7 +345,7 @@ func (c *Conversation) SendMessage(ctx context.Context, s *sdk_struct.MsgStruct,
 			if err != nil {
 				return nil, err
 			}
-		} else {
+		} else if !isOutboxSend(ctx) { // a queued message is already stored as sending
 			if oldMessage.Status != constant.MsgStatusSendFailed {
 				return nil, sdkerrs.ErrMsgRepeated
 			} else {
@@ -324,6 +363,9 @@ func (c *Conversation) SendMessage(ctx context.Context, s *sdk_struct.MsgStruct,
 		log.ZDebug(ctx, "send message come here", "conversion", *lc)
 		_ = common.TriggerCmdUpdateConversation(ctx, common.UpdateConNode{ConID: lc.ConversationID, Action: constant.AddConOrUpLatMsg, Args: *lc}, c.GetCh())
 	}
+	if !isOnlineOnly && c.shouldQueue(ctx, lc.ConversationID) {
+		return c.queueMessage(ctx, s, lc, recvID, groupID, p, callback)
+	}
 
 	var delFile []string
 	//media file handle
@@ -564,7 +606,7 @@ func (c *Conversation) sendMessageNotOss(ctx context.Context, s *sdk_struct.MsgS
 			if err != nil {
 				return nil, err
 			}
-		} else {
+		} else if !isOutboxSend(ctx) { // a queued message is already stored as sending
 			if oldMessage.Status != constant.MsgStatusSendFailed {
 				return nil, sdkerrs.ErrMsgRepeated
 			} else {
@@ -580,6 +622,9 @@ func (c *Conversation) sendMessageNotOss(ctx context.Context, s *sdk_struct.MsgS
 		}
 	}
 	lc.LatestMsg = utils.StructToJsonString(s)
+	if !isOnlineOnly && c.shouldQueue(ctx, lc.ConversationID) {
+		return c.queueMessage(ctx, s, lc, recvID, groupID, p, callback)
+	}
 	var delFile []string
 	switch s.ContentType {
 	case constant.Picture:
@@ -761,10 +806,59 @@ func (c *Conversation) GetAdvancedHistoryMessageListReverse(ctx context.Context,
 	return result, nil
 }
 
+func (c *Conversation) GetThreadMessages(ctx context.Context, req sdk_params_callback.GetThreadMessagesParams) (*sdk_params_callback.GetThreadMessagesCallback, error) {
+	return c.getThreadMessages(ctx, req)
+}
+
 func (c *Conversation) RevokeMessage(ctx context.Context, conversationID, clientMsgID string) error {
 	return c.revokeOneMessage(ctx, conversationID, clientMsgID)
 }
 
+func (c *Conversation) EditMessage(ctx context.Context, conversationID, clientMsgID, newContent string) (*sdk_struct.MsgStruct, error) {
+	return c.editOneMessage(ctx, conversationID, clientMsgID, newContent)
+}
+
+func (c *Conversation) AddMessageReaction(ctx context.Context, conversationID, clientMsgID string, reactionType int, info string) (*sdk_params_callback.MessageReactions, error) {
+	return c.setMessageReaction(ctx, conversationID, clientMsgID, reactionType, info, false)
+}
+
+func (c *Conversation) RemoveMessageReaction(ctx context.Context, conversationID, clientMsgID string, reactionType int) (*sdk_params_callback.MessageReactions, error) {
+	return c.setMessageReaction(ctx, conversationID, clientMsgID, reactionType, "", true)
+}
+
+func (c *Conversation) GetMessageReactions(ctx context.Context, conversationID string, clientMsgIDs []string) ([]*sdk_params_callback.MessageReactions, error) {
+	return c.getMessageReactions(ctx, conversationID, clientMsgIDs)
+}
+
+func (c *Conversation) PinMessage(ctx context.Context, conversationID, clientMsgID string) error {
+	return c.setMessagePin(ctx, conversationID, clientMsgID, true)
+}
+
+func (c *Conversation) UnpinMessage(ctx context.Context, conversationID, clientMsgID string) error {
+	return c.setMessagePin(ctx, conversationID, clientMsgID, false)
+}
+
+func (c *Conversation) GetPinnedMessages(ctx context.Context, conversationID string) ([]*sdk_params_callback.PinnedMessage, error) {
+	return c.getPinnedMessages(ctx, conversationID)
+}
+
+func (c *Conversation) GetMentionMessages(ctx context.Context, req sdk_params_callback.GetMentionMessagesParams) (*sdk_params_callback.GetMentionMessagesCallback, error) {
+	return c.getMentionMessages(ctx, req)
+}
+
+// MarkMentionsRead marks mentions of a conversation read, all of them when clientMsgIDs is empty.
+func (c *Conversation) MarkMentionsRead(ctx context.Context, conversationID string, clientMsgIDs []string) error {
+	return c.markMentionsRead(ctx, conversationID, clientMsgIDs)
+}
+
+func (c *Conversation) GetUnreadMentionCount(ctx context.Context) (int32, error) {
+	return c.db.GetUnreadMentionCount(ctx)
+}
+
+func (c *Conversation) GetGroupMessageReaderList(ctx context.Context, conversationID, clientMsgID string, filter, offset, count int32) (*sdk_params_callback.GroupMessageReaderList, error) {
+	return c.getGroupMessageReaderList(ctx, conversationID, clientMsgID, filter, offset, count)
+}
+
 func (c *Conversation) TypingStatusUpdate(ctx context.Context, recvID, msgTip string) error {
 	return c.typingStatusUpdate(ctx, recvID, msgTip)
 }
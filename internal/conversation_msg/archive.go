@@ -0,0 +1,515 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/internal/third/file"
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/common"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/version"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// An archive is a zip holding:
+//
+//	manifest.json                          archiveManifest
+//	conversations.jsonl                    one LocalConversation per line
+//	messages/<conversationID>.jsonl        one archiveMessage per line, oldest first
+//	media/<conversationID>/<clientMsgID>/  local media files of the messages, when exported with media
+//
+// archiveVersion is bumped on any incompatible layout change; newer archives are rejected.
+const (
+	archiveVersion = 1
+
+	archiveManifestName      = "manifest.json"
+	archiveConversationsName = "conversations.jsonl"
+	archiveMessagesDir       = "messages/"
+	archiveMediaDir          = "media/"
+
+	archiveBatchSize = 200
+)
+
+// archiveMediaKeys are the content fields of media messages that hold a local file path.
+var archiveMediaKeys = map[int32][]string{
+	constant.Picture: {"sourcePath"},
+	constant.Sound:   {"soundPath"},
+	constant.Video:   {"videoPath", "snapshotPath"},
+	constant.File:    {"filePath"},
+}
+
+type archiveManifest struct {
+	Version       int      `json:"version"`
+	UserID        string   `json:"userID"`
+	SDKVersion    string   `json:"sdkVersion"`
+	CreateTime    int64    `json:"createTime"`
+	WithMedia     bool     `json:"withMedia"`
+	Conversations []string `json:"conversations"`
+}
+
+// archiveMessage is a message line. Media maps a content path field to its entry in the archive.
+type archiveMessage struct {
+	Message *model_struct.LocalChatLog `json:"message"`
+	Media   map[string]string          `json:"media,omitempty"`
+}
+
+type archiveMedia struct {
+	name string
+	path string
+}
+
+func archiveMessagesName(conversationID string) string {
+	return archiveMessagesDir + conversationID + ".jsonl"
+}
+
+// ExportConversations writes the local history of conversationIDs to a zip archive at path,
+// every conversation when conversationIDs is empty. withMedia also stores the local media
+// files of the messages. Progress counts exported conversations.
+func (c *Conversation) ExportConversations(ctx context.Context, conversationIDs []string, path string, withMedia bool, progress open_im_sdk_callback.ArchiveProgress) error {
+	if path == "" {
+		return sdkerrs.ErrArgs.WrapMsg("archive path is empty")
+	}
+	var (
+		conversations []*model_struct.LocalConversation
+		err           error
+	)
+	if len(conversationIDs) == 0 {
+		conversations, err = c.db.GetAllConversations(ctx)
+	} else {
+		conversations, err = c.db.GetMultipleConversationDB(ctx, conversationIDs)
+	}
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return errs.WrapMsg(err, "create archive failed", "path", tmp)
+	}
+	defer os.Remove(tmp)
+	if err := c.writeArchive(ctx, f, conversations, withMedia, progress); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(os.Rename(tmp, path))
+}
+
+func (c *Conversation) writeArchive(ctx context.Context, w io.Writer, conversations []*model_struct.LocalConversation, withMedia bool, progress open_im_sdk_callback.ArchiveProgress) error {
+	zw := zip.NewWriter(w)
+	manifest := archiveManifest{
+		Version:    archiveVersion,
+		UserID:     c.loginUserID,
+		SDKVersion: version.Version,
+		CreateTime: time.Now().UnixMilli(),
+		WithMedia:  withMedia,
+	}
+	for _, conversation := range conversations {
+		manifest.Conversations = append(manifest.Conversations, conversation.ConversationID)
+	}
+	if err := writeArchiveJSON(zw, archiveManifestName, manifest); err != nil {
+		return err
+	}
+	if err := writeArchiveJSONL(zw, archiveConversationsName, conversations); err != nil {
+		return err
+	}
+	total := int64(len(conversations))
+	reportArchiveProgress(progress, 0, total)
+	for i, conversation := range conversations {
+		media, err := c.writeArchiveMessages(ctx, zw, conversation.ConversationID, withMedia)
+		if err != nil {
+			return err
+		}
+		for _, m := range media {
+			if err := writeArchiveFile(zw, m.name, m.path); err != nil {
+				log.ZWarn(ctx, "archive media failed", err, "path", m.path)
+			}
+		}
+		reportArchiveProgress(progress, int64(i+1), total)
+	}
+	return errs.Wrap(zw.Close())
+}
+
+// writeArchiveMessages writes the messages of a conversation oldest first and returns the
+// media files they reference. The media are written afterwards, a zip entry must be complete
+// before the next one starts.
+func (c *Conversation) writeArchiveMessages(ctx context.Context, zw *zip.Writer, conversationID string, withMedia bool) ([]archiveMedia, error) {
+	w, err := zw.Create(archiveMessagesName(conversationID))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	enc := json.NewEncoder(w)
+	var (
+		media            []archiveMedia
+		startTime        int64
+		startSeq         int64
+		startClientMsgID string
+	)
+	seen := make(map[string]struct{})
+	for {
+		list, err := c.db.GetMessageList(ctx, conversationID, archiveBatchSize, startTime, startSeq, startClientMsgID, true)
+		if err != nil {
+			return nil, err
+		}
+		var added int
+		for _, message := range list {
+			if _, ok := seen[message.ClientMsgID]; ok {
+				continue
+			}
+			seen[message.ClientMsgID] = struct{}{}
+			added++
+			if message.Status == constant.MsgStatusHasDeleted {
+				continue
+			}
+			line := archiveMessage{Message: message}
+			if withMedia {
+				line.Media, media = archiveMessageMedia(conversationID, message, media)
+			}
+			if err := enc.Encode(line); err != nil {
+				return nil, errs.Wrap(err)
+			}
+		}
+		if len(list) < archiveBatchSize || added == 0 {
+			return media, nil
+		}
+		last := list[len(list)-1]
+		startTime, startSeq, startClientMsgID = last.SendTime, last.Seq, last.ClientMsgID
+	}
+}
+
+// archiveMessageMedia collects the local files referenced by a media message that still exist.
+func archiveMessageMedia(conversationID string, message *model_struct.LocalChatLog, media []archiveMedia) (map[string]string, []archiveMedia) {
+	keys, ok := archiveMediaKeys[message.ContentType]
+	if !ok {
+		return nil, media
+	}
+	var content map[string]any
+	if err := json.Unmarshal([]byte(message.Content), &content); err != nil {
+		return nil, media
+	}
+	var entries map[string]string
+	for _, key := range keys {
+		localPath, _ := content[key].(string)
+		if localPath == "" {
+			continue
+		}
+		if info, err := os.Stat(localPath); err != nil || info.IsDir() {
+			continue
+		}
+		name := path.Join(archiveMediaDir, conversationID, message.ClientMsgID, key+"_"+filepath.Base(localPath))
+		if entries == nil {
+			entries = make(map[string]string)
+		}
+		entries[key] = name
+		media = append(media, archiveMedia{name: name, path: localPath})
+	}
+	return entries, media
+}
+
+func writeArchiveJSON(zw *zip.Writer, name string, v any) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(json.NewEncoder(w).Encode(v))
+}
+
+func writeArchiveJSONL[T any](zw *zip.Writer, name string, values []T) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	enc := json.NewEncoder(w)
+	for _, v := range values {
+		if err := enc.Encode(v); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func writeArchiveFile(zw *zip.Writer, name string, localPath string) error {
+	f, err := file.Open(&file.UploadFileReq{Filepath: localPath})
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, io.LimitReader(f, f.Size()))
+	return err
+}
+
+// ImportArchive merges an archive written by ExportConversations into the local database.
+// Messages already present by ClientMsgID or Seq and conversations already present are kept
+// as they are, so data synced from the server is never overwritten. Media files are extracted
+// under the data directory. Progress counts imported conversations.
+func (c *Conversation) ImportArchive(ctx context.Context, path string, progress open_im_sdk_callback.ArchiveProgress) (*sdk_params_callback.ImportArchiveResp, error) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, sdkerrs.ErrArgs.WrapMsg("open archive failed: " + err.Error())
+	}
+	defer zr.Close()
+	entries := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		entries[f.Name] = f
+	}
+	var manifest archiveManifest
+	if err := readArchiveJSON(entries, archiveManifestName, &manifest); err != nil {
+		return nil, sdkerrs.ErrArgs.WrapMsg("read archive manifest failed: " + err.Error())
+	}
+	if manifest.Version < 1 || manifest.Version > archiveVersion {
+		return nil, sdkerrs.ErrArgs.WrapMsg(fmt.Sprintf("unsupported archive version %d", manifest.Version))
+	}
+	if manifest.UserID != c.loginUserID {
+		return nil, sdkerrs.ErrArgs.WrapMsg("archive belongs to another user " + manifest.UserID)
+	}
+	var conversations []*model_struct.LocalConversation
+	if err := readArchiveJSONL(entries, archiveConversationsName, func(conversation *model_struct.LocalConversation) error {
+		conversations = append(conversations, conversation)
+		return nil
+	}); err != nil {
+		return nil, sdkerrs.ErrArgs.WrapMsg("read archive conversations failed: " + err.Error())
+	}
+
+	resp := &sdk_params_callback.ImportArchiveResp{}
+	var newConversations []*model_struct.LocalConversation
+	total := int64(len(conversations))
+	reportArchiveProgress(progress, 0, total)
+	for i, conversation := range conversations {
+		if !archiveSafeName(conversation.ConversationID) {
+			return nil, sdkerrs.ErrArgs.WrapMsg("invalid archive conversationID " + conversation.ConversationID)
+		}
+		exists, err := c.db.ConversationIfExists(ctx, conversation.ConversationID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			conversation.UnreadCount = 0
+			if err := c.db.InsertConversation(ctx, conversation); err != nil {
+				return nil, err
+			}
+			newConversations = append(newConversations, conversation)
+		}
+		inserted, skipped, err := c.importArchiveMessages(ctx, entries, conversation.ConversationID)
+		if err != nil {
+			return nil, err
+		}
+		resp.ConversationCount++
+		resp.InsertedCount += inserted
+		resp.SkippedCount += skipped
+		reportArchiveProgress(progress, int64(i+1), total)
+	}
+	if len(newConversations) > 0 {
+		_ = common.TriggerCmdUpdateConversation(ctx, common.UpdateConNode{Action: constant.NewConDirect, Args: utils.StructToJsonString(newConversations)}, c.GetCh())
+		_ = common.TriggerCmdUpdateConversation(ctx, common.UpdateConNode{Action: constant.TotalUnreadMessageChanged}, c.GetCh())
+	}
+	return resp, nil
+}
+
+func (c *Conversation) importArchiveMessages(ctx context.Context, entries map[string]*zip.File, conversationID string) (inserted int, skipped int, err error) {
+	batch := make([]*archiveMessage, 0, archiveBatchSize)
+	flush := func() error {
+		n, err := c.importArchiveBatch(ctx, entries, conversationID, batch)
+		inserted += n
+		skipped += len(batch) - n
+		batch = batch[:0]
+		return err
+	}
+	err = readArchiveJSONL(entries, archiveMessagesName(conversationID), func(line *archiveMessage) error {
+		if line.Message == nil || !archiveSafeName(line.Message.ClientMsgID) {
+			return nil
+		}
+		batch = append(batch, line)
+		if len(batch) < archiveBatchSize {
+			return nil
+		}
+		return flush()
+	})
+	if err == nil && len(batch) > 0 {
+		err = flush()
+	}
+	return inserted, skipped, err
+}
+
+// importArchiveBatch inserts the messages of a batch that are not in the local database yet.
+func (c *Conversation) importArchiveBatch(ctx context.Context, entries map[string]*zip.File, conversationID string, batch []*archiveMessage) (int, error) {
+	clientMsgIDs := make([]string, 0, len(batch))
+	seqs := make([]int64, 0, len(batch))
+	for _, line := range batch {
+		clientMsgIDs = append(clientMsgIDs, line.Message.ClientMsgID)
+		if line.Message.Seq != 0 {
+			seqs = append(seqs, line.Message.Seq)
+		}
+	}
+	existClientMsgIDs := make(map[string]struct{})
+	existSeqs := make(map[int64]struct{})
+	local, err := c.db.GetMessagesByClientMsgIDs(ctx, conversationID, clientMsgIDs)
+	if err != nil {
+		return 0, err
+	}
+	if len(seqs) > 0 {
+		localBySeq, err := c.db.GetMessagesBySeqs(ctx, conversationID, seqs)
+		if err != nil {
+			return 0, err
+		}
+		local = append(local, localBySeq...)
+	}
+	for _, message := range local {
+		existClientMsgIDs[message.ClientMsgID] = struct{}{}
+		if message.Seq != 0 {
+			existSeqs[message.Seq] = struct{}{}
+		}
+	}
+	messages := make([]*model_struct.LocalChatLog, 0, len(batch))
+	for _, line := range batch {
+		message := line.Message
+		if _, ok := existClientMsgIDs[message.ClientMsgID]; ok {
+			continue
+		}
+		if _, ok := existSeqs[message.Seq]; ok && message.Seq != 0 {
+			continue
+		}
+		existClientMsgIDs[message.ClientMsgID] = struct{}{}
+		if message.Seq != 0 {
+			existSeqs[message.Seq] = struct{}{}
+		}
+		if len(line.Media) > 0 {
+			c.importArchiveMedia(ctx, entries, conversationID, line)
+		}
+		messages = append(messages, message)
+	}
+	if len(messages) == 0 {
+		return 0, nil
+	}
+	if err := c.db.BatchInsertMessageList(ctx, conversationID, messages); err != nil {
+		return 0, err
+	}
+	return len(messages), nil
+}
+
+// importArchiveMedia extracts the media of a message and points its content at the extracted
+// files. A missing or broken entry leaves the path as exported.
+func (c *Conversation) importArchiveMedia(ctx context.Context, entries map[string]*zip.File, conversationID string, line *archiveMessage) {
+	var content map[string]any
+	if err := json.Unmarshal([]byte(line.Message.Content), &content); err != nil {
+		return
+	}
+	dir := filepath.Join(c.DataDir, "archive", conversationID, line.Message.ClientMsgID)
+	for key, name := range line.Media {
+		entry, ok := entries[name]
+		if !ok {
+			continue
+		}
+		localPath := filepath.Join(dir, path.Base(name))
+		if err := extractArchiveFile(entry, localPath); err != nil {
+			log.ZWarn(ctx, "extract archive media failed", err, "name", name)
+			continue
+		}
+		content[key] = localPath
+	}
+	line.Message.Content = utils.StructToJsonString(content)
+}
+
+func extractArchiveFile(entry *zip.File, localPath string) error {
+	if err := os.MkdirAll(filepath.Dir(localPath), 0o755); err != nil {
+		return err
+	}
+	r, err := entry.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	f, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func readArchiveJSON(entries map[string]*zip.File, name string, v any) error {
+	entry, ok := entries[name]
+	if !ok {
+		return errs.New("archive entry not found", "name", name).Wrap()
+	}
+	r, err := entry.Open()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer r.Close()
+	return errs.Wrap(json.NewDecoder(r).Decode(v))
+}
+
+// readArchiveJSONL calls fn for every line of an entry, a missing entry has no lines.
+func readArchiveJSONL[T any](entries map[string]*zip.File, name string, fn func(*T) error) error {
+	entry, ok := entries[name]
+	if !ok {
+		return nil
+	}
+	r, err := entry.Open()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer r.Close()
+	dec := json.NewDecoder(bufio.NewReader(r))
+	for {
+		v := new(T)
+		if err := dec.Decode(v); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errs.WrapMsg(err, "decode archive entry failed", "name", name)
+		}
+		if err := fn(v); err != nil {
+			return err
+		}
+	}
+}
+
+// archiveSafeName reports whether an ID read from an archive can be used as a path element.
+func archiveSafeName(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, "/\\`")
+}
+
+func reportArchiveProgress(progress open_im_sdk_callback.ArchiveProgress, current, size int64) {
+	if progress != nil {
+		progress.OnProgress(current, size)
+	}
+}
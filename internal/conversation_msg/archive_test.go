@@ -0,0 +1,149 @@
+//go:build !js
+
+package conversation_msg
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+type archiveProgress struct {
+	current, size int64
+}
+
+func (p *archiveProgress) OnProgress(current int64, size int64) {
+	p.current, p.size = current, size
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	srcDir, dstDir := t.TempDir(), t.TempDir()
+	conversationID := "si_testUser_peer"
+
+	src := newTestConversation(t, testUserID, srcDir)
+	if err := src.db.InsertConversation(ctx, &model_struct.LocalConversation{ConversationID: conversationID, ConversationType: constant.SingleChatType, UserID: "peer", LatestMsgSendTime: 1010}); err != nil {
+		t.Fatal(err)
+	}
+	picturePath := filepath.Join(srcDir, "picture.png")
+	if err := os.WriteFile(picturePath, []byte("png"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	messages := []*model_struct.LocalChatLog{
+		testTextMessage("m1", 1, "one"),
+		testTextMessage("m2", 2, "two"),
+		testTextMessage("m3", 3, "three"),
+		{
+			ClientMsgID: "m4",
+			SendID:      testUserID,
+			ContentType: constant.Picture,
+			Content:     utils.StructToJsonString(sdk_struct.PictureElem{SourcePath: picturePath}),
+			Status:      constant.MsgStatusSendSuccess,
+			Seq:         4,
+			SendTime:    1004,
+		},
+	}
+	if err := src.db.BatchInsertMessageList(ctx, conversationID, messages); err != nil {
+		t.Fatal(err)
+	}
+	archivePath := filepath.Join(srcDir, "history.zip")
+	var progress archiveProgress
+	if err := src.ExportConversations(ctx, []string{conversationID}, archivePath, true, &progress); err != nil {
+		t.Fatal(err)
+	}
+	if progress.current != 1 || progress.size != 1 {
+		t.Fatalf("export progress %d/%d", progress.current, progress.size)
+	}
+
+	// m1 was synced again locally and m2's seq is now held by a newer message: both are kept
+	dst := newTestConversation(t, testUserID, dstDir)
+	if err := dst.db.BatchInsertMessageList(ctx, conversationID, []*model_struct.LocalChatLog{
+		testTextMessage("m1", 1, "one edited"),
+		testTextMessage("other", 2, "server"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := dst.ImportArchive(ctx, archivePath, &progress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ConversationCount != 1 || resp.InsertedCount != 2 || resp.SkippedCount != 2 {
+		t.Fatalf("import resp %+v", resp)
+	}
+	if ok, err := dst.db.ConversationIfExists(ctx, conversationID); err != nil || !ok {
+		t.Fatalf("conversation not imported %v, %v", ok, err)
+	}
+	m1, err := dst.db.GetMessage(ctx, conversationID, "m1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if utils.SearchableText(m1.ContentType, m1.Content) != "one edited" {
+		t.Fatalf("local message clobbered: %s", m1.Content)
+	}
+	if _, err := dst.db.GetMessage(ctx, conversationID, "m2"); err == nil {
+		t.Fatal("message with a taken seq imported")
+	}
+	m4, err := dst.db.GetMessage(ctx, conversationID, "m4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var picture sdk_struct.PictureElem
+	if err := json.Unmarshal([]byte(m4.Content), &picture); err != nil {
+		t.Fatal(err)
+	}
+	if picture.SourcePath == picturePath {
+		t.Fatal("media path not rewritten")
+	}
+	if data, err := os.ReadFile(picture.SourcePath); err != nil || string(data) != "png" {
+		t.Fatalf("media not extracted: %q, %v", data, err)
+	}
+
+	// importing twice inserts nothing
+	resp, err = dst.ImportArchive(ctx, archivePath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.InsertedCount != 0 || resp.SkippedCount != 4 {
+		t.Fatalf("second import resp %+v", resp)
+	}
+}
+
+func TestImportArchiveRejects(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	c := newTestConversation(t, testUserID, dir)
+	write := func(name string, manifest archiveManifest) string {
+		p := filepath.Join(dir, name)
+		f, err := os.Create(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		zw := zip.NewWriter(f)
+		if err := writeArchiveJSON(zw, archiveManifestName, manifest); err != nil {
+			t.Fatal(err)
+		}
+		if err := zw.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+	for _, p := range []string{
+		write("newer.zip", archiveManifest{Version: archiveVersion + 1, UserID: testUserID}),
+		write("other.zip", archiveManifest{Version: archiveVersion, UserID: "other"}),
+		filepath.Join(dir, "missing.zip"),
+	} {
+		if _, err := c.ImportArchive(ctx, p, nil); !sdkerrs.ErrArgs.Is(err) {
+			t.Fatalf("import %s: %v, want ErrArgs", p, err)
+		}
+	}
+}
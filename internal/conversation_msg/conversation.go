@@ -87,6 +87,7 @@ func (c *Conversation) getAdvancedHistoryMessageList(ctx context.Context, req sd
 	t = time.Now()
 
 	messageList = c.LocalChatLog2MsgStruct(list)
+	c.attachThreadInfo(ctx, conversationID, messageList)
 	log.ZDebug(ctx, "message convert and unmarshal", "unmarshal cost time", time.Since(t))
 	t = time.Now()
 	if !isReverse {
@@ -250,7 +251,8 @@ func (c *Conversation) LocalChatLog2MsgStruct(list []*model_struct.LocalChatLog)
 	for _, v := range list {
 		temp := LocalChatLogToMsgStruct(v)
 
-		if temp.AttachedInfoElem.IsPrivateChat && temp.SendTime+int64(temp.AttachedInfoElem.BurnDuration) < time.Now().Unix() {
+		// hide burnt messages the scheduler has not deleted yet
+		if deadline := burnDeadline(temp.AttachedInfoElem, temp.IsRead, temp.SendTime); deadline > 0 && deadline <= time.Now().UnixMilli() {
 			continue
 		}
 		messageList = append(messageList, temp)
@@ -451,10 +453,16 @@ func (c *Conversation) searchLocalMessages(ctx context.Context, searchParam *sdk
 			searchResultItem.ConversationType = localConversation.ConversationType
 			searchResultItem.MessageList = append(searchResultItem.MessageList, temp)
 			searchResultItem.MessageCount++
+			if snippet := searchSnippet(v, searchParam.KeywordList); snippet != nil {
+				searchResultItem.SnippetList = append(searchResultItem.SnippetList, snippet)
+			}
 			conversationMap[conversationID] = &searchResultItem
 		} else {
 			oldItem.MessageCount++
 			oldItem.MessageList = append(oldItem.MessageList, temp)
+			if snippet := searchSnippet(v, searchParam.KeywordList); snippet != nil {
+				oldItem.SnippetList = append(oldItem.SnippetList, snippet)
+			}
 			conversationMap[conversationID] = oldItem
 		}
 	}
@@ -76,6 +76,12 @@ type Conversation struct {
 	startTime time.Time
 
 	typing *typing
+
+	scheduledWake chan struct{}
+	outbox        *outbox
+	destruct      *messageDestruct
+	// reactionLocks serializes the reaction changes of a message by its clientMsgID.
+	reactionLocks *utils.LockPool
 }
 
 func (c *Conversation) SetMsgListener(msgListener func() open_im_sdk_callback.OnAdvancedMsgListener) {
@@ -110,6 +116,10 @@ func NewConversation(ctx context.Context, longConnMgr *interaction.LongConnMgr,
 		messagePullReverseEndSeqMap: cache.NewConversationSeqContextCache(),
 		msgOffset:                   0,
 		progress:                    0,
+		scheduledWake:               make(chan struct{}, 1),
+		outbox:                      newOutbox(info.Outbox()),
+		destruct:                    newMessageDestruct(),
+		reactionLocks:               utils.NewLockPool(maxReactionLocks),
 	}
 	n.typing = newTyping(n)
 	n.initSyncer()
@@ -163,6 +173,9 @@ func (c *Conversation) initSyncer() {
 		syncer.WithNotice[*model_struct.LocalConversation, pbConversation.GetOwnerConversationResp, string](func(ctx context.Context, state int, server, local *model_struct.LocalConversation) error {
 			if state == syncer.Update || state == syncer.Insert {
 				c.doUpdateConversation(common.Cmd2Value{Value: common.UpdateConNode{ConID: server.ConversationID, Action: constant.ConChange, Args: []string{server.ConversationID}}})
+				if server.IsPrivateChat || server.IsMsgDestruct {
+					c.checkDestruct(server.ConversationID)
+				}
 			}
 			return nil
 		}),
@@ -391,6 +404,11 @@ func (c *Conversation) doMsgNew(c2v common.Cmd2Value) {
 
 	//Normal message storage
 	_ = c.batchInsertMessageList(ctx, insertMsg)
+	for conversationID := range insertMsg {
+		if lc, ok := m[conversationID]; ok && (lc.IsPrivateChat || lc.IsMsgDestruct) {
+			c.checkDestruct(conversationID)
+		}
+	}
 
 	hList, _ := c.db.GetHiddenConversationList(ctx)
 	for _, v := range hList {
@@ -686,11 +704,9 @@ func (c *Conversation) batchInsertMessageList(ctx context.Context, insertMsg map
 	return nil
 }
 
-func (c *Conversation) DoMsgReaction(msgReactionList []*sdk_struct.MsgStruct) {
-}
-
 func (c *Conversation) newMessage(ctx context.Context, newMessagesList sdk_struct.NewMsgList, cc, nc map[string]*model_struct.LocalConversation, onlineMsg map[onlineMsgKey]struct{}) {
 	sort.Sort(newMessagesList)
+	c.addMentions(ctx, newMessagesList, onlineMsg)
 	if c.GetBackground() {
 		u, err := c.user.GetSelfUserInfo(ctx)
 		if err != nil {
@@ -730,6 +746,7 @@ func (c *Conversation) batchNewMessages(ctx context.Context, newMessagesList sdk
 	}
 
 	sort.Sort(newMessagesList)
+	c.addMentions(ctx, newMessagesList, onlineMsg)
 	var needNotificationMsgList sdk_struct.NewMsgList
 
 	// offline
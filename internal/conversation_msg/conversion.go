@@ -84,6 +84,7 @@ func MsgDataToLocalChatLog(serverMessage *sdkws.MsgData) *model_struct.LocalChat
 		CreateTime:       serverMessage.CreateTime,
 		AttachedInfo:     serverMessage.AttachedInfo,
 		Ex:               serverMessage.Ex,
+		ThreadRootID:     utils.ThreadRootID(serverMessage.ContentType, string(serverMessage.Content)),
 	}
 
 	if serverMessage.Status >= constant.MsgStatusHasDeleted {
@@ -118,6 +119,7 @@ func LocalChatLogToMsgStruct(localMessage *model_struct.LocalChatLog) *sdk_struc
 		Status:           localMessage.Status,
 		Ex:               localMessage.Ex,
 		LocalEx:          localMessage.LocalEx,
+		ThreadRootID:     localMessage.ThreadRootID,
 	}
 	var attachedInfo sdk_struct.AttachedInfoElem
 	err := utils.JsonStringToStruct(localMessage.AttachedInfo, &attachedInfo)
@@ -234,6 +236,7 @@ func MsgStructToLocalChatLog(message *sdk_struct.MsgStruct) *model_struct.LocalC
 		AttachedInfo:     message.AttachedInfo,
 		Ex:               message.Ex,
 		LocalEx:          message.LocalEx,
+		ThreadRootID:     message.ThreadRootID,
 	}
 	switch message.ContentType {
 	case constant.Text:
@@ -258,6 +261,9 @@ func MsgStructToLocalChatLog(message *sdk_struct.MsgStruct) *model_struct.LocalC
 		localMessage.Content = utils.StructToJsonString(message.CustomElem)
 	case constant.Quote:
 		localMessage.Content = utils.StructToJsonString(message.QuoteElem)
+		if localMessage.ThreadRootID == "" {
+			localMessage.ThreadRootID = utils.ThreadRootID(message.ContentType, localMessage.Content)
+		}
 	case constant.Face:
 		localMessage.Content = utils.StructToJsonString(message.FaceElem)
 	case constant.AdvancedText:
@@ -113,6 +113,7 @@ func (c *Conversation) CreateQuoteMessage(ctx context.Context, text string, qs *
 	if err != nil {
 		return nil, err
 	}
+	s.ThreadRootID = threadRootOf(qs)
 	//Avoid nested references
 	if qs.ContentType == constant.Quote {
 		qs.ContentType = constant.Text
@@ -121,6 +122,7 @@ func (c *Conversation) CreateQuoteMessage(ctx context.Context, text string, qs *
 	s.QuoteElem = &sdk_struct.QuoteElem{
 		Text:         text,
 		QuoteMessage: qs,
+		ThreadRootID: s.ThreadRootID,
 	}
 	return &s, nil
 
@@ -131,6 +133,7 @@ func (c *Conversation) CreateAdvancedQuoteMessage(ctx context.Context, text stri
 	if err != nil {
 		return nil, err
 	}
+	s.ThreadRootID = threadRootOf(qs)
 	//Avoid nested references
 	if qs.ContentType == constant.Quote {
 		//qs.Content = qs.QuoteElem.Text
@@ -141,6 +144,7 @@ func (c *Conversation) CreateAdvancedQuoteMessage(ctx context.Context, text stri
 		Text:              text,
 		QuoteMessage:      qs,
 		MessageEntityList: messageEntities,
+		ThreadRootID:      s.ThreadRootID,
 	}
 	return &s, nil
 }
@@ -70,6 +70,8 @@ func (c *Conversation) clearConversationAndDeleteAllMsg(ctx context.Context, con
 	if err != nil {
 		return err
 	}
+	c.removeConversationPins(ctx, conversationID)
+	c.removeConversationMentions(ctx, conversationID)
 	log.ZDebug(ctx, "reset conversation", "conversationID", conversationID)
 	err = f(ctx, conversationID)
 	if err != nil {
@@ -188,6 +190,8 @@ func (c *Conversation) deleteMessageFromLocal(ctx context.Context, conversationI
 		}
 		c.doUpdateConversation(common.Cmd2Value{Value: common.UpdateConNode{Action: constant.ConChange, Args: []string{conversationID}}})
 	}
+	c.removeMessagePins(ctx, conversationID, c.loginUserID, []string{clientMsgID})
+	c.removeMessageMentions(ctx, conversationID, []string{clientMsgID})
 	c.msgListener().OnMsgDeleted(utils.StructToJsonString(s))
 	return nil
 }
@@ -0,0 +1,313 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/common"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+
+	"github.com/openimsdk/tools/log"
+)
+
+const (
+	// destructBatchSize is how many messages of a conversation are examined per query.
+	destructBatchSize = 200
+	// destructRetryWait is how long a conversation waits after its messages failed to be deleted.
+	destructRetryWait = 5 * time.Second
+	// destructIdleWait caps the sleep of the scheduler so clock changes are noticed.
+	destructIdleWait = time.Minute
+)
+
+// messageDestruct holds the earliest deadline, in milliseconds, of the messages of each
+// conversation that burn after reading or self-destruct. It only lives in memory and is
+// rebuilt from the local database at login.
+type messageDestruct struct {
+	lock      sync.Mutex
+	deadlines map[string]int64
+	wake      chan struct{}
+}
+
+func newMessageDestruct() *messageDestruct {
+	return &messageDestruct{deadlines: make(map[string]int64), wake: make(chan struct{}, 1)}
+}
+
+// checkDestruct asks the scheduler to look at the messages of the conversations again, after
+// messages were read or received or the conversations' settings changed.
+func (c *Conversation) checkDestruct(conversationIDs ...string) {
+	c.destruct.lock.Lock()
+	for _, conversationID := range conversationIDs {
+		c.destruct.deadlines[conversationID] = 0
+	}
+	c.destruct.lock.Unlock()
+	select {
+	case c.destruct.wake <- struct{}{}:
+	default:
+	}
+}
+
+// RunMessageDestruct deletes the messages of burn-after-reading and self-destructing
+// conversations as they expire, until ctx is done.
+func (c *Conversation) RunMessageDestruct(ctx context.Context) {
+	conversations, err := c.db.GetAllConversationListDB(ctx)
+	if err != nil {
+		log.ZWarn(ctx, "get conversations for message destruct failed", err)
+	}
+	for _, conversation := range conversations {
+		if conversation.IsPrivateChat || conversation.IsMsgDestruct {
+			c.checkDestruct(conversation.ConversationID)
+		}
+	}
+	for {
+		timer := time.NewTimer(c.dispatchDestruct(ctx))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-c.destruct.wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// dispatchDestruct handles the conversations that are due and returns how long to wait for the next one.
+func (c *Conversation) dispatchDestruct(ctx context.Context) time.Duration {
+	now := time.Now().UnixMilli()
+	var due []string
+	c.destruct.lock.Lock()
+	for conversationID, deadline := range c.destruct.deadlines {
+		if deadline <= now {
+			due = append(due, conversationID)
+			delete(c.destruct.deadlines, conversationID)
+		}
+	}
+	c.destruct.lock.Unlock()
+	for _, conversationID := range due {
+		if ctx.Err() != nil {
+			return 0
+		}
+		deadline, err := c.destructConversation(ctx, conversationID, now)
+		if err != nil {
+			log.ZWarn(ctx, "destruct conversation messages failed", err, "conversationID", conversationID)
+			deadline = now + destructRetryWait.Milliseconds()
+		}
+		if deadline == 0 {
+			continue
+		}
+		c.destruct.lock.Lock()
+		// a check requested meanwhile is kept
+		if current, ok := c.destruct.deadlines[conversationID]; !ok || deadline < current {
+			c.destruct.deadlines[conversationID] = deadline
+		}
+		c.destruct.lock.Unlock()
+	}
+	wait := destructIdleWait
+	c.destruct.lock.Lock()
+	for _, deadline := range c.destruct.deadlines {
+		wait = min(wait, time.Until(time.UnixMilli(deadline)))
+	}
+	c.destruct.lock.Unlock()
+	return max(wait, 0)
+}
+
+// destructConversation deletes the expired messages of a conversation and returns the deadline
+// of the next one, 0 when no message is waiting to expire.
+func (c *Conversation) destructConversation(ctx context.Context, conversationID string, now int64) (int64, error) {
+	conversation, err := c.db.GetConversation(ctx, conversationID)
+	if err != nil {
+		return 0, err
+	}
+	if !conversation.IsPrivateChat && !conversation.IsMsgDestruct {
+		return 0, nil
+	}
+	var (
+		next             int64
+		expired          []*model_struct.LocalChatLog
+		startTime        int64
+		startSeq         int64
+		startClientMsgID string
+	)
+	for {
+		list, err := c.db.GetMessageList(ctx, conversationID, destructBatchSize, startTime, startSeq, startClientMsgID, true)
+		if err != nil {
+			return 0, err
+		}
+		for _, message := range list {
+			if message.Status == constant.MsgStatusHasDeleted {
+				continue
+			}
+			deadline := messageDeadline(conversation, message)
+			if deadline == 0 {
+				continue
+			}
+			if deadline <= now {
+				expired = append(expired, message)
+				continue
+			}
+			if next == 0 || deadline < next {
+				next = deadline
+			}
+			// without burn after reading, deadlines follow the send time and the rest expire later
+			if !conversation.IsPrivateChat {
+				return next, c.destructMessages(ctx, conversation, expired)
+			}
+		}
+		if len(list) < destructBatchSize {
+			break
+		}
+		last := list[len(list)-1]
+		startTime, startSeq, startClientMsgID = last.SendTime, last.Seq, last.ClientMsgID
+	}
+	return next, c.destructMessages(ctx, conversation, expired)
+}
+
+// messageDeadline returns when a message expires in milliseconds, 0 if it does not yet.
+// A burn-after-reading message expires BurnDuration seconds after it was read, and every
+// message of a self-destructing conversation MsgDestructTime seconds after it was sent.
+func messageDeadline(conversation *model_struct.LocalConversation, message *model_struct.LocalChatLog) int64 {
+	var deadline int64
+	if conversation.IsMsgDestruct && conversation.MsgDestructTime > 0 {
+		deadline = message.SendTime + conversation.MsgDestructTime*1000
+	}
+	var attachedInfo sdk_struct.AttachedInfoElem
+	_ = utils.JsonStringToStruct(message.AttachedInfo, &attachedInfo)
+	if burn := burnDeadline(&attachedInfo, message.IsRead, message.SendTime); burn > 0 && (deadline == 0 || burn < deadline) {
+		deadline = burn
+	}
+	return deadline
+}
+
+// burnDeadline returns when a burn-after-reading message expires in milliseconds, 0 while it is unread.
+// Messages marked as read by another device carry no read time and count from their send time.
+func burnDeadline(attachedInfo *sdk_struct.AttachedInfoElem, isRead bool, sendTime int64) int64 {
+	if attachedInfo == nil || !attachedInfo.IsPrivateChat {
+		return 0
+	}
+	readTime := attachedInfo.HasReadTime
+	if readTime == 0 {
+		if !isRead {
+			return 0
+		}
+		readTime = sendTime
+	}
+	return readTime + int64(attachedInfo.BurnDuration)*1000
+}
+
+// destructMessages removes expired messages with the files the SDK keeps for them, and updates
+// the conversation like a deletion does.
+func (c *Conversation) destructMessages(ctx context.Context, conversation *model_struct.LocalConversation, messages []*model_struct.LocalChatLog) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	conversationID := conversation.ConversationID
+	var (
+		clientMsgIDs []string
+		unread       int64
+	)
+	for _, message := range messages {
+		clientMsgIDs = append(clientMsgIDs, message.ClientMsgID)
+		if !message.IsRead && message.SendID != c.loginUserID {
+			unread++
+		}
+		c.removeMessageMedia(ctx, conversationID, message)
+	}
+	if err := c.db.DeleteConversationMsgs(ctx, conversationID, clientMsgIDs); err != nil {
+		return err
+	}
+	log.ZDebug(ctx, "destruct messages", "conversationID", conversationID, "clientMsgIDs", clientMsgIDs)
+	if unread > 0 {
+		if err := c.db.DecrConversationUnreadCount(ctx, conversationID, unread); err != nil {
+			log.ZWarn(ctx, "DecrConversationUnreadCount err", err, "conversationID", conversationID)
+		}
+	}
+	var latestMsg sdk_struct.MsgStruct
+	_ = utils.JsonStringToStruct(conversation.LatestMsg, &latestMsg)
+	if utils.IsContain(latestMsg.ClientMsgID, clientMsgIDs) {
+		msg, err := c.db.GetLatestActiveMessage(ctx, conversationID, false)
+		if err != nil {
+			return err
+		}
+		latestMsgSendTime := latestMsg.SendTime
+		latestMsgStr := ""
+		if len(msg) > 0 {
+			latestMsg = *LocalChatLogToMsgStruct(msg[0])
+			latestMsgStr = utils.StructToJsonString(latestMsg)
+			latestMsgSendTime = latestMsg.SendTime
+		}
+		if err := c.db.UpdateColumnsConversation(ctx, conversationID, map[string]interface{}{"latest_msg": latestMsgStr, "latest_msg_send_time": latestMsgSendTime}); err != nil {
+			return err
+		}
+	}
+	c.doUpdateConversation(common.Cmd2Value{Value: common.UpdateConNode{ConID: conversationID, Action: constant.ConChange, Args: []string{conversationID}}, Ctx: ctx})
+	if unread > 0 {
+		c.doUpdateConversation(common.Cmd2Value{Value: common.UpdateConNode{Action: constant.TotalUnreadMessageChanged}, Ctx: ctx})
+	}
+	c.removeMessagePins(ctx, conversationID, c.loginUserID, clientMsgIDs)
+	c.removeMessageMentions(ctx, conversationID, clientMsgIDs)
+	for _, message := range messages {
+		c.msgListener().OnMsgDeleted(utils.StructToJsonString(message))
+	}
+	return nil
+}
+
+// removeMessageMedia deletes the local files of a media message that the SDK keeps for this
+// device: the copies of a message sent from this platform, held in the SDK data directory, and
+// the media extracted from an archive for the message. The paths of a received message come from
+// its sender and are never trusted, files elsewhere belong to the application and are left alone.
+func (c *Conversation) removeMessageMedia(ctx context.Context, conversationID string, message *model_struct.LocalChatLog) {
+	keys, ok := archiveMediaKeys[message.ContentType]
+	if !ok || c.DataDir == "" {
+		return
+	}
+	var content map[string]any
+	if err := json.Unmarshal([]byte(message.Content), &content); err != nil {
+		return
+	}
+	sent := message.SendID == c.loginUserID && message.SenderPlatformID == c.platformID
+	archiveDir := filepath.Join(c.DataDir, "archive", conversationID, message.ClientMsgID)
+	for _, key := range keys {
+		localPath, _ := content[key].(string)
+		if localPath == "" {
+			continue
+		}
+		if !(sent && pathWithin(c.DataDir, localPath)) && !pathWithin(archiveDir, localPath) {
+			continue
+		}
+		if err := os.Remove(localPath); err != nil && !os.IsNotExist(err) {
+			log.ZWarn(ctx, "remove message media failed", err, "clientMsgID", message.ClientMsgID, "path", localPath)
+		}
+	}
+}
+
+// pathWithin reports whether localPath names a file inside dir once both are cleaned, so that
+// ".." elements cannot escape it.
+func pathWithin(dir, localPath string) bool {
+	rel, err := filepath.Rel(filepath.Clean(dir), filepath.Clean(localPath))
+	if err != nil || rel == "." || filepath.IsAbs(rel) {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
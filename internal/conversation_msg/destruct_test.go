@@ -0,0 +1,216 @@
+//go:build !js
+
+package conversation_msg
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+type destructListener struct {
+	open_im_sdk_callback.OnAdvancedMsgListener
+	deleted []string
+}
+
+func (l *destructListener) OnMsgDeleted(message string) {
+	var msg model_struct.LocalChatLog
+	_ = utils.JsonStringToStruct(message, &msg)
+	l.deleted = append(l.deleted, msg.ClientMsgID)
+}
+
+type destructConversationListener struct {
+	open_im_sdk_callback.OnConversationListener
+}
+
+func (destructConversationListener) OnConversationChanged(string) {}
+
+func (destructConversationListener) OnTotalUnreadMessageCountChanged(int32) {}
+
+// newDestructConversation returns a test Conversation reporting deleted messages to the listener.
+func newDestructConversation(t *testing.T, dir string) (*Conversation, *destructListener) {
+	c := newTestConversation(t, testUserID, dir)
+	listener := &destructListener{}
+	c.msgListener = func() open_im_sdk_callback.OnAdvancedMsgListener { return listener }
+	c.ConversationListener = func() open_im_sdk_callback.OnConversationListener { return destructConversationListener{} }
+	return c, listener
+}
+
+func burnMessage(clientMsgID string, seq int64, hasReadTime int64) *model_struct.LocalChatLog {
+	message := testTextMessage(clientMsgID, seq, clientMsgID)
+	message.SendID = "peer"
+	message.IsRead = hasReadTime > 0
+	message.AttachedInfo = utils.StructToJsonString(sdk_struct.AttachedInfoElem{IsPrivateChat: true, BurnDuration: 10, HasReadTime: hasReadTime})
+	return message
+}
+
+func TestDestructBurnAfterReading(t *testing.T) {
+	ctx := context.Background()
+	c, listener := newDestructConversation(t, t.TempDir())
+	conversationID := "si_testUser_peer"
+	read, unread, plain := burnMessage("read", 1, 2000), burnMessage("unread", 2, 0), testTextMessage("plain", 3, "plain")
+	if err := c.db.InsertConversation(ctx, &model_struct.LocalConversation{ConversationID: conversationID, ConversationType: constant.SingleChatType,
+		UserID: "peer", IsPrivateChat: true, BurnDuration: 10, UnreadCount: 1, LatestMsg: utils.StructToJsonString(LocalChatLogToMsgStruct(plain))}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.db.BatchInsertMessageList(ctx, conversationID, []*model_struct.LocalChatLog{read, unread, plain}); err != nil {
+		t.Fatal(err)
+	}
+
+	next, err := c.destructConversation(ctx, conversationID, 5000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != 12000 || len(listener.deleted) != 0 {
+		t.Fatalf("next %d, deleted %v", next, listener.deleted)
+	}
+	next, err = c.destructConversation(ctx, conversationID, 12000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next != 0 || len(listener.deleted) != 1 || listener.deleted[0] != "read" {
+		t.Fatalf("next %d, deleted %v", next, listener.deleted)
+	}
+	if _, err := c.db.GetMessage(ctx, conversationID, "read"); err == nil {
+		t.Fatal("burnt message kept")
+	}
+	for _, clientMsgID := range []string{"unread", "plain"} {
+		if _, err := c.db.GetMessage(ctx, conversationID, clientMsgID); err != nil {
+			t.Fatalf("%s: %v", clientMsgID, err)
+		}
+	}
+}
+
+func TestDestructExpiredMessages(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	c, listener := newDestructConversation(t, dir)
+	conversationID := "si_testUser_peer"
+	now := time.Now().UnixMilli()
+
+	sdkPicture := utils.FileTmpPath("picture.png", dir+"/")
+	appPicture := filepath.Join(t.TempDir(), "picture.png")
+	for _, p := range []string{sdkPicture, appPicture} {
+		if err := os.WriteFile(p, []byte("png"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := testTextMessage("old", 1, "old")
+	old.SendID, old.SendTime = "peer", now-120*1000
+	picture := &model_struct.LocalChatLog{ClientMsgID: "picture", SendID: testUserID, ContentType: constant.Picture, Status: constant.MsgStatusSendSuccess,
+		Content: utils.StructToJsonString(sdk_struct.PictureElem{SourcePath: sdkPicture}), Seq: 2, SendTime: now - 90*1000}
+	app := &model_struct.LocalChatLog{ClientMsgID: "app", SendID: testUserID, ContentType: constant.Picture, Status: constant.MsgStatusSendSuccess,
+		Content: utils.StructToJsonString(sdk_struct.PictureElem{SourcePath: appPicture}), Seq: 3, SendTime: now - 80*1000}
+	fresh := testTextMessage("fresh", 4, "fresh")
+	fresh.SendTime = now
+	if err := c.db.InsertConversation(ctx, &model_struct.LocalConversation{ConversationID: conversationID, ConversationType: constant.SingleChatType,
+		UserID: "peer", IsMsgDestruct: true, MsgDestructTime: 60, UnreadCount: 1, LatestMsg: utils.StructToJsonString(LocalChatLogToMsgStruct(app))}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.db.BatchInsertMessageList(ctx, conversationID, []*model_struct.LocalChatLog{old, picture, app}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the scheduler rebuilt at login picks the conversation up
+	c.checkDestruct(conversationID)
+	wait := c.dispatchDestruct(ctx)
+	if len(listener.deleted) != 3 || wait != destructIdleWait {
+		t.Fatalf("deleted %v, wait %s", listener.deleted, wait)
+	}
+	if _, err := os.Stat(sdkPicture); !os.IsNotExist(err) {
+		t.Fatalf("sdk media kept: %v", err)
+	}
+	if _, err := os.Stat(appPicture); err != nil {
+		t.Fatalf("application media removed: %v", err)
+	}
+	conversation, err := c.db.GetConversation(ctx, conversationID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conversation.UnreadCount != 0 || conversation.LatestMsg != "" {
+		t.Fatalf("conversation unread %d, latest %q", conversation.UnreadCount, conversation.LatestMsg)
+	}
+
+	if err := c.db.InsertMessage(ctx, conversationID, fresh); err != nil {
+		t.Fatal(err)
+	}
+	c.checkDestruct(conversationID)
+	if wait := c.dispatchDestruct(ctx); wait > time.Minute || len(listener.deleted) != 3 {
+		t.Fatalf("deleted %v, wait %s", listener.deleted, wait)
+	}
+	c.destruct.lock.Lock()
+	deadline := c.destruct.deadlines[conversationID]
+	c.destruct.lock.Unlock()
+	if deadline != now+60*1000 {
+		t.Fatalf("deadline %d, want %d", deadline, now+60*1000)
+	}
+}
+
+func TestDestructHostileMediaPath(t *testing.T) {
+	ctx := context.Background()
+	base := t.TempDir()
+	dir := filepath.Join(base, "data")
+	if err := os.Mkdir(dir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	c, _ := newDestructConversation(t, dir)
+	conversationID := "si_testUser_peer"
+	dbFiles, err := filepath.Glob(filepath.Join(dir, "OpenIM_*_"+testUserID+".db"))
+	if err != nil || len(dbFiles) != 1 {
+		t.Fatalf("database files %v: %v", dbFiles, err)
+	}
+	outside := filepath.Join(base, "outside.png")
+	peerFile := filepath.Join(dir, "peer.png")
+	imported := filepath.Join(dir, "archive", conversationID, "imported", "sourcePath_picture.png")
+	if err := os.MkdirAll(filepath.Dir(imported), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{outside, peerFile, imported} {
+		if err := os.WriteFile(p, []byte("png"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	picture := func(clientMsgID, sendID, sourcePath string) *model_struct.LocalChatLog {
+		return &model_struct.LocalChatLog{ClientMsgID: clientMsgID, SendID: sendID, ContentType: constant.Picture, Status: constant.MsgStatusSendSuccess,
+			Content: utils.StructToJsonString(sdk_struct.PictureElem{SourcePath: sourcePath})}
+	}
+	messages := []*model_struct.LocalChatLog{
+		// a peer pointing at the database, at a file out of the data directory, at any file in it
+		picture("database", "peer", dbFiles[0]),
+		picture("escape", "peer", dir+"/../outside.png"),
+		picture("peer", "peer", peerFile),
+		// a message of this device escaping the data directory
+		picture("own", testUserID, dir+"/../outside.png"),
+		// media this device imported for a received message
+		picture("imported", "peer", imported),
+	}
+	conversation := &model_struct.LocalConversation{ConversationID: conversationID, ConversationType: constant.SingleChatType, UserID: "peer"}
+	if err := c.db.InsertConversation(ctx, conversation); err != nil {
+		t.Fatal(err)
+	}
+	for i, message := range messages {
+		message.Seq = int64(i + 1)
+	}
+	if err := c.db.BatchInsertMessageList(ctx, conversationID, messages); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.destructMessages(ctx, conversation, messages); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{dbFiles[0], outside, peerFile} {
+		if _, err := os.Stat(p); err != nil {
+			t.Fatalf("%s removed: %v", p, err)
+		}
+	}
+	if _, err := os.Stat(imported); !os.IsNotExist(err) {
+		t.Fatalf("imported media kept: %v", err)
+	}
+}
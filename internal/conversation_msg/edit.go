@@ -0,0 +1,170 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/common"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/server_api_params"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+// editableContentTypes are the message types whose content can be replaced after sending.
+var editableContentTypes = []int32{constant.Text, constant.AtText, constant.AdvancedText, constant.Quote, constant.Custom}
+
+func (c *Conversation) doEditMsg(ctx context.Context, msg *sdkws.MsgData) error {
+	var tips server_api_params.MsgEditedTips
+	if err := utils.UnmarshalNotificationElem(msg.Content, &tips); err != nil {
+		log.ZWarn(ctx, "unmarshal failed", err, "msg", msg)
+		return errs.Wrap(err)
+	}
+	log.ZDebug(ctx, "do editMessage", "tips", &tips)
+	_, err := c.editMessage(ctx, &tips)
+	return err
+}
+
+// editMessage applies an edit to the local message, keeps the conversation's latest message
+// in step and notifies the listener. Edits that are not newer than the local copy are ignored,
+// so the editor's own notification echo is harmless.
+func (c *Conversation) editMessage(ctx context.Context, tips *server_api_params.MsgEditedTips) (*sdk_struct.MsgStruct, error) {
+	var (
+		editedMsg *model_struct.LocalChatLog
+		err       error
+	)
+	if tips.ClientMsgID != "" {
+		editedMsg, err = c.db.GetMessage(ctx, tips.ConversationID, tips.ClientMsgID)
+	} else {
+		editedMsg, err = c.db.GetMessageBySeq(ctx, tips.ConversationID, tips.Seq)
+	}
+	if err != nil {
+		log.ZError(ctx, "GetMessage failed", err, "tips", tips)
+		return nil, errs.Wrap(err)
+	}
+	var attachedInfo sdk_struct.AttachedInfoElem
+	_ = utils.JsonStringToStruct(editedMsg.AttachedInfo, &attachedInfo)
+	if attachedInfo.LastEditTime >= tips.EditTime {
+		log.ZDebug(ctx, "message edit is not newer than local", "clientMsgID", editedMsg.ClientMsgID,
+			"localEditTime", attachedInfo.LastEditTime, "editTime", tips.EditTime)
+		return LocalChatLogToMsgStruct(editedMsg), nil
+	}
+	attachedInfo.EditCount++
+	attachedInfo.LastEditTime = tips.EditTime
+	editedMsg.Content = tips.Content
+	editedMsg.AttachedInfo = utils.StructToJsonString(attachedInfo)
+	if err := c.db.UpdateColumnsMessage(ctx, tips.ConversationID, editedMsg.ClientMsgID,
+		map[string]interface{}{"content": editedMsg.Content, "attached_info": editedMsg.AttachedInfo}); err != nil {
+		log.ZError(ctx, "UpdateColumnsMessage failed", err, "tips", tips)
+		return nil, errs.Wrap(err)
+	}
+	message := LocalChatLogToMsgStruct(editedMsg)
+	if err := c.editConversationLatestMsg(ctx, tips.ConversationID, message); err != nil {
+		log.ZError(ctx, "editConversationLatestMsg failed", err, "tips", tips)
+	}
+	c.msgListener().OnMsgEdited(utils.StructToJsonString(message))
+	return message, nil
+}
+
+func (c *Conversation) editConversationLatestMsg(ctx context.Context, conversationID string, message *sdk_struct.MsgStruct) error {
+	conversation, err := c.db.GetConversation(ctx, conversationID)
+	if err != nil {
+		return err
+	}
+	var latestMsg sdk_struct.MsgStruct
+	utils.JsonStringToStruct(conversation.LatestMsg, &latestMsg)
+	if latestMsg.ClientMsgID != message.ClientMsgID {
+		return nil
+	}
+	log.ZDebug(ctx, "latestMsg edited", "seq", latestMsg.Seq, "clientMsgID", latestMsg.ClientMsgID)
+	if err := c.db.UpdateColumnsConversation(ctx, conversationID, map[string]interface{}{"latest_msg": utils.StructToJsonString(message),
+		"latest_msg_send_time": message.SendTime}); err != nil {
+		return err
+	}
+	c.doUpdateConversation(common.Cmd2Value{Value: common.UpdateConNode{Action: constant.ConChange, Args: []string{conversationID}}})
+	return nil
+}
+
+func (c *Conversation) editOneMessage(ctx context.Context, conversationID, clientMsgID, newContent string) (*sdk_struct.MsgStruct, error) {
+	conversation, err := c.db.GetConversation(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	message, err := c.db.GetMessage(ctx, conversationID, clientMsgID)
+	if err != nil {
+		return nil, err
+	}
+	if message.Status != constant.MsgStatusSendSuccess {
+		return nil, sdkerrs.ErrArgs.WrapMsg("only send success message can be edited")
+	}
+	if !datautil.Contain(message.ContentType, editableContentTypes...) {
+		return nil, sdkerrs.ErrMsgContentTypeNotSupport.WrapMsg("message content type can not be edited", "contentType", message.ContentType)
+	}
+	if err := msgHandleByContentType(&sdk_struct.MsgStruct{ContentType: message.ContentType, Content: newContent}); err != nil {
+		return nil, sdkerrs.ErrArgs.WrapMsg("new content does not match message content type " + err.Error())
+	}
+	switch conversation.ConversationType {
+	case constant.SingleChatType:
+		if message.SendID != c.loginUserID {
+			return nil, sdkerrs.ErrArgs.WrapMsg("only send by yourself message can be edited")
+		}
+	case constant.ReadGroupChatType:
+		if message.SendID != c.loginUserID {
+			groupAdmins, err := c.db.GetGroupMemberOwnerAndAdminDB(ctx, conversation.GroupID)
+			if err != nil {
+				return nil, err
+			}
+			var isAdmin bool
+			for _, member := range groupAdmins {
+				if member.UserID == c.loginUserID {
+					isAdmin = true
+					break
+				}
+			}
+			if !isAdmin {
+				return nil, sdkerrs.ErrArgs.WrapMsg("only group admin can edit message")
+			}
+		}
+	default:
+		return nil, sdkerrs.ErrNotSupportOpt.WrapMsg("conversation type does not support editing", "conversationType", conversation.ConversationType)
+	}
+
+	resp, err := c.editMessageFromServer(ctx, conversationID, message.Seq, clientMsgID, newContent)
+	if err != nil {
+		return nil, err
+	}
+	editTime := resp.EditTime
+	if editTime == 0 {
+		editTime = timeutil.GetCurrentTimestampByMill()
+	}
+	return c.editMessage(ctx, &server_api_params.MsgEditedTips{
+		ConversationID: conversationID,
+		SessionType:    conversation.ConversationType,
+		ClientMsgID:    clientMsgID,
+		Seq:            message.Seq,
+		EditorUserID:   c.loginUserID,
+		Content:        newContent,
+		EditTime:       editTime,
+	})
+}
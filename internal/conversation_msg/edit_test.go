@@ -0,0 +1,164 @@
+//go:build !js
+
+package conversation_msg
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/ccontext"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/server_api_params"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+type editListener struct {
+	open_im_sdk_callback.OnAdvancedMsgListener
+	edited []*sdk_struct.MsgStruct
+}
+
+func (l *editListener) OnMsgEdited(message string) {
+	var msg sdk_struct.MsgStruct
+	_ = json.Unmarshal([]byte(message), &msg)
+	l.edited = append(l.edited, &msg)
+}
+
+type editConversationListener struct {
+	open_im_sdk_callback.OnConversationListener
+	changed int
+}
+
+func (l *editConversationListener) OnConversationChanged(string) { l.changed++ }
+
+// newEditAPI returns the address of an API answering edits with increasing edit times from 100.
+func newEditAPI(t *testing.T, calls *atomic.Int64) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/msg/edit_msg" {
+			http.NotFound(w, r)
+			return
+		}
+		n := calls.Add(1)
+		_ = json.NewEncoder(w).Encode(map[string]any{"errCode": 0, "data": server_api_params.EditMsgResp{EditTime: 99 + n}})
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestEditMessage(t *testing.T) {
+	var calls atomic.Int64
+	conf := &ccontext.GlobalConfig{UserID: testUserID}
+	conf.ApiAddr = newEditAPI(t, &calls)
+	ctx := ccontext.WithOperationID(ccontext.WithInfo(context.Background(), conf), "edit")
+	c := newTestConversation(t, testUserID, t.TempDir())
+	listener, conversationListener := &editListener{}, &editConversationListener{}
+	c.msgListener = func() open_im_sdk_callback.OnAdvancedMsgListener { return listener }
+	c.ConversationListener = func() open_im_sdk_callback.OnConversationListener { return conversationListener }
+
+	singleID, groupID := "si_testUser_peer", "sg_group1"
+	mine, peers := testTextMessage("mine", 1, "one"), testTextMessage("peers", 2, "two")
+	peers.SendID = "peer"
+	members, latest := testTextMessage("member", 1, "three"), testTextMessage("latest", 2, "four")
+	members.SendID, latest.SendID = "member", "member"
+	for _, conversation := range []struct {
+		conversation *model_struct.LocalConversation
+		messages     []*model_struct.LocalChatLog
+		latest       *model_struct.LocalChatLog
+	}{
+		{&model_struct.LocalConversation{ConversationID: singleID, ConversationType: constant.SingleChatType, UserID: "peer"}, []*model_struct.LocalChatLog{mine, peers}, mine},
+		{&model_struct.LocalConversation{ConversationID: groupID, ConversationType: constant.ReadGroupChatType, GroupID: "group1"}, []*model_struct.LocalChatLog{members, latest}, latest},
+	} {
+		conversation.conversation.LatestMsg = utils.StructToJsonString(LocalChatLogToMsgStruct(conversation.latest))
+		conversation.conversation.LatestMsgSendTime = conversation.latest.SendTime
+		if err := c.db.InsertConversation(ctx, conversation.conversation); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.db.BatchInsertMessageList(ctx, conversation.conversation.ConversationID, conversation.messages); err != nil {
+			t.Fatal(err)
+		}
+	}
+	text := func(s string) string { return utils.StructToJsonString(sdk_struct.TextElem{Content: s}) }
+	stored := func(conversationID, clientMsgID string) (*model_struct.LocalChatLog, sdk_struct.AttachedInfoElem) {
+		t.Helper()
+		message, err := c.db.GetMessage(ctx, conversationID, clientMsgID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var attachedInfo sdk_struct.AttachedInfoElem
+		_ = utils.JsonStringToStruct(message.AttachedInfo, &attachedInfo)
+		return message, attachedInfo
+	}
+	latestMsg := func(conversationID string) *sdk_struct.MsgStruct {
+		t.Helper()
+		conversation, err := c.db.GetConversation(ctx, conversationID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var msg sdk_struct.MsgStruct
+		_ = utils.JsonStringToStruct(conversation.LatestMsg, &msg)
+		return &msg
+	}
+
+	// the sender edits the latest message of a single chat
+	if _, err := c.editOneMessage(ctx, singleID, "mine", text("one edited")); err != nil {
+		t.Fatal(err)
+	}
+	if message, info := stored(singleID, "mine"); message.Content != text("one edited") || info.EditCount != 1 || info.LastEditTime != 100 {
+		t.Fatalf("edited message %s %+v", message.Content, info)
+	}
+	if msg := latestMsg(singleID); msg.ClientMsgID != "mine" || msg.TextElem == nil || msg.TextElem.Content != "one edited" {
+		t.Fatalf("latest message %+v", msg)
+	}
+	if len(listener.edited) != 1 || listener.edited[0].ClientMsgID != "mine" || conversationListener.changed != 1 {
+		t.Fatalf("edited %d changed %d", len(listener.edited), conversationListener.changed)
+	}
+
+	// the editor's notification echo and older edits are ignored, newer ones found by seq apply
+	for _, editTime := range []int64{100, 90} {
+		if _, err := c.editMessage(ctx, &server_api_params.MsgEditedTips{ConversationID: singleID, ClientMsgID: "mine", Content: text("stale"), EditTime: editTime}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if message, info := stored(singleID, "mine"); message.Content != text("one edited") || info.EditCount != 1 || len(listener.edited) != 1 {
+		t.Fatalf("stale edit applied %s %+v", message.Content, info)
+	}
+	if _, err := c.editMessage(ctx, &server_api_params.MsgEditedTips{ConversationID: singleID, Seq: 1, Content: text("one again"), EditTime: 120}); err != nil {
+		t.Fatal(err)
+	}
+	if message, info := stored(singleID, "mine"); message.Content != text("one again") || info.EditCount != 2 || info.LastEditTime != 120 || len(listener.edited) != 2 || conversationListener.changed != 2 {
+		t.Fatalf("newer edit %s %+v", message.Content, info)
+	}
+
+	// only the sender edits in a single chat, and a group admin in a group
+	if _, err := c.editOneMessage(ctx, singleID, "peers", text("two edited")); err == nil {
+		t.Fatal("edited the peer's message")
+	}
+	if _, err := c.editOneMessage(ctx, groupID, "member", text("three edited")); err == nil {
+		t.Fatal("edited a member's message without being a group admin")
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("rejected edits reached the server, %d calls", calls.Load())
+	}
+	if err := c.db.InsertGroupMember(ctx, &model_struct.LocalGroupMember{GroupID: "group1", UserID: testUserID, RoleLevel: constant.GroupAdmin}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.editOneMessage(ctx, groupID, "member", text("three edited")); err != nil {
+		t.Fatal(err)
+	}
+	if message, info := stored(groupID, "member"); message.Content != text("three edited") || info.LastEditTime != 101 {
+		t.Fatalf("admin edit %s %+v", message.Content, info)
+	}
+	// an edit of another message leaves the latest message alone
+	if msg := latestMsg(groupID); msg.ClientMsgID != "latest" || msg.TextElem == nil || msg.TextElem.Content != "four" {
+		t.Fatalf("latest message %+v", msg)
+	}
+	if len(listener.edited) != 3 || listener.edited[2].ClientMsgID != "member" || conversationListener.changed != 2 {
+		t.Fatalf("edited %d changed %d", len(listener.edited), conversationListener.changed)
+	}
+}
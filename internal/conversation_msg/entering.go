@@ -192,14 +192,8 @@ func (e *typing) onNewMsg(ctx context.Context, msg *sdkws.MsgData) {
 	}
 }
 
-type InputStatesChangedData struct {
-	ConversationID string  `json:"conversationID"`
-	UserID         string  `json:"userID"`
-	PlatformIDs    []int32 `json:"platformIDs"`
-}
-
 func (e *typing) changes(conversationID string, userID string) {
-	data := InputStatesChangedData{ConversationID: conversationID, UserID: userID, PlatformIDs: e.GetInputStates(conversationID, userID)}
+	data := sdk_struct.InputStatesChangedData{ConversationID: conversationID, UserID: userID, PlatformIDs: e.GetInputStates(conversationID, userID)}
 	e.conv.ConversationListener().OnConversationUserInputStatusChanged(utils.StructToJsonString(data))
 }
 
@@ -0,0 +1,41 @@
+//go:build !js
+
+package conversation_msg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/common"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+const testUserID = "testUser"
+
+// newTestConversation returns a Conversation over a fresh local database, without a connection.
+func newTestConversation(t *testing.T, userID string, dir string) *Conversation {
+	ctx := context.Background()
+	database, err := db.NewDataBase(ctx, userID, dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = database.Close(ctx) })
+	return &Conversation{db: database, loginUserID: userID, DataDir: dir, recvCH: make(chan common.Cmd2Value, 10), scheduledWake: make(chan struct{}, 1),
+		outbox: newOutbox(sdk_struct.OutboxConfig{}), destruct: newMessageDestruct(), reactionLocks: utils.NewLockPool(maxReactionLocks)}
+}
+
+func testTextMessage(clientMsgID string, seq int64, text string) *model_struct.LocalChatLog {
+	return &model_struct.LocalChatLog{
+		ClientMsgID: clientMsgID,
+		SendID:      testUserID,
+		ContentType: constant.Text,
+		Content:     utils.StructToJsonString(sdk_struct.TextElem{Content: text}),
+		Status:      constant.MsgStatusSendSuccess,
+		Seq:         seq,
+		SendTime:    1000 + seq,
+	}
+}
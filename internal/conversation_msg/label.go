@@ -0,0 +1,285 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	userPb "github.com/openimsdk/protocol/user"
+	"github.com/openimsdk/protocol/wrapperspb"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+)
+
+// Conversation labels group conversations into folders. They are kept in the user commands, so
+// every device of the user syncs them together with the other commands: a label is a command of
+// type UserCommandConversationLabel keyed by the label ID, and each conversation in a label a
+// command of type UserCommandConversationLabelMember. A membership is a command of its own, so
+// devices adding conversations to the same label at once don't overwrite each other.
+
+type labelValue struct {
+	Name  string `json:"name"`
+	Order int32  `json:"order"`
+}
+
+type labelMemberValue struct {
+	LabelID        string `json:"labelID"`
+	ConversationID string `json:"conversationID"`
+}
+
+func labelMemberUuid(labelID, conversationID string) string {
+	return labelID + "_" + conversationID
+}
+
+// getLabels returns the labels in their order, read from the synced commands. Memberships left
+// by a label deleted on another device are ignored.
+func (c *Conversation) getLabels(ctx context.Context) ([]*sdk_params_callback.ConversationLabel, error) {
+	commands, err := c.db.ProcessUserCommandGetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	labelMap := make(map[string]*sdk_params_callback.ConversationLabel)
+	for _, command := range commands {
+		if command.Type != constant.UserCommandConversationLabel {
+			continue
+		}
+		var value labelValue
+		if err := utils.JsonStringToStruct(command.Value, &value); err != nil {
+			log.ZWarn(ctx, "invalid conversation label", err, "command", command)
+			continue
+		}
+		labelMap[command.Uuid] = &sdk_params_callback.ConversationLabel{LabelID: command.Uuid, Name: value.Name,
+			Order: value.Order, CreateTime: command.CreateTime, ConversationIDs: []string{}}
+	}
+	for _, command := range commands {
+		if command.Type != constant.UserCommandConversationLabelMember {
+			continue
+		}
+		var value labelMemberValue
+		if err := utils.JsonStringToStruct(command.Value, &value); err != nil {
+			log.ZWarn(ctx, "invalid conversation label member", err, "command", command)
+			continue
+		}
+		if label, ok := labelMap[value.LabelID]; ok {
+			label.ConversationIDs = append(label.ConversationIDs, value.ConversationID)
+		}
+	}
+	labels := datautil.Values(labelMap)
+	sort.Slice(labels, func(i, j int) bool {
+		if labels[i].Order != labels[j].Order {
+			return labels[i].Order < labels[j].Order
+		}
+		if labels[i].CreateTime != labels[j].CreateTime {
+			return labels[i].CreateTime < labels[j].CreateTime
+		}
+		return labels[i].LabelID < labels[j].LabelID
+	})
+	return labels, nil
+}
+
+func (c *Conversation) getLabel(ctx context.Context, labelID string) (*sdk_params_callback.ConversationLabel, error) {
+	labels, err := c.getLabels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, label := range labels {
+		if label.LabelID == labelID {
+			return label, nil
+		}
+	}
+	return nil, sdkerrs.ErrArgs.WrapMsg("conversation label not found", "labelID", labelID)
+}
+
+func labelUpdate(label *sdk_params_callback.ConversationLabel) *userPb.ProcessUserCommandUpdateReq {
+	return &userPb.ProcessUserCommandUpdateReq{Type: constant.UserCommandConversationLabel, Uuid: label.LabelID,
+		Value: wrapperspb.String(utils.StructToJsonString(labelValue{Name: label.Name, Order: label.Order}))}
+}
+
+func (c *Conversation) createConversationLabel(ctx context.Context, name string) (*sdk_params_callback.ConversationLabel, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, sdkerrs.ErrArgs.WrapMsg("label name is empty")
+	}
+	labels, err := c.getLabels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// a new label goes last
+	var order int32
+	if len(labels) > 0 {
+		order = labels[len(labels)-1].Order + 1
+	}
+	label := &sdk_params_callback.ConversationLabel{LabelID: utils.GetMsgID(c.loginUserID), Name: name, Order: order,
+		CreateTime: timeutil.GetCurrentTimestampByMill(), ConversationIDs: []string{}}
+	add := &userPb.ProcessUserCommandAddReq{Type: constant.UserCommandConversationLabel, Uuid: label.LabelID,
+		Value: wrapperspb.String(utils.StructToJsonString(labelValue{Name: label.Name, Order: label.Order}))}
+	if err := c.user.ProcessUserCommands(ctx, []*userPb.ProcessUserCommandAddReq{add}, nil, nil); err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+func (c *Conversation) renameConversationLabel(ctx context.Context, labelID, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return sdkerrs.ErrArgs.WrapMsg("label name is empty")
+	}
+	label, err := c.getLabel(ctx, labelID)
+	if err != nil {
+		return err
+	}
+	if label.Name == name {
+		return nil
+	}
+	label.Name = name
+	return c.user.ProcessUserCommands(ctx, nil, []*userPb.ProcessUserCommandUpdateReq{labelUpdate(label)}, nil)
+}
+
+// deleteConversationLabel deletes a label with its memberships, the conversations are kept.
+func (c *Conversation) deleteConversationLabel(ctx context.Context, labelID string) error {
+	label, err := c.getLabel(ctx, labelID)
+	if err != nil {
+		return err
+	}
+	deletes := []*userPb.ProcessUserCommandDeleteReq{{Type: constant.UserCommandConversationLabel, Uuid: label.LabelID}}
+	for _, conversationID := range label.ConversationIDs {
+		deletes = append(deletes, &userPb.ProcessUserCommandDeleteReq{Type: constant.UserCommandConversationLabelMember, Uuid: labelMemberUuid(labelID, conversationID)})
+	}
+	return c.user.ProcessUserCommands(ctx, nil, nil, deletes)
+}
+
+// reorderLabels returns the updates putting the labels in the order of labelIDs, which must
+// list every label once.
+func reorderLabels(labels []*sdk_params_callback.ConversationLabel, labelIDs []string) ([]*userPb.ProcessUserCommandUpdateReq, error) {
+	if len(labelIDs) != len(labels) || datautil.Duplicate(labelIDs) {
+		return nil, sdkerrs.ErrArgs.WrapMsg("labelIDs must list every label once", "labelIDs", labelIDs)
+	}
+	labelMap := datautil.SliceToMap(labels, func(label *sdk_params_callback.ConversationLabel) string { return label.LabelID })
+	var updates []*userPb.ProcessUserCommandUpdateReq
+	for i, labelID := range labelIDs {
+		label, ok := labelMap[labelID]
+		if !ok {
+			return nil, sdkerrs.ErrArgs.WrapMsg("conversation label not found", "labelID", labelID)
+		}
+		if label.Order != int32(i) {
+			label.Order = int32(i)
+			updates = append(updates, labelUpdate(label))
+		}
+	}
+	return updates, nil
+}
+
+func (c *Conversation) reorderConversationLabels(ctx context.Context, labelIDs []string) error {
+	labels, err := c.getLabels(ctx)
+	if err != nil {
+		return err
+	}
+	updates, err := reorderLabels(labels, labelIDs)
+	if err != nil || len(updates) == 0 {
+		return err
+	}
+	return c.user.ProcessUserCommands(ctx, nil, updates, nil)
+}
+
+func (c *Conversation) addConversationsToLabel(ctx context.Context, labelID string, conversationIDs []string) error {
+	label, err := c.getLabel(ctx, labelID)
+	if err != nil {
+		return err
+	}
+	conversations, err := c.db.GetMultipleConversationDB(ctx, conversationIDs)
+	if err != nil {
+		return err
+	}
+	if len(conversations) != len(datautil.Distinct(conversationIDs)) {
+		return sdkerrs.ErrArgs.WrapMsg("conversation not found", "conversationIDs", conversationIDs)
+	}
+	var adds []*userPb.ProcessUserCommandAddReq
+	for _, conversation := range conversations {
+		if datautil.Contain(conversation.ConversationID, label.ConversationIDs...) {
+			continue
+		}
+		adds = append(adds, &userPb.ProcessUserCommandAddReq{Type: constant.UserCommandConversationLabelMember, Uuid: labelMemberUuid(labelID, conversation.ConversationID),
+			Value: wrapperspb.String(utils.StructToJsonString(labelMemberValue{LabelID: labelID, ConversationID: conversation.ConversationID}))})
+	}
+	if len(adds) == 0 {
+		return nil
+	}
+	return c.user.ProcessUserCommands(ctx, adds, nil, nil)
+}
+
+func (c *Conversation) removeConversationsFromLabel(ctx context.Context, labelID string, conversationIDs []string) error {
+	label, err := c.getLabel(ctx, labelID)
+	if err != nil {
+		return err
+	}
+	var deletes []*userPb.ProcessUserCommandDeleteReq
+	for _, conversationID := range datautil.Distinct(conversationIDs) {
+		if datautil.Contain(conversationID, label.ConversationIDs...) {
+			deletes = append(deletes, &userPb.ProcessUserCommandDeleteReq{Type: constant.UserCommandConversationLabelMember, Uuid: labelMemberUuid(labelID, conversationID)})
+		}
+	}
+	if len(deletes) == 0 {
+		return nil
+	}
+	return c.user.ProcessUserCommands(ctx, nil, nil, deletes)
+}
+
+// getConversationLabels returns the labels with the unread totals of their conversations.
+func (c *Conversation) getConversationLabels(ctx context.Context) ([]*sdk_params_callback.ConversationLabel, error) {
+	labels, err := c.getLabels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(labels) == 0 {
+		return labels, nil
+	}
+	conversations, err := c.db.GetAllConversationListDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conversationMap := datautil.SliceToMap(conversations, func(conversation *model_struct.LocalConversation) string { return conversation.ConversationID })
+	for _, label := range labels {
+		for _, conversationID := range label.ConversationIDs {
+			if conversation, ok := conversationMap[conversationID]; ok && conversation.RecvMsgOpt < constant.ReceiveNotNotifyMessage {
+				label.UnreadCount += conversation.UnreadCount
+			}
+		}
+	}
+	return labels, nil
+}
+
+// getConversationListByLabel returns the conversations of a label in the order of the
+// conversation list.
+func (c *Conversation) getConversationListByLabel(ctx context.Context, labelID string) ([]*model_struct.LocalConversation, error) {
+	label, err := c.getLabel(ctx, labelID)
+	if err != nil {
+		return nil, err
+	}
+	conversations, err := c.db.GetAllConversationListDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return datautil.Filter(conversations, func(conversation *model_struct.LocalConversation) (*model_struct.LocalConversation, bool) {
+		return conversation, datautil.Contain(conversation.ConversationID, label.ConversationIDs...)
+	}), nil
+}
@@ -0,0 +1,104 @@
+//go:build !js
+
+package conversation_msg
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+)
+
+func addLabelCommand(t *testing.T, c *Conversation, labelID, name string, order int32, createTime int64) {
+	err := c.db.ProcessUserCommandAdd(context.Background(), &model_struct.LocalUserCommand{UserID: c.loginUserID, Type: constant.UserCommandConversationLabel,
+		Uuid: labelID, CreateTime: createTime, Value: utils.StructToJsonString(labelValue{Name: name, Order: order})})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func addLabelMemberCommand(t *testing.T, c *Conversation, labelID, conversationID string) {
+	err := c.db.ProcessUserCommandAdd(context.Background(), &model_struct.LocalUserCommand{UserID: c.loginUserID, Type: constant.UserCommandConversationLabelMember,
+		Uuid: labelMemberUuid(labelID, conversationID), Value: utils.StructToJsonString(labelMemberValue{LabelID: labelID, ConversationID: conversationID})})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConversationLabels(t *testing.T) {
+	ctx := context.Background()
+	c := newTestConversation(t, testUserID, t.TempDir())
+	conversations := []*model_struct.LocalConversation{
+		{ConversationID: "c1", ConversationType: constant.SingleChatType, UnreadCount: 2, LatestMsgSendTime: 300},
+		{ConversationID: "c2", ConversationType: constant.SingleChatType, UnreadCount: 5, LatestMsgSendTime: 200, RecvMsgOpt: constant.ReceiveNotNotifyMessage},
+		{ConversationID: "c3", ConversationType: constant.SingleChatType, UnreadCount: 1, LatestMsgSendTime: 100},
+	}
+	for _, conversation := range conversations {
+		if err := c.db.InsertConversation(ctx, conversation); err != nil {
+			t.Fatal(err)
+		}
+	}
+	addLabelCommand(t, c, "work", "Work", 1, 20)
+	addLabelCommand(t, c, "family", "Family", 0, 30)
+	addLabelCommand(t, c, "friends", "Friends", 1, 10)
+	addLabelMemberCommand(t, c, "work", "c3")
+	addLabelMemberCommand(t, c, "work", "c1")
+	addLabelMemberCommand(t, c, "work", "c2")
+	addLabelMemberCommand(t, c, "friends", "c3")
+	// left by a label deleted on another device
+	addLabelMemberCommand(t, c, "deleted", "c1")
+
+	labels, err := c.getConversationLabels(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, label := range labels {
+		got = append(got, fmt.Sprintf("%s:%d:%d", label.Name, len(label.ConversationIDs), label.UnreadCount))
+	}
+	// the not notified conversation is in the label but not in its unread total
+	if fmt.Sprint(got) != "[Family:0:0 Friends:1:1 Work:3:3]" {
+		t.Fatalf("labels = %v", got)
+	}
+
+	list, err := c.getConversationListByLabel(ctx, "work")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = nil
+	for _, conversation := range list {
+		got = append(got, conversation.ConversationID)
+	}
+	if fmt.Sprint(got) != "[c1 c2 c3]" {
+		t.Fatalf("conversations = %v", got)
+	}
+	if _, err := c.getConversationListByLabel(ctx, "deleted"); err == nil {
+		t.Fatal("expected an error for a deleted label")
+	}
+}
+
+func TestReorderLabels(t *testing.T) {
+	labels := func() []*sdk_params_callback.ConversationLabel {
+		return []*sdk_params_callback.ConversationLabel{{LabelID: "a", Order: 0}, {LabelID: "b", Order: 1}, {LabelID: "c", Order: 2}}
+	}
+	updates, err := reorderLabels(labels(), []string{"a", "c", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, update := range updates {
+		got = append(got, update.Uuid+update.Value.GetValue())
+	}
+	if fmt.Sprint(got) != `[c{"name":"","order":1} b{"name":"","order":2}]` {
+		t.Fatalf("updates = %v", got)
+	}
+	for _, labelIDs := range [][]string{{"a", "b"}, {"a", "b", "b"}, {"a", "b", "d"}} {
+		if _, err := reorderLabels(labels(), labelIDs); err == nil {
+			t.Fatalf("expected an error for %v", labelIDs)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/common"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// The mentions inbox lists the messages of other users mentioning the login user across
+// conversations. A mention stays unread until MarkMentionsRead, reading its conversation
+// doesn't read it, and is dropped when its message is revoked or deleted.
+
+// mentionType returns how a message mentions the login user, 0 when it doesn't.
+func (c *Conversation) mentionType(message *sdk_struct.MsgStruct) int32 {
+	if message.SendID == c.loginUserID {
+		return 0
+	}
+	var quoted *sdk_struct.MsgStruct
+	switch message.ContentType {
+	case constant.AtText:
+		if message.AtTextElem == nil {
+			return 0
+		}
+		if datautil.Contain(c.loginUserID, message.AtTextElem.AtUserList...) {
+			return constant.MentionAtMe
+		}
+		if datautil.Contain(constant.AtAllString, message.AtTextElem.AtUserList...) {
+			return constant.MentionAtAll
+		}
+		quoted = message.AtTextElem.QuoteMessage
+	case constant.Quote:
+		if message.QuoteElem != nil {
+			quoted = message.QuoteElem.QuoteMessage
+		}
+	}
+	if quoted != nil && quoted.SendID == c.loginUserID {
+		return constant.MentionQuote
+	}
+	return 0
+}
+
+// addMentions records the new messages mentioning the login user. Online only messages are not
+// stored, so they are left out.
+func (c *Conversation) addMentions(ctx context.Context, messages sdk_struct.NewMsgList, onlineMsg map[onlineMsgKey]struct{}) {
+	var mentions []*model_struct.LocalMention
+	for _, message := range messages {
+		if _, ok := onlineMsg[onlineMsgKey{ClientMsgID: message.ClientMsgID, ServerMsgID: message.ServerMsgID}]; ok {
+			continue
+		}
+		mentionType := c.mentionType(message)
+		if mentionType == 0 {
+			continue
+		}
+		mentions = append(mentions, &model_struct.LocalMention{
+			ConversationID: utils.GetConversationIDByMsg(message),
+			ClientMsgID:    message.ClientMsgID,
+			MentionType:    mentionType,
+			SendID:         message.SendID,
+			SendTime:       message.SendTime,
+		})
+	}
+	if len(mentions) == 0 {
+		return
+	}
+	if err := c.db.InsertMentions(ctx, mentions); err != nil {
+		log.ZWarn(ctx, "InsertMentions err", err, "mentions", mentions)
+		return
+	}
+	c.unreadMentionCountTrigger(ctx)
+}
+
+func (c *Conversation) getMentionMessages(ctx context.Context, req sdk_params_callback.GetMentionMessagesParams) (*sdk_params_callback.GetMentionMessagesCallback, error) {
+	if req.Count <= 0 {
+		return nil, sdkerrs.ErrArgs.WrapMsg("count must be greater than 0")
+	}
+	var startTime int64
+	if req.StartClientMsgID != "" {
+		start, err := c.db.GetMention(ctx, req.StartConversationID, req.StartClientMsgID)
+		if err != nil {
+			return nil, err
+		}
+		startTime = start.SendTime
+	}
+	mentions, err := c.db.GetMentions(ctx, startTime, req.StartConversationID, req.StartClientMsgID, req.UnreadOnly, req.Count)
+	if err != nil {
+		return nil, err
+	}
+	clientMsgIDs := make(map[string][]string)
+	for _, mention := range mentions {
+		clientMsgIDs[mention.ConversationID] = append(clientMsgIDs[mention.ConversationID], mention.ClientMsgID)
+	}
+	messageMap := make(map[[2]string]*model_struct.LocalChatLog, len(mentions))
+	for conversationID, ids := range clientMsgIDs {
+		messages, err := c.db.GetMessagesByClientMsgIDs(ctx, conversationID, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, message := range messages {
+			messageMap[[2]string{conversationID, message.ClientMsgID}] = message
+		}
+	}
+	res := &sdk_params_callback.GetMentionMessagesCallback{MentionList: make([]*sdk_params_callback.MentionMessage, 0, len(mentions)), IsEnd: len(mentions) < req.Count}
+	for _, mention := range mentions {
+		message, ok := messageMap[[2]string{mention.ConversationID, mention.ClientMsgID}]
+		if !ok {
+			continue
+		}
+		res.MentionList = append(res.MentionList, &sdk_params_callback.MentionMessage{
+			ConversationID: mention.ConversationID,
+			MentionType:    mention.MentionType,
+			IsRead:         mention.IsRead,
+			Message:        LocalChatLogToMsgStruct(message),
+		})
+	}
+	return res, nil
+}
+
+func (c *Conversation) markMentionsRead(ctx context.Context, conversationID string, clientMsgIDs []string) error {
+	if conversationID == "" {
+		return sdkerrs.ErrArgs.WrapMsg("conversationID is empty")
+	}
+	n, err := c.db.MarkMentionsRead(ctx, conversationID, clientMsgIDs)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		c.unreadMentionCountTrigger(ctx)
+	}
+	return nil
+}
+
+// removeMessageMentions drops the mentions of messages revoked or deleted.
+func (c *Conversation) removeMessageMentions(ctx context.Context, conversationID string, clientMsgIDs []string) {
+	c.removeMentions(ctx, func() error { return c.db.DeleteMentions(ctx, conversationID, clientMsgIDs) })
+}
+
+// removeConversationMentions drops every mention of a conversation whose messages are cleared.
+func (c *Conversation) removeConversationMentions(ctx context.Context, conversationID string) {
+	c.removeMentions(ctx, func() error { return c.db.DeleteConversationMentions(ctx, conversationID) })
+}
+
+func (c *Conversation) removeMentions(ctx context.Context, remove func() error) {
+	before, err := c.db.GetUnreadMentionCount(ctx)
+	if err != nil {
+		log.ZWarn(ctx, "GetUnreadMentionCount err", err)
+		return
+	}
+	if err := remove(); err != nil {
+		log.ZWarn(ctx, "remove mentions err", err)
+		return
+	}
+	if after, err := c.db.GetUnreadMentionCount(ctx); err == nil && after != before {
+		c.unreadMentionCountTrigger(ctx)
+	}
+}
+
+func (c *Conversation) unreadMentionCountTrigger(ctx context.Context) {
+	c.doUpdateConversation(common.Cmd2Value{Value: common.UpdateConNode{Action: constant.UnreadMentionCountChanged}, Ctx: ctx})
+}
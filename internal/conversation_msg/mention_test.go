@@ -0,0 +1,123 @@
+//go:build !js
+
+package conversation_msg
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+type mentionConversationListener struct {
+	open_im_sdk_callback.OnConversationListener
+	counts []int32
+}
+
+func (l *mentionConversationListener) OnConversationChanged(string) {}
+
+func (l *mentionConversationListener) OnTotalUnreadMessageCountChanged(int32) {}
+
+func (l *mentionConversationListener) OnUnreadMentionCountChanged(totalUnreadCount int32) {
+	l.counts = append(l.counts, totalUnreadCount)
+}
+
+func groupMessage(clientMsgID string, seq int64, sendID string) *sdk_struct.MsgStruct {
+	return &sdk_struct.MsgStruct{ClientMsgID: clientMsgID, ServerMsgID: "s" + clientMsgID, SendID: sendID, GroupID: "group",
+		SessionType: constant.ReadGroupChatType, ContentType: constant.Text, Status: constant.MsgStatusSendSuccess,
+		Seq: seq, SendTime: 1000 + seq, TextElem: &sdk_struct.TextElem{Content: clientMsgID}}
+}
+
+func atMessage(clientMsgID string, seq int64, atUserList ...string) *sdk_struct.MsgStruct {
+	message := groupMessage(clientMsgID, seq, "peer")
+	message.ContentType = constant.AtText
+	message.TextElem = nil
+	message.AtTextElem = &sdk_struct.AtTextElem{Text: clientMsgID, AtUserList: atUserList}
+	return message
+}
+
+func TestMentions(t *testing.T) {
+	ctx := context.Background()
+	c := newTestConversation(t, testUserID, t.TempDir())
+	listener := &mentionConversationListener{}
+	c.ConversationListener = func() open_im_sdk_callback.OnConversationListener { return listener }
+	c.msgListener = func() open_im_sdk_callback.OnAdvancedMsgListener { return &pinListener{} }
+	conversationID := "sg_group"
+	if err := c.db.InsertConversation(ctx, &model_struct.LocalConversation{ConversationID: conversationID, ConversationType: constant.ReadGroupChatType, GroupID: "group"}); err != nil {
+		t.Fatal(err)
+	}
+
+	mine := groupMessage("mine", 1, testUserID)
+	quote := groupMessage("quote", 2, "peer")
+	quote.ContentType = constant.Quote
+	quote.TextElem = nil
+	quote.QuoteElem = &sdk_struct.QuoteElem{Text: "quote", QuoteMessage: mine}
+	selfAt := atMessage("selfAt", 6, testUserID)
+	selfAt.SendID = testUserID
+	messages := sdk_struct.NewMsgList{
+		mine, quote,
+		atMessage("atMe", 3, "other", testUserID),
+		atMessage("atAll", 4, constant.AtAllString),
+		atMessage("atOther", 5, "other"),
+		selfAt,
+	}
+	var logs []*model_struct.LocalChatLog
+	for _, message := range messages {
+		logs = append(logs, MsgStructToLocalChatLog(message))
+	}
+	if err := c.db.BatchInsertMessageList(ctx, conversationID, logs); err != nil {
+		t.Fatal(err)
+	}
+	// online only messages are not stored, so they are not mentions
+	online := atMessage("online", 7, testUserID)
+	c.addMentions(ctx, append(messages, online), map[onlineMsgKey]struct{}{{ClientMsgID: online.ClientMsgID, ServerMsgID: online.ServerMsgID}: {}})
+	c.addMentions(ctx, messages, nil) // replayed messages are recorded once
+
+	page := func(req sdk_params_callback.GetMentionMessagesParams) ([]string, []int32) {
+		t.Helper()
+		res, err := c.getMentionMessages(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		var types []int32
+		for _, mention := range res.MentionList {
+			ids = append(ids, mention.Message.ClientMsgID)
+			types = append(types, mention.MentionType)
+		}
+		return ids, types
+	}
+	ids, types := page(sdk_params_callback.GetMentionMessagesParams{Count: 2})
+	if len(ids) != 2 || ids[0] != "atAll" || ids[1] != "atMe" || types[0] != constant.MentionAtAll || types[1] != constant.MentionAtMe {
+		t.Fatalf("first mention page %v %v", ids, types)
+	}
+	ids, types = page(sdk_params_callback.GetMentionMessagesParams{StartConversationID: conversationID, StartClientMsgID: "atMe", Count: 2})
+	if len(ids) != 1 || ids[0] != "quote" || types[0] != constant.MentionQuote {
+		t.Fatalf("next mention page %v %v", ids, types)
+	}
+
+	if err := c.markMentionsRead(ctx, conversationID, []string{"atAll"}); err != nil {
+		t.Fatal(err)
+	}
+	if ids, _ = page(sdk_params_callback.GetMentionMessagesParams{Count: 10, UnreadOnly: true}); len(ids) != 2 || ids[0] != "atMe" {
+		t.Fatalf("unread mentions %v", ids)
+	}
+	// deleting a mention drops it from the inbox
+	if err := c.deleteMessageFromLocal(ctx, conversationID, "atMe"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.markMentionsRead(ctx, conversationID, nil); err != nil {
+		t.Fatal(err)
+	}
+	if ids, _ = page(sdk_params_callback.GetMentionMessagesParams{Count: 10}); len(ids) != 2 || ids[0] != "atAll" || ids[1] != "quote" {
+		t.Fatalf("mentions after delete %v", ids)
+	}
+	if want := "[3 3 2 1 0]"; fmt.Sprint(listener.counts) != want {
+		t.Fatalf("unread mention counts %v, want %s", listener.counts, want)
+	}
+}
@@ -177,6 +177,12 @@ func (c *Conversation) doNotification(ctx context.Context, msg *sdkws.MsgData) e
 		return c.doClearConversations(ctx, msg)
 	case constant.DeleteMsgsNotification:
 		return c.doDeleteMsgs(ctx, msg)
+	case constant.MsgEditNotification:
+		return c.doEditMsg(ctx, msg)
+	case constant.MsgReactionNotification:
+		return c.doMsgReaction(ctx, msg)
+	case constant.MsgPinNotification:
+		return c.doMsgPin(ctx, msg)
 	case constant.HasReadReceipt: // 2200
 		return c.doReadDrawing(ctx, msg)
 	case pconstant.StreamMsgNotification:
@@ -237,6 +243,13 @@ func (c *Conversation) doUpdateConversation(c2v common.Cmd2Value) {
 		} else {
 			c.ConversationListener().OnTotalUnreadMessageCountChanged(totalUnreadCount)
 		}
+	case constant.UnreadMentionCountChanged:
+		unreadMentionCount, err := c.db.GetUnreadMentionCount(ctx)
+		if err != nil {
+			log.ZWarn(ctx, "GetUnreadMentionCount err", err)
+		} else {
+			c.ConversationListener().OnUnreadMentionCountChanged(unreadMentionCount)
+		}
 	case constant.UpdateConFaceUrlAndNickName:
 		var lc model_struct.LocalConversation
 		st := node.Args.(common.SourceIDAndSessionType)
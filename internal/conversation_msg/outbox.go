@@ -0,0 +1,359 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/ccontext"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/protocol/sdkws"
+
+	"github.com/openimsdk/tools/log"
+)
+
+const (
+	defaultOutboxMaxAttempts      = 5
+	defaultOutboxRetryInterval    = time.Second
+	defaultOutboxMaxRetryInterval = 30 * time.Second
+	defaultOutboxExpire           = 24 * time.Hour
+	// outboxIdleWait caps the sleep of the dispatcher so expired messages are noticed.
+	outboxIdleWait = time.Minute
+)
+
+// outboxSendKey marks the context of a send made by the outbox dispatcher.
+type outboxSendKey struct{}
+
+func withOutboxSend(ctx context.Context) context.Context {
+	return context.WithValue(ctx, outboxSendKey{}, true)
+}
+
+func isOutboxSend(ctx context.Context) bool {
+	ok, _ := ctx.Value(outboxSendKey{}).(bool)
+	return ok
+}
+
+// outbox queues the messages sent while disconnected. The queue itself is kept in the local
+// database, the outbox only remembers the callers still waiting on their messages.
+type outbox struct {
+	disable          bool
+	wait             bool
+	maxAttempts      int32
+	retryInterval    time.Duration
+	maxRetryInterval time.Duration
+	expire           time.Duration
+
+	wake        chan struct{}
+	lock        sync.Mutex
+	lastOrderID int64
+	waiters     map[string]*outboxWaiter
+}
+
+type outboxWaiter struct {
+	callback open_im_sdk_callback.SendMsgCallBack
+	done     chan outboxResult
+}
+
+type outboxResult struct {
+	message *sdk_struct.MsgStruct
+	err     error
+}
+
+func newOutbox(conf sdk_struct.OutboxConfig) *outbox {
+	o := &outbox{
+		disable:          conf.Disable,
+		wait:             conf.Wait,
+		maxAttempts:      conf.MaxAttempts,
+		retryInterval:    time.Duration(conf.RetryInterval) * time.Millisecond,
+		maxRetryInterval: time.Duration(conf.MaxRetryInterval) * time.Millisecond,
+		expire:           time.Duration(conf.Expire) * time.Millisecond,
+		wake:             make(chan struct{}, 1),
+		waiters:          make(map[string]*outboxWaiter),
+	}
+	if o.maxAttempts <= 0 {
+		o.maxAttempts = defaultOutboxMaxAttempts
+	}
+	if o.retryInterval <= 0 {
+		o.retryInterval = defaultOutboxRetryInterval
+	}
+	if o.maxRetryInterval <= 0 {
+		o.maxRetryInterval = defaultOutboxMaxRetryInterval
+	}
+	if o.maxRetryInterval < o.retryInterval {
+		o.maxRetryInterval = o.retryInterval
+	}
+	if o.expire <= 0 {
+		o.expire = defaultOutboxExpire
+	}
+	return o
+}
+
+// backoff is the wait after the given number of failed sends, doubled per attempt.
+func (o *outbox) backoff(attempts int32) time.Duration {
+	wait := o.retryInterval
+	for i := int32(1); i < attempts && wait < o.maxRetryInterval; i++ {
+		wait *= 2
+	}
+	return min(wait, o.maxRetryInterval)
+}
+
+// nextOrderID returns an increasing id keeping the send order of queued messages.
+func (o *outbox) nextOrderID() int64 {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	id := time.Now().UnixMilli() * 1000
+	if id <= o.lastOrderID {
+		id = o.lastOrderID + 1
+	}
+	o.lastOrderID = id
+	return id
+}
+
+func (o *outbox) addWaiter(clientMsgID string, callback open_im_sdk_callback.SendMsgCallBack) *outboxWaiter {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	w := &outboxWaiter{callback: callback, done: make(chan outboxResult, 1)}
+	o.waiters[clientMsgID] = w
+	return w
+}
+
+func (o *outbox) removeWaiter(clientMsgID string, w *outboxWaiter) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if o.waiters[clientMsgID] == w {
+		delete(o.waiters, clientMsgID)
+	}
+}
+
+// callback returns the callback of the caller waiting on a message, messages queued before a
+// restart have nobody waiting.
+func (o *outbox) callback(clientMsgID string) open_im_sdk_callback.SendMsgCallBack {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if w, ok := o.waiters[clientMsgID]; ok && w.callback != nil {
+		return w.callback
+	}
+	return emptySendMsgCallback{}
+}
+
+func (o *outbox) finish(clientMsgID string, res outboxResult) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if w, ok := o.waiters[clientMsgID]; ok {
+		delete(o.waiters, clientMsgID)
+		w.done <- res
+	}
+}
+
+func (c *Conversation) wakeOutbox() {
+	select {
+	case c.outbox.wake <- struct{}{}:
+	default:
+	}
+}
+
+// shouldQueue reports whether a message to conversationID goes through the outbox: the
+// connection is down, or earlier messages of the conversation are still queued.
+func (c *Conversation) shouldQueue(ctx context.Context, conversationID string) bool {
+	if c.outbox.disable || isOutboxSend(ctx) {
+		return false
+	}
+	if !c.IsConnected() {
+		return true
+	}
+	queued, err := c.db.GetConversationOutboxMessages(ctx, conversationID)
+	if err != nil {
+		log.ZWarn(ctx, "get conversation outbox messages failed", err, "conversationID", conversationID)
+		return false
+	}
+	return len(queued) > 0
+}
+
+// queueMessage puts a message, already stored locally as sending, into the outbox and returns
+// it still sending, OnOutboxMessageStatusChanged reports the outcome. With the wait option it
+// waits until the outbox sends the message or gives up on it instead.
+func (c *Conversation) queueMessage(ctx context.Context, s *sdk_struct.MsgStruct, lc *model_struct.LocalConversation,
+	recvID, groupID string, offlinePushInfo *sdkws.OfflinePushInfo, callback open_im_sdk_callback.SendMsgCallBack) (*sdk_struct.MsgStruct, error) {
+	message := &model_struct.LocalOutboxMessage{
+		ClientMsgID:    s.ClientMsgID,
+		ConversationID: lc.ConversationID,
+		RecvID:         recvID,
+		GroupID:        groupID,
+		OrderID:        c.outbox.nextOrderID(),
+		Message:        utils.StructToJsonString(s),
+		CreateTime:     time.Now().UnixMilli(),
+	}
+	if offlinePushInfo != nil {
+		message.OfflinePushInfo = utils.StructToJsonString(offlinePushInfo)
+	}
+	var waiter *outboxWaiter
+	if c.outbox.wait {
+		waiter = c.outbox.addWaiter(s.ClientMsgID, callback)
+		defer c.outbox.removeWaiter(s.ClientMsgID, waiter)
+	}
+	if err := c.db.InsertOutboxMessage(ctx, message); err != nil {
+		c.updateMsgStatusAndTriggerConversation(ctx, s.ClientMsgID, "", s.CreateTime, constant.MsgStatusSendFailed, s, lc, false)
+		return nil, err
+	}
+	status := constant.OutboxQueued
+	if !c.IsConnected() {
+		status = constant.OutboxWaitingNetwork
+	}
+	log.ZInfo(ctx, "message queued in the outbox", "clientMsgID", s.ClientMsgID, "conversationID", lc.ConversationID, "status", status)
+	c.outboxStatus(s, status, 0)
+	c.wakeOutbox()
+	if waiter == nil {
+		return s, nil
+	}
+	select {
+	case res := <-waiter.done:
+		return res.message, res.err
+	case <-ctx.Done():
+		return nil, sdkerrs.ErrNetwork.WrapMsg("stopped waiting, the message stays in the outbox")
+	}
+}
+
+func (c *Conversation) outboxStatus(s *sdk_struct.MsgStruct, status int, attempts int32) {
+	c.msgListener().OnOutboxMessageStatusChanged(utils.StructToJsonString(s), int32(status), attempts)
+}
+
+// RunOutbox sends the queued messages in order until ctx is done. The queue is drained each
+// time the long connection is established, network failures are retried with a backoff.
+func (c *Conversation) RunOutbox(ctx context.Context) {
+	for {
+		wait := c.dispatchOutbox(ctx)
+		var connected <-chan struct{}
+		if !c.IsConnected() {
+			connected = c.ConnectedNotify()
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-c.outbox.wake:
+			timer.Stop()
+		case <-connected:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// dispatchOutbox sends the queued messages that are ready and returns how long to wait for the
+// next one. A message that cannot be sent yet holds back the later ones of its conversation.
+func (c *Conversation) dispatchOutbox(ctx context.Context) time.Duration {
+	list, err := c.db.GetAllOutboxMessages(ctx)
+	if err != nil {
+		log.ZWarn(ctx, "get outbox messages failed", err)
+		return c.outbox.retryInterval
+	}
+	wait := outboxIdleWait
+	blocked := make(map[string]struct{})
+	for _, message := range list {
+		if ctx.Err() != nil {
+			return 0
+		}
+		if _, ok := blocked[message.ConversationID]; ok {
+			continue
+		}
+		var s sdk_struct.MsgStruct
+		if err := utils.JsonStringToStruct(message.Message, &s); err != nil {
+			log.ZError(ctx, "outbox message is broken", err, "clientMsgID", message.ClientMsgID)
+			s.ClientMsgID = message.ClientMsgID
+			c.finishOutboxMessage(ctx, message, &s, constant.OutboxFailed, sdkerrs.ErrSdkInternal.WrapMsg("outbox message is broken"))
+			continue
+		}
+		expireAt := time.UnixMilli(message.CreateTime).Add(c.outbox.expire)
+		if !time.Now().Before(expireAt) {
+			c.finishOutboxMessage(ctx, message, &s, constant.OutboxExpired, sdkerrs.ErrMsgExpired)
+			continue
+		}
+		if c.IsConnected() && time.Now().UnixMilli() >= message.NextAttemptTime && c.sendOutboxMessage(ctx, message, &s) {
+			continue
+		}
+		blocked[message.ConversationID] = struct{}{}
+		wait = min(wait, time.Until(expireAt))
+		if c.IsConnected() {
+			wait = min(wait, time.Until(time.UnixMilli(message.NextAttemptTime)))
+		}
+	}
+	return max(wait, 0)
+}
+
+// sendOutboxMessage sends a queued message through SendMessage and reports whether it left the
+// queue. A network failure keeps it queued with a backoff until it runs out of attempts.
+func (c *Conversation) sendOutboxMessage(ctx context.Context, message *model_struct.LocalOutboxMessage, s *sdk_struct.MsgStruct) bool {
+	var offlinePushInfo *sdkws.OfflinePushInfo
+	if message.OfflinePushInfo != "" {
+		offlinePushInfo = &sdkws.OfflinePushInfo{}
+		_ = utils.JsonStringToStruct(message.OfflinePushInfo, offlinePushInfo)
+	}
+	message.Attempts++
+	sendCtx := withOutboxSend(ccontext.WithOperationID(ctx, utils.OperationIDGenerator()))
+	sendCtx = context.WithValue(sendCtx, "callback", c.outbox.callback(message.ClientMsgID))
+	log.ZInfo(sendCtx, "send outbox message", "clientMsgID", message.ClientMsgID, "attempts", message.Attempts)
+	c.outboxStatus(s, constant.OutboxSending, message.Attempts)
+	sent, err := c.SendMessage(sendCtx, s, message.RecvID, message.GroupID, offlinePushInfo, false)
+	if err == nil {
+		c.finishOutboxMessage(ctx, message, sent, constant.OutboxSent, nil)
+		return true
+	}
+	if (sdkerrs.ErrNetwork.Is(err) || sdkerrs.ErrNetworkTimeOut.Is(err)) && message.Attempts < c.outbox.maxAttempts {
+		message.NextAttemptTime = time.Now().Add(c.outbox.backoff(message.Attempts)).UnixMilli()
+		if updateErr := c.db.UpdateOutboxMessage(ctx, message); updateErr == nil {
+			log.ZWarn(sendCtx, "outbox message send failed, retry", err, "clientMsgID", message.ClientMsgID, "attempts", message.Attempts)
+			c.outboxStatus(s, constant.OutboxRetryWaiting, message.Attempts)
+			return false
+		}
+	}
+	c.finishOutboxMessage(ctx, message, s, constant.OutboxFailed, err)
+	return true
+}
+
+// finishOutboxMessage removes a message from the queue, marks it failed on sendErr and hands
+// the outcome to its listener and waiting caller.
+func (c *Conversation) finishOutboxMessage(ctx context.Context, message *model_struct.LocalOutboxMessage, s *sdk_struct.MsgStruct, status int, sendErr error) {
+	if err := c.db.DeleteOutboxMessage(ctx, message.ClientMsgID); err != nil {
+		log.ZError(ctx, "delete outbox message failed", err, "clientMsgID", message.ClientMsgID)
+	}
+	if sendErr != nil {
+		log.ZError(ctx, "outbox message send failed", sendErr, "clientMsgID", message.ClientMsgID, "status", status, "attempts", message.Attempts)
+		c.failOutboxMessage(ctx, message.ConversationID, s)
+	}
+	c.outboxStatus(s, status, message.Attempts)
+	c.outbox.finish(message.ClientMsgID, outboxResult{message: s, err: sendErr})
+}
+
+func (c *Conversation) failOutboxMessage(ctx context.Context, conversationID string, s *sdk_struct.MsgStruct) {
+	lc, err := c.db.GetConversation(ctx, conversationID)
+	if err == nil {
+		c.updateMsgStatusAndTriggerConversation(ctx, s.ClientMsgID, "", s.CreateTime, constant.MsgStatusSendFailed, s, lc, false)
+		return
+	}
+	if err := c.db.UpdateMessageTimeAndStatus(ctx, conversationID, s.ClientMsgID, "", s.CreateTime, constant.MsgStatusSendFailed); err != nil {
+		log.ZWarn(ctx, "update outbox message status failed", err, "clientMsgID", s.ClientMsgID)
+	}
+	if err := c.db.DeleteSendingMessage(ctx, conversationID, s.ClientMsgID); err != nil {
+		log.ZWarn(ctx, "delete outbox sending message failed", err, "clientMsgID", s.ClientMsgID)
+	}
+}
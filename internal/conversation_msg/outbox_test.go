@@ -0,0 +1,172 @@
+//go:build !js
+
+package conversation_msg
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/internal/interaction"
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+type outboxListener struct {
+	open_im_sdk_callback.OnAdvancedMsgListener
+	lock     sync.Mutex
+	statuses []int32
+}
+
+func (l *outboxListener) OnOutboxMessageStatusChanged(message string, status int32, attempts int32) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.statuses = append(l.statuses, status)
+}
+
+func (l *outboxListener) last() int32 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if len(l.statuses) == 0 {
+		return 0
+	}
+	return l.statuses[len(l.statuses)-1]
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	o := newOutbox(sdk_struct.OutboxConfig{RetryInterval: 100, MaxRetryInterval: 500})
+	for attempts, want := range map[int32]time.Duration{1: 100, 2: 200, 3: 400, 4: 500, 10: 500} {
+		if wait := o.backoff(attempts); wait != want*time.Millisecond {
+			t.Fatalf("backoff %d = %s, want %s", attempts, wait, want*time.Millisecond)
+		}
+	}
+	if o := newOutbox(sdk_struct.OutboxConfig{}); o.maxAttempts != defaultOutboxMaxAttempts || o.expire != defaultOutboxExpire {
+		t.Fatalf("defaults %+v", o)
+	}
+}
+
+// newDisconnectedConversation returns a conversation of userID to peerID without a long connection.
+func newDisconnectedConversation(t *testing.T, ctx context.Context, userID, peerID string) (*Conversation, string, *outboxListener) {
+	c := newTestConversation(t, userID, t.TempDir())
+	c.LongConnMgr = &interaction.LongConnMgr{}
+	listener := &outboxListener{}
+	c.msgListener = func() open_im_sdk_callback.OnAdvancedMsgListener { return listener }
+	conversationID := c.getConversationIDBySessionType(peerID, constant.SingleChatType)
+	if err := c.db.InsertConversation(ctx, &model_struct.LocalConversation{ConversationID: conversationID, ConversationType: constant.SingleChatType, UserID: peerID}); err != nil {
+		t.Fatal(err)
+	}
+	return c, conversationID, listener
+}
+
+func TestOutboxQueueReturns(t *testing.T) {
+	ctx := context.Background()
+	const peerID = "peer"
+	c, conversationID, listener := newDisconnectedConversation(t, ctx, "outboxUser", peerID)
+	s := &sdk_struct.MsgStruct{ClientMsgID: "queued", ContentType: constant.Text, Status: constant.MsgStatusSending,
+		CreateTime: time.Now().UnixMilli(), TextElem: &sdk_struct.TextElem{Content: "queued"}}
+	message, err := c.SendMessage(ctx, s, peerID, "", nil, false)
+	if err != nil || message.ClientMsgID != "queued" || message.Status != constant.MsgStatusSending {
+		t.Fatalf("queued send %+v, %v", message, err)
+	}
+	if list, err := c.db.GetAllOutboxMessages(ctx); err != nil || len(list) != 1 || list[0].ConversationID != conversationID {
+		t.Fatalf("outbox %+v, %v", list, err)
+	}
+	if status := listener.last(); status != constant.OutboxWaitingNetwork {
+		t.Fatalf("queued status %d", status)
+	}
+
+	// the outcome is only reported to the listener
+	c.outbox.expire = time.Nanosecond
+	c.dispatchOutbox(ctx)
+	if status := listener.last(); status != constant.OutboxExpired {
+		t.Fatalf("expired status %d", status)
+	}
+	if m, err := c.db.GetMessage(ctx, conversationID, "queued"); err != nil || m.Status != constant.MsgStatusSendFailed {
+		t.Fatalf("expired message %+v, %v", m, err)
+	}
+}
+
+func TestOutboxQueueWait(t *testing.T) {
+	ctx := context.Background()
+	const peerID = "peer"
+	c, conversationID, listener := newDisconnectedConversation(t, ctx, "outboxUser", peerID)
+	c.outbox.wait = true
+
+	type result struct {
+		message *sdk_struct.MsgStruct
+		err     error
+	}
+	send := func(ctx context.Context, clientMsgID string) <-chan result {
+		ch := make(chan result, 1)
+		s := &sdk_struct.MsgStruct{ClientMsgID: clientMsgID, ContentType: constant.Text, Status: constant.MsgStatusSending,
+			CreateTime: time.Now().UnixMilli(), TextElem: &sdk_struct.TextElem{Content: clientMsgID}}
+		go func() {
+			message, err := c.SendMessage(ctx, s, peerID, "", nil, false)
+			ch <- result{message, err}
+		}()
+		return ch
+	}
+	waitQueued := func(n int) []*model_struct.LocalOutboxMessage {
+		for i := 0; i < 100; i++ {
+			list, err := c.db.GetAllOutboxMessages(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(list) == n {
+				return list
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("outbox does not hold %d messages", n)
+		return nil
+	}
+
+	first := send(ctx, "first")
+	waitQueued(1)
+	stopped, cancel := context.WithCancel(ctx)
+	second := send(stopped, "second")
+	list := waitQueued(2)
+	if list[0].ClientMsgID != "first" || list[1].ClientMsgID != "second" || list[0].ConversationID != conversationID {
+		t.Fatalf("outbox order %+v, %+v", list[0], list[1])
+	}
+	if status := listener.last(); status != constant.OutboxWaitingNetwork {
+		t.Fatalf("queued status %d", status)
+	}
+
+	// the caller gives up waiting, the message stays queued and is not marked failed
+	cancel()
+	if res := <-second; !sdkerrs.ErrNetwork.Is(res.err) {
+		t.Fatalf("cancelled send: %v", res.err)
+	}
+	if m, err := c.db.GetMessage(ctx, conversationID, "second"); err != nil || m.Status != constant.MsgStatusSending {
+		t.Fatalf("cancelled message %+v, %v", m, err)
+	}
+
+	// nothing is sent while disconnected
+	if wait := c.dispatchOutbox(ctx); wait <= 0 || wait > outboxIdleWait {
+		t.Fatalf("dispatch wait %s", wait)
+	}
+	waitQueued(2)
+
+	c.outbox.expire = time.Nanosecond
+	c.dispatchOutbox(ctx)
+	if res := <-first; !sdkerrs.ErrMsgExpired.Is(res.err) {
+		t.Fatalf("expired send: %v", res.err)
+	}
+	waitQueued(0)
+	if status := listener.last(); status != constant.OutboxExpired {
+		t.Fatalf("expired status %d", status)
+	}
+	for _, clientMsgID := range []string{"first", "second"} {
+		if m, err := c.db.GetMessage(ctx, conversationID, clientMsgID); err != nil || m.Status != constant.MsgStatusSendFailed {
+			t.Fatalf("expired message %s %+v, %v", clientMsgID, m, err)
+		}
+	}
+	if sending, err := c.db.GetAllSendingMessages(ctx); err != nil || len(sending) != 0 {
+		t.Fatalf("sending messages left %+v, %v", sending, err)
+	}
+}
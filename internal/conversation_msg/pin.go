@@ -0,0 +1,217 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/server_api_params"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+func (c *Conversation) doMsgPin(ctx context.Context, msg *sdkws.MsgData) error {
+	var tips server_api_params.MsgPinTips
+	if err := utils.UnmarshalNotificationElem(msg.Content, &tips); err != nil {
+		log.ZWarn(ctx, "unmarshal failed", err, "msg", msg)
+		return errs.Wrap(err)
+	}
+	log.ZDebug(ctx, "do msgPin", "tips", &tips)
+	if tips.ClientMsgID == "" {
+		message, err := c.db.GetMessageBySeq(ctx, tips.ConversationID, tips.Seq)
+		if err != nil {
+			log.ZError(ctx, "GetMessageBySeq failed", err, "tips", tips)
+			return errs.Wrap(err)
+		}
+		tips.ClientMsgID = message.ClientMsgID
+	}
+	return c.pinMessage(ctx, &tips)
+}
+
+func (c *Conversation) setMessagePin(ctx context.Context, conversationID, clientMsgID string, pinned bool) error {
+	if _, err := c.db.GetConversation(ctx, conversationID); err != nil {
+		return err
+	}
+	message, err := c.db.GetMessage(ctx, conversationID, clientMsgID)
+	if err != nil {
+		return err
+	}
+	if message.Status != constant.MsgStatusSendSuccess {
+		return sdkerrs.ErrArgs.WrapMsg("only send success message can be pinned")
+	}
+	if message.Seq == 0 {
+		return sdkerrs.ErrMsgHasNoSeq
+	}
+	resp, err := c.setMessagePinFromServer(ctx, conversationID, message.Seq, clientMsgID, pinned)
+	if err != nil {
+		return err
+	}
+	operateTime := resp.OperateTime
+	if operateTime == 0 {
+		operateTime = timeutil.GetCurrentTimestampByMill()
+	}
+	return c.pinMessage(ctx, &server_api_params.MsgPinTips{
+		ConversationID: conversationID,
+		ClientMsgID:    clientMsgID,
+		Seq:            message.Seq,
+		OperatorUserID: c.loginUserID,
+		IsPinned:       pinned,
+		OperateTime:    operateTime,
+	})
+}
+
+// pinMessage applies a pin change to the local state and reports it when the message changes
+// between pinned and unpinned. Changes that are not newer than the local state are ignored, so
+// the operator's own notification echo is harmless.
+func (c *Conversation) pinMessage(ctx context.Context, tips *server_api_params.MsgPinTips) error {
+	local, err := c.db.GetPinnedMessage(ctx, tips.ConversationID, tips.ClientMsgID)
+	if err != nil && errs.Unwrap(err) != errs.ErrRecordNotFound {
+		return err
+	}
+	if local != nil && local.OperateTime >= tips.OperateTime {
+		log.ZDebug(ctx, "message pin is not newer than local", "clientMsgID", tips.ClientMsgID,
+			"localOperateTime", local.OperateTime, "operateTime", tips.OperateTime)
+		return nil
+	}
+	if tips.IsPinned {
+		// a message revoked or deleted here has had its pin removed, a late pin must not restore it.
+		// A message that is not stored is only pinned when it has no pin state yet, it may not
+		// have been synced, while an unpinned row means it was destructed here.
+		messages, err := c.db.GetMessagesByClientMsgIDs(ctx, tips.ConversationID, []string{tips.ClientMsgID})
+		if err != nil {
+			return err
+		}
+		if (len(messages) > 0 && !pinnable(messages[0])) || (len(messages) == 0 && local != nil) {
+			log.ZDebug(ctx, "pinned message is revoked or deleted", "clientMsgID", tips.ClientMsgID)
+			return nil
+		}
+	}
+	if err := c.db.SetPinnedMessage(ctx, &model_struct.LocalPinnedMessage{
+		ConversationID: tips.ConversationID,
+		ClientMsgID:    tips.ClientMsgID,
+		Seq:            tips.Seq,
+		IsPinned:       tips.IsPinned,
+		OperatorUserID: tips.OperatorUserID,
+		OperateTime:    tips.OperateTime,
+	}); err != nil {
+		log.ZError(ctx, "SetPinnedMessage failed", err, "tips", tips)
+		return err
+	}
+	if wasPinned := local != nil && local.IsPinned; wasPinned != tips.IsPinned {
+		c.msgListener().OnMsgPinChanged(utils.StructToJsonString(&sdk_params_callback.MessagePinChange{
+			ConversationID: tips.ConversationID,
+			ClientMsgID:    tips.ClientMsgID,
+			IsPinned:       tips.IsPinned,
+			OperatorUserID: tips.OperatorUserID,
+			OperateTime:    tips.OperateTime,
+		}))
+	}
+	return nil
+}
+
+func (c *Conversation) getPinnedMessages(ctx context.Context, conversationID string) ([]*sdk_params_callback.PinnedMessage, error) {
+	pins, err := c.db.GetPinnedMessages(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*sdk_params_callback.PinnedMessage, 0, len(pins))
+	if len(pins) == 0 {
+		return res, nil
+	}
+	messages, err := c.db.GetMessagesByClientMsgIDs(ctx, conversationID, datautil.Slice(pins, func(p *model_struct.LocalPinnedMessage) string { return p.ClientMsgID }))
+	if err != nil {
+		return nil, err
+	}
+	messageMap := datautil.SliceToMap(messages, func(m *model_struct.LocalChatLog) string { return m.ClientMsgID })
+	for _, pin := range pins {
+		message, ok := messageMap[pin.ClientMsgID]
+		if !ok || !pinnable(message) {
+			continue
+		}
+		res = append(res, &sdk_params_callback.PinnedMessage{
+			Message:        LocalChatLogToMsgStruct(message),
+			OperatorUserID: pin.OperatorUserID,
+			PinTime:        pin.OperateTime,
+		})
+	}
+	return res, nil
+}
+
+// removeMessagePins unpins messages revoked or deleted by operatorUserID, reporting the pinned
+// ones as unpinned. The unpinned rows are kept, so a late pin of a message that is no longer
+// stored is not applied.
+func (c *Conversation) removeMessagePins(ctx context.Context, conversationID, operatorUserID string, clientMsgIDs []string) {
+	pins, err := c.db.GetPinnedMessages(ctx, conversationID)
+	if err != nil {
+		log.ZWarn(ctx, "GetPinnedMessages err", err, "conversationID", conversationID)
+		return
+	}
+	pinMap := datautil.SliceToMap(pins, func(p *model_struct.LocalPinnedMessage) string { return p.ClientMsgID })
+	operateTime := timeutil.GetCurrentTimestampByMill()
+	for _, clientMsgID := range datautil.Distinct(clientMsgIDs) {
+		unpinned := &model_struct.LocalPinnedMessage{ConversationID: conversationID, ClientMsgID: clientMsgID, OperatorUserID: operatorUserID, OperateTime: operateTime}
+		if pin, ok := pinMap[clientMsgID]; ok {
+			unpinned.Seq = pin.Seq
+		}
+		if err := c.db.SetPinnedMessage(ctx, unpinned); err != nil {
+			log.ZWarn(ctx, "SetPinnedMessage err", err, "conversationID", conversationID, "clientMsgID", clientMsgID)
+			return
+		}
+	}
+	c.notifyPinsRemoved(datautil.Filter(pins, func(p *model_struct.LocalPinnedMessage) (*model_struct.LocalPinnedMessage, bool) {
+		return p, datautil.Contain(p.ClientMsgID, clientMsgIDs...)
+	}), operatorUserID)
+}
+
+// removeConversationPins drops every pin of a conversation whose messages are cleared.
+func (c *Conversation) removeConversationPins(ctx context.Context, conversationID string) {
+	pins, err := c.db.GetPinnedMessages(ctx, conversationID)
+	if err != nil {
+		log.ZWarn(ctx, "GetPinnedMessages err", err, "conversationID", conversationID)
+		return
+	}
+	if err := c.db.DeleteConversationPinnedMessages(ctx, conversationID); err != nil {
+		log.ZWarn(ctx, "DeleteConversationPinnedMessages err", err, "conversationID", conversationID)
+		return
+	}
+	c.notifyPinsRemoved(pins, c.loginUserID)
+}
+
+func (c *Conversation) notifyPinsRemoved(pins []*model_struct.LocalPinnedMessage, operatorUserID string) {
+	operateTime := timeutil.GetCurrentTimestampByMill()
+	for _, pin := range pins {
+		c.msgListener().OnMsgPinChanged(utils.StructToJsonString(&sdk_params_callback.MessagePinChange{
+			ConversationID: pin.ConversationID,
+			ClientMsgID:    pin.ClientMsgID,
+			IsPinned:       false,
+			OperatorUserID: operatorUserID,
+			OperateTime:    operateTime,
+		}))
+	}
+}
+
+// pinnable reports whether a local message is still shown, revoked and deleted ones can't be pinned.
+func pinnable(message *model_struct.LocalChatLog) bool {
+	return message.Status != constant.MsgStatusHasDeleted && message.ContentType != constant.RevokeNotification
+}
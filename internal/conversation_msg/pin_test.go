@@ -0,0 +1,109 @@
+//go:build !js
+
+package conversation_msg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/server_api_params"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/tools/utils/timeutil"
+)
+
+type pinListener struct {
+	open_im_sdk_callback.OnAdvancedMsgListener
+	changes []*sdk_params_callback.MessagePinChange
+}
+
+func (l *pinListener) OnMsgPinChanged(pinChange string) {
+	var change sdk_params_callback.MessagePinChange
+	_ = utils.JsonStringToStruct(pinChange, &change)
+	l.changes = append(l.changes, &change)
+}
+
+func (l *pinListener) OnMsgDeleted(message string) {}
+
+func TestMessagePins(t *testing.T) {
+	ctx := context.Background()
+	// the messages are sent by the login user, deleting them leaves the unread count alone
+	c := newTestConversation(t, testUserID, t.TempDir())
+	listener := &pinListener{}
+	c.msgListener = func() open_im_sdk_callback.OnAdvancedMsgListener { return listener }
+	conversationID := "si_testUser_peer"
+	if err := c.db.InsertConversation(ctx, &model_struct.LocalConversation{ConversationID: conversationID, ConversationType: constant.SingleChatType, UserID: "peer"}); err != nil {
+		t.Fatal(err)
+	}
+	messages := []*model_struct.LocalChatLog{testTextMessage("m1", 1, "one"), testTextMessage("m2", 2, "two"), testTextMessage("m3", 3, "three")}
+	if err := c.db.BatchInsertMessageList(ctx, conversationID, messages); err != nil {
+		t.Fatal(err)
+	}
+	pin := func(clientMsgID string, pinned bool, operateTime int64) {
+		t.Helper()
+		if err := c.pinMessage(ctx, &server_api_params.MsgPinTips{ConversationID: conversationID, ClientMsgID: clientMsgID,
+			OperatorUserID: "peer", IsPinned: pinned, OperateTime: operateTime}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pinned := func() []string {
+		t.Helper()
+		list, err := c.GetPinnedMessages(ctx, conversationID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, p := range list {
+			ids = append(ids, p.Message.ClientMsgID)
+		}
+		return ids
+	}
+
+	pin("m1", true, 10)
+	pin("m1", true, 10) // notification echo
+	pin("m2", true, 11)
+	pin("m3", true, 12)
+	pin("m2", false, 13)
+	pin("m2", true, 9) // late pin, already unpinned
+	if ids := pinned(); len(ids) != 2 || ids[0] != "m3" || ids[1] != "m1" {
+		t.Fatalf("pinned %v", ids)
+	}
+
+	// deleting a pinned message removes its pin, and a late pin doesn't restore it
+	if err := c.deleteMessageFromLocal(ctx, conversationID, "m1"); err != nil {
+		t.Fatal(err)
+	}
+	pin("m1", true, 14)
+	if ids := pinned(); len(ids) != 1 || ids[0] != "m3" {
+		t.Fatalf("pinned after delete %v", ids)
+	}
+
+	// a destructed message is no longer stored, a late pin stamped after the local unpin is still
+	// not applied, while a pin of a message that is not synced yet is kept
+	if err := c.db.DeleteConversationMsgs(ctx, conversationID, []string{"m3"}); err != nil {
+		t.Fatal(err)
+	}
+	c.removeMessagePins(ctx, conversationID, testUserID, []string{"m3"})
+	pin("m3", true, timeutil.GetCurrentTimestampByMill()+time.Hour.Milliseconds())
+	pin("m4", true, 15)
+	if ids := pinned(); len(ids) != 0 {
+		t.Fatalf("pinned after destruct %v", ids)
+	}
+	if p, err := c.db.GetPinnedMessage(ctx, conversationID, "m4"); err != nil || !p.IsPinned {
+		t.Fatalf("pin of an unsynced message %+v %v", p, err)
+	}
+
+	want := []string{"m1 true", "m2 true", "m3 true", "m2 false", "m1 false", "m3 false", "m4 true"}
+	if len(listener.changes) != len(want) {
+		t.Fatalf("changes %s", utils.StructToJsonString(listener.changes))
+	}
+	for i, change := range listener.changes {
+		if got := change.ClientMsgID + " " + utils.StructToJsonString(change.IsPinned); got != want[i] {
+			t.Fatalf("change %d %s, want %s", i, got, want[i])
+		}
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/server_api_params"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"github.com/openimsdk/tools/utils/timeutil"
+
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+// maxReactionLocks bounds the messages whose reactions are changed at the same time.
+const maxReactionLocks = 64
+
+// userReaction is the reaction of one user as kept in LocalChatLogReactionExtensions. Removed
+// reactions are kept too, so a replayed or late notification is never applied twice.
+type userReaction struct {
+	Type        int    `json:"type"`
+	UserID      string `json:"userID"`
+	Info        string `json:"info,omitempty"`
+	Removed     bool   `json:"removed,omitempty"`
+	OperateTime int64  `json:"operateTime"`
+}
+
+func (c *Conversation) doMsgReaction(ctx context.Context, msg *sdkws.MsgData) error {
+	var tips server_api_params.MsgReactionTips
+	if err := utils.UnmarshalNotificationElem(msg.Content, &tips); err != nil {
+		log.ZWarn(ctx, "unmarshal failed", err, "msg", msg)
+		return errs.Wrap(err)
+	}
+	log.ZDebug(ctx, "do msgReaction", "tips", &tips)
+	if tips.ClientMsgID == "" {
+		message, err := c.db.GetMessageBySeq(ctx, tips.ConversationID, tips.Seq)
+		if err != nil {
+			log.ZError(ctx, "GetMessageBySeq failed", err, "tips", tips)
+			return errs.Wrap(err)
+		}
+		tips.ClientMsgID = message.ClientMsgID
+	}
+	_, err := c.reactMessage(ctx, &tips)
+	return err
+}
+
+func (c *Conversation) setMessageReaction(ctx context.Context, conversationID, clientMsgID string, reactionType int, info string, remove bool) (*sdk_params_callback.MessageReactions, error) {
+	if _, err := c.db.GetConversation(ctx, conversationID); err != nil {
+		return nil, err
+	}
+	message, err := c.db.GetMessage(ctx, conversationID, clientMsgID)
+	if err != nil {
+		return nil, err
+	}
+	if message.Status != constant.MsgStatusSendSuccess {
+		return nil, sdkerrs.ErrArgs.WrapMsg("only send success message can be reacted to")
+	}
+	if message.Seq == 0 {
+		return nil, sdkerrs.ErrMsgHasNoSeq
+	}
+	resp, err := c.setMessageReactionFromServer(ctx, conversationID, message.Seq, clientMsgID, reactionType, info, remove)
+	if err != nil {
+		return nil, err
+	}
+	operateTime := resp.OperateTime
+	if operateTime == 0 {
+		operateTime = timeutil.GetCurrentTimestampByMill()
+	}
+	return c.reactMessage(ctx, &server_api_params.MsgReactionTips{
+		ConversationID: conversationID,
+		ClientMsgID:    clientMsgID,
+		Seq:            message.Seq,
+		UserID:         c.loginUserID,
+		ReactionType:   reactionType,
+		Info:           info,
+		IsRemoved:      remove,
+		OperateTime:    operateTime,
+	})
+}
+
+// reactMessage applies a reaction change to the local copy and fires the extension callbacks
+// for its reaction type. Changes that are not newer than the local state of the user's reaction
+// are ignored, so the reacting user's own notification echo is harmless. The changes of a
+// message are applied one at a time, the local API and the notifications would otherwise
+// overwrite each other's reactions.
+func (c *Conversation) reactMessage(ctx context.Context, tips *server_api_params.MsgReactionTips) (*sdk_params_callback.MessageReactions, error) {
+	c.reactionLocks.Lock(tips.ClientMsgID)
+	defer c.reactionLocks.Unlock(tips.ClientMsgID)
+	reactions, err := c.getUserReactions(ctx, tips.ClientMsgID)
+	if err != nil {
+		return nil, err
+	}
+	before := reactionElem(reactions, tips.ReactionType)
+	i := indexUserReaction(reactions, tips.ReactionType, tips.UserID)
+	if i >= 0 && reactions[i].OperateTime >= tips.OperateTime {
+		log.ZDebug(ctx, "message reaction is not newer than local", "clientMsgID", tips.ClientMsgID,
+			"localOperateTime", reactions[i].OperateTime, "operateTime", tips.OperateTime)
+		return messageReactions(tips.ClientMsgID, reactions), nil
+	}
+	reaction := &userReaction{Type: tips.ReactionType, UserID: tips.UserID, Info: tips.Info, Removed: tips.IsRemoved, OperateTime: tips.OperateTime}
+	if i >= 0 {
+		reactions[i] = reaction
+	} else {
+		reactions = append(reactions, reaction)
+	}
+	if err := c.db.SetMessageReactionExtension(ctx, &model_struct.LocalChatLogReactionExtensions{
+		ClientMsgID:             tips.ClientMsgID,
+		LocalReactionExtensions: []byte(utils.StructToJsonString(reactions)),
+	}); err != nil {
+		log.ZError(ctx, "SetMessageReactionExtension failed", err, "tips", tips)
+		return nil, err
+	}
+	after := reactionElem(reactions, tips.ReactionType)
+	switch {
+	case before == nil && after != nil:
+		c.msgListener().OnRecvMessageExtensionsAdded(tips.ClientMsgID, utils.StructToJsonString([]*sdk_struct.ReactionElem{after}))
+	case before != nil && after == nil:
+		c.msgListener().OnRecvMessageExtensionsDeleted(tips.ClientMsgID, utils.StructToJsonString([]string{strconv.Itoa(tips.ReactionType)}))
+	case after != nil:
+		c.msgListener().OnRecvMessageExtensionsChanged(tips.ClientMsgID, utils.StructToJsonString([]*sdk_struct.ReactionElem{after}))
+	}
+	return messageReactions(tips.ClientMsgID, reactions), nil
+}
+
+func (c *Conversation) getMessageReactions(ctx context.Context, conversationID string, clientMsgIDs []string) ([]*sdk_params_callback.MessageReactions, error) {
+	if len(clientMsgIDs) == 0 {
+		return nil, sdkerrs.ErrArgs.WrapMsg("clientMsgIDs is empty")
+	}
+	messages, err := c.db.GetMessagesByClientMsgIDs(ctx, conversationID, clientMsgIDs)
+	if err != nil {
+		return nil, err
+	}
+	found := datautil.SliceSetAny(messages, func(m *model_struct.LocalChatLog) string { return m.ClientMsgID })
+	extensions, err := c.db.GetMessageReactionExtensions(ctx, clientMsgIDs)
+	if err != nil {
+		return nil, err
+	}
+	extensionMap := datautil.SliceToMap(extensions, func(e *model_struct.LocalChatLogReactionExtensions) string { return e.ClientMsgID })
+	res := make([]*sdk_params_callback.MessageReactions, 0, len(messages))
+	for _, clientMsgID := range datautil.Distinct(clientMsgIDs) {
+		if _, ok := found[clientMsgID]; !ok {
+			continue
+		}
+		var reactions []*userReaction
+		if e, ok := extensionMap[clientMsgID]; ok {
+			if err := utils.JsonStringToStruct(string(e.LocalReactionExtensions), &reactions); err != nil {
+				log.ZWarn(ctx, "message reactions are broken", err, "clientMsgID", clientMsgID)
+			}
+		}
+		res = append(res, messageReactions(clientMsgID, reactions))
+	}
+	return res, nil
+}
+
+// getUserReactions returns the reactions kept for a message. A row that does not decode, such as
+// one written in an older format, is an error rather than empty: the change being applied would
+// otherwise overwrite the reactions of every other user.
+func (c *Conversation) getUserReactions(ctx context.Context, clientMsgID string) ([]*userReaction, error) {
+	extensions, err := c.db.GetMessageReactionExtensions(ctx, []string{clientMsgID})
+	if err != nil {
+		return nil, err
+	}
+	var reactions []*userReaction
+	if len(extensions) > 0 {
+		if err := utils.JsonStringToStruct(string(extensions[0].LocalReactionExtensions), &reactions); err != nil {
+			log.ZError(ctx, "message reactions are broken", err, "clientMsgID", clientMsgID)
+			return nil, err
+		}
+	}
+	return reactions, nil
+}
+
+func indexUserReaction(reactions []*userReaction, reactionType int, userID string) int {
+	for i, r := range reactions {
+		if r.Type == reactionType && r.UserID == userID {
+			return i
+		}
+	}
+	return -1
+}
+
+// reactionElem returns the users reacting with reactionType, nil when there are none.
+func reactionElem(reactions []*userReaction, reactionType int) *sdk_struct.ReactionElem {
+	var elem *sdk_struct.ReactionElem
+	for _, r := range reactions {
+		if r.Type != reactionType || r.Removed {
+			continue
+		}
+		if elem == nil {
+			elem = &sdk_struct.ReactionElem{Type: reactionType}
+		}
+		elem.Counter++
+		elem.UserReactionList = append(elem.UserReactionList, &sdk_struct.UserReactionElem{UserID: r.UserID, Counter: 1, Info: r.Info})
+	}
+	return elem
+}
+
+func messageReactions(clientMsgID string, reactions []*userReaction) *sdk_params_callback.MessageReactions {
+	types := make([]int, 0)
+	for _, r := range reactions {
+		if !r.Removed && !datautil.Contain(r.Type, types...) {
+			types = append(types, r.Type)
+		}
+	}
+	sort.Ints(types)
+	res := &sdk_params_callback.MessageReactions{ClientMsgID: clientMsgID, ReactionList: make([]*sdk_struct.ReactionElem, 0, len(types))}
+	for _, t := range types {
+		res.ReactionList = append(res.ReactionList, reactionElem(reactions, t))
+	}
+	return res
+}
@@ -0,0 +1,146 @@
+//go:build !js
+
+package conversation_msg
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/server_api_params"
+)
+
+type reactionListener struct {
+	open_im_sdk_callback.OnAdvancedMsgListener
+	events []string
+}
+
+func (l *reactionListener) OnRecvMessageExtensionsAdded(msgID string, reactionExtensionList string) {
+	l.events = append(l.events, "added "+reactionExtensionList)
+}
+
+func (l *reactionListener) OnRecvMessageExtensionsChanged(msgID string, reactionExtensionList string) {
+	l.events = append(l.events, "changed "+reactionExtensionList)
+}
+
+func (l *reactionListener) OnRecvMessageExtensionsDeleted(msgID string, reactionExtensionKeyList string) {
+	l.events = append(l.events, "deleted "+reactionExtensionKeyList)
+}
+
+func TestMessageReactions(t *testing.T) {
+	ctx := context.Background()
+	c := newTestConversation(t, "reactionUser", t.TempDir())
+	listener := &reactionListener{}
+	c.msgListener = func() open_im_sdk_callback.OnAdvancedMsgListener { return listener }
+	conversationID := "si_peer_reactionUser"
+	if err := c.db.BatchInsertMessageList(ctx, conversationID, []*model_struct.LocalChatLog{testTextMessage("m1", 1, "one")}); err != nil {
+		t.Fatal(err)
+	}
+	react := func(userID string, reactionType int, removed bool, operateTime int64) {
+		t.Helper()
+		if _, err := c.reactMessage(ctx, &server_api_params.MsgReactionTips{ConversationID: conversationID, ClientMsgID: "m1", Seq: 1,
+			UserID: userID, ReactionType: reactionType, IsRemoved: removed, OperateTime: operateTime}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	react("a", 1, false, 10)
+	react("a", 1, false, 10) // notification echo
+	react("b", 1, false, 11)
+	react("b", 2, false, 12)
+	react("a", 1, true, 13)
+	react("a", 1, false, 9) // late add, already removed
+	react("b", 1, true, 14)
+	want := []string{
+		`added [{"counter":1,"type":1,"userReactionList":[{"userID":"a","counter":1}]}]`,
+		`changed [{"counter":2,"type":1,"userReactionList":[{"userID":"a","counter":1},{"userID":"b","counter":1}]}]`,
+		`added [{"counter":1,"type":2,"userReactionList":[{"userID":"b","counter":1}]}]`,
+		`changed [{"counter":1,"type":1,"userReactionList":[{"userID":"b","counter":1}]}]`,
+		`deleted ["1"]`,
+	}
+	if len(listener.events) != len(want) {
+		t.Fatalf("events %q", listener.events)
+	}
+	for i := range want {
+		if listener.events[i] != want[i] {
+			t.Fatalf("event %d %s, want %s", i, listener.events[i], want[i])
+		}
+	}
+
+	list, err := c.GetMessageReactions(ctx, conversationID, []string{"m1", "missing", "m1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].ClientMsgID != "m1" || len(list[0].ReactionList) != 1 || list[0].ReactionList[0].Type != 2 {
+		t.Fatalf("reactions %+v", list)
+	}
+}
+
+func TestMessageReactionsConcurrent(t *testing.T) {
+	ctx := context.Background()
+	c := newTestConversation(t, "reactionUser", t.TempDir())
+	c.msgListener = func() open_im_sdk_callback.OnAdvancedMsgListener { return &reactionListener{} }
+	conversationID := "si_peer_reactionUser"
+	if err := c.db.BatchInsertMessageList(ctx, conversationID, []*model_struct.LocalChatLog{testTextMessage("m1", 1, "one")}); err != nil {
+		t.Fatal(err)
+	}
+	const users = 20
+	var wg sync.WaitGroup
+	errCh := make(chan error, users)
+	for i := 0; i < users; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := c.reactMessage(ctx, &server_api_params.MsgReactionTips{ConversationID: conversationID, ClientMsgID: "m1", Seq: 1,
+				UserID: strconv.Itoa(i), ReactionType: 1, OperateTime: 10})
+			errCh <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	list, err := c.GetMessageReactions(ctx, conversationID, []string{"m1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || len(list[0].ReactionList) != 1 || list[0].ReactionList[0].Counter != users {
+		t.Fatalf("reactions after concurrent changes %+v", list)
+	}
+}
+
+func TestMessageReactionsBrokenRow(t *testing.T) {
+	ctx := context.Background()
+	c := newTestConversation(t, "reactionUser", t.TempDir())
+	listener := &reactionListener{}
+	c.msgListener = func() open_im_sdk_callback.OnAdvancedMsgListener { return listener }
+	conversationID := "si_peer_reactionUser"
+	if err := c.db.BatchInsertMessageList(ctx, conversationID, []*model_struct.LocalChatLog{testTextMessage("m1", 1, "one")}); err != nil {
+		t.Fatal(err)
+	}
+	// An object keyed by reaction type, not the list of user reactions.
+	legacy := []byte(`{"1":{"type":1,"counter":2,"userReactionList":[{"userID":"a","counter":1},{"userID":"b","counter":1}]}}`)
+	if err := c.db.SetMessageReactionExtension(ctx, &model_struct.LocalChatLogReactionExtensions{ClientMsgID: "m1", LocalReactionExtensions: legacy}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.reactMessage(ctx, &server_api_params.MsgReactionTips{ConversationID: conversationID, ClientMsgID: "m1", Seq: 1,
+		UserID: "c", ReactionType: 1, OperateTime: 10}); err == nil {
+		t.Fatal("reaction applied on top of a broken row")
+	}
+	if len(listener.events) != 0 {
+		t.Fatalf("events %q", listener.events)
+	}
+	extensions, err := c.db.GetMessageReactionExtensions(ctx, []string{"m1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(extensions) != 1 || string(extensions[0].LocalReactionExtensions) != string(legacy) {
+		t.Fatalf("broken row was overwritten: %+v", extensions)
+	}
+}
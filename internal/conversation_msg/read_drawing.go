@@ -22,6 +22,8 @@ import (
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/common"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
 	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
 	"github.com/openimsdk/tools/errs"
@@ -90,9 +92,28 @@ func (c *Conversation) markConversationMessageAsRead(ctx context.Context, conver
 		}
 	case constant.ReadGroupChatType, constant.NotificationChatType:
 		log.ZDebug(ctx, "markConversationMessageAsRead", "conversationID", conversationID, "peerUserMaxSeq", peerUserMaxSeq, "maxSeq", maxSeq)
-		if err := c.markConversationAsReadServer(ctx, conversationID, maxSeq, nil); err != nil {
+		var (
+			msgIDs []string
+			seqs   []int64
+		)
+		if conversation.ConversationType == constant.ReadGroupChatType {
+			msgs, err := c.db.GetUnreadMessage(ctx, conversationID)
+			if err != nil {
+				return err
+			}
+			// only the messages requesting receipts are reported one by one, so that their senders are told
+			msgIDs, seqs = c.getAsReadMsgMapAndList(ctx, datautil.Filter(msgs, func(msg *model_struct.LocalChatLog) (*model_struct.LocalChatLog, bool) {
+				return msg, needGroupReadReceipt(msg)
+			}))
+		}
+		if err := c.markConversationAsReadServer(ctx, conversationID, maxSeq, seqs); err != nil {
 			return err
 		}
+		if len(msgIDs) > 0 {
+			if _, err := c.db.MarkConversationMessageAsReadDB(ctx, conversationID, msgIDs); err != nil {
+				log.ZWarn(ctx, "MarkConversationMessageAsRead err", err, "conversationID", conversationID, "msgIDs", msgIDs)
+			}
+		}
 	}
 
 	if err := c.db.UpdateColumnsConversation(ctx, conversationID, map[string]interface{}{"unread_count": 0}); err != nil {
@@ -100,6 +121,7 @@ func (c *Conversation) markConversationMessageAsRead(ctx context.Context, conver
 	}
 	log.ZDebug(ctx, "update columns sucess")
 	c.unreadChangeTrigger(ctx, conversationID, peerUserMaxSeq == maxSeq)
+	c.checkDestruct(conversationID)
 	return nil
 }
 
@@ -139,6 +161,7 @@ func (c *Conversation) markMessagesAsReadByMsgID(ctx context.Context, conversati
 			"decrCount", decrCount)
 	}
 	c.unreadChangeTrigger(ctx, conversationID, hasReadSeq == maxSeq && msgs[0].SendID != c.loginUserID)
+	c.checkDestruct(conversationID)
 	return nil
 }
 
@@ -185,6 +208,7 @@ func (c *Conversation) doUnreadCount(ctx context.Context, conversation *model_st
 				if err != nil {
 					return err
 				}
+				c.checkDestruct(conversation.ConversationID)
 			}
 
 		} else {
@@ -240,6 +264,10 @@ func (c *Conversation) doReadDrawing(ctx context.Context, msg *sdkws.MsgData) er
 	}
 	if tips.MarkAsReadUserID != c.loginUserID {
 		if len(tips.Seqs) == 0 {
+			if conversation.ConversationType != constant.SingleChatType {
+				// a member read the group without any message requesting receipts
+				return nil
+			}
 			return errs.New("tips Seqs is empty").Wrap()
 		}
 		messages, err := c.db.GetMessagesBySeqs(ctx, tips.ConversationID, tips.Seqs)
@@ -248,7 +276,8 @@ func (c *Conversation) doReadDrawing(ctx context.Context, msg *sdkws.MsgData) er
 			return err
 
 		}
-		if conversation.ConversationType == constant.SingleChatType {
+		switch conversation.ConversationType {
+		case constant.SingleChatType:
 			latestMsg := &sdk_struct.MsgStruct{}
 			if err := json.Unmarshal([]byte(conversation.LatestMsg), latestMsg); err != nil {
 				log.ZWarn(ctx, "Unmarshal err", err, "conversationID", tips.ConversationID, "latestMsg", conversation.LatestMsg)
@@ -277,9 +306,109 @@ func (c *Conversation) doReadDrawing(ctx context.Context, msg *sdkws.MsgData) er
 			var messageReceiptResp = []*sdk_struct.MessageReceipt{{UserID: tips.MarkAsReadUserID, MsgIDList: successMsgIDs,
 				SessionType: conversation.ConversationType, ReadTime: msg.SendTime}}
 			c.msgListener().OnRecvC2CReadReceipt(utils.StructToJsonString(messageReceiptResp))
+			c.checkDestruct(conversation.ConversationID)
+		case constant.WriteGroupChatType, constant.ReadGroupChatType:
+			return c.doGroupReadReceipt(ctx, conversation, tips.MarkAsReadUserID, msg.SendTime, messages)
 		}
 	} else {
 		return c.doUnreadCount(ctx, conversation, tips.HasReadSeq, tips.Seqs)
 	}
 	return nil
 }
+
+// doGroupReadReceipt records userID as a reader of the messages requesting receipts,
+// and reports the messages it had not read before.
+func (c *Conversation) doGroupReadReceipt(ctx context.Context, conversation *model_struct.LocalConversation, userID string,
+	readTime int64, messages []*model_struct.LocalChatLog) error {
+	latestMsg := &sdk_struct.MsgStruct{}
+	_ = utils.JsonStringToStruct(conversation.LatestMsg, latestMsg)
+	var successMsgIDs []string
+	for _, message := range messages {
+		if !needGroupReadReceipt(message) || message.SendID == userID {
+			continue
+		}
+		attachInfo := sdk_struct.AttachedInfoElem{}
+		_ = utils.JsonStringToStruct(message.AttachedInfo, &attachInfo)
+		if datautil.Contain(userID, attachInfo.GroupHasReadInfo.HasReadUserIDList...) {
+			continue
+		}
+		attachInfo.GroupHasReadInfo.HasReadUserIDList = append(attachInfo.GroupHasReadInfo.HasReadUserIDList, userID)
+		attachInfo.GroupHasReadInfo.HasReadCount = int32(len(attachInfo.GroupHasReadInfo.HasReadUserIDList))
+		message.AttachedInfo = utils.StructToJsonString(attachInfo)
+		if err := c.db.UpdateColumnsMessage(ctx, conversation.ConversationID, message.ClientMsgID,
+			map[string]interface{}{"attached_info": message.AttachedInfo}); err != nil {
+			log.ZWarn(ctx, "UpdateColumnsMessage err", err, "conversationID", conversation.ConversationID, "clientMsgID", message.ClientMsgID)
+			return err
+		}
+		if latestMsg.ClientMsgID == message.ClientMsgID {
+			latestMsg.AttachedInfoElem = &attachInfo
+			conversation.LatestMsg = utils.StructToJsonString(latestMsg)
+			_ = common.TriggerCmdUpdateConversation(ctx, common.UpdateConNode{ConID: conversation.ConversationID, Action: constant.AddConOrUpLatMsg, Args: *conversation}, c.GetCh())
+		}
+		successMsgIDs = append(successMsgIDs, message.ClientMsgID)
+	}
+	if len(successMsgIDs) == 0 {
+		return nil
+	}
+	var messageReceiptResp = []*sdk_struct.MessageReceipt{{GroupID: conversation.GroupID, UserID: userID, MsgIDList: successMsgIDs,
+		SessionType: conversation.ConversationType, ReadTime: readTime}}
+	c.msgListener().OnRecvGroupReadReceipt(utils.StructToJsonString(messageReceiptResp))
+	return nil
+}
+
+func needGroupReadReceipt(message *model_struct.LocalChatLog) bool {
+	attachInfo := sdk_struct.AttachedInfoElem{}
+	_ = utils.JsonStringToStruct(message.AttachedInfo, &attachInfo)
+	return attachInfo.GroupHasReadInfo.NeedReadReceipt
+}
+
+func (c *Conversation) getGroupMessageReaderList(ctx context.Context, conversationID, clientMsgID string, filter, offset, count int32) (*sdk_params_callback.GroupMessageReaderList, error) {
+	conversation, err := c.db.GetConversation(ctx, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if conversation.ConversationType != constant.WriteGroupChatType && conversation.ConversationType != constant.ReadGroupChatType {
+		return nil, sdkerrs.ErrArgs.WrapMsg("not a group conversation")
+	}
+	message, err := c.db.GetMessage(ctx, conversationID, clientMsgID)
+	if err != nil {
+		return nil, err
+	}
+	attachInfo := sdk_struct.AttachedInfoElem{}
+	_ = utils.JsonStringToStruct(message.AttachedInfo, &attachInfo)
+	if !attachInfo.GroupHasReadInfo.NeedReadReceipt {
+		return nil, sdkerrs.ErrArgs.WrapMsg("message does not request read receipts")
+	}
+	members, err := c.db.GetGroupMemberListByGroupID(ctx, conversation.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	var list []*model_struct.LocalGroupMember
+	switch filter {
+	case constant.GroupMessageReaderFilterRead:
+		// readers are listed in the order they read the message
+		memberMap := datautil.SliceToMap(members, func(member *model_struct.LocalGroupMember) string { return member.UserID })
+		for _, userID := range attachInfo.GroupHasReadInfo.HasReadUserIDList {
+			if member, ok := memberMap[userID]; ok {
+				list = append(list, member)
+			}
+		}
+	case constant.GroupMessageReaderFilterUnread:
+		for _, member := range members {
+			if member.UserID != message.SendID && !datautil.Contain(member.UserID, attachInfo.GroupHasReadInfo.HasReadUserIDList...) {
+				list = append(list, member)
+			}
+		}
+	default:
+		return nil, sdkerrs.ErrArgs.WrapMsg("unknown filter")
+	}
+	resp := &sdk_params_callback.GroupMessageReaderList{TotalCount: len(list), GroupMemberList: []*model_struct.LocalGroupMember{}}
+	if offset >= 0 && int(offset) < len(list) {
+		list = list[offset:]
+		if count > 0 && int(count) < len(list) {
+			list = list[:count]
+		}
+		resp.GroupMemberList = list
+	}
+	return resp, nil
+}
@@ -0,0 +1,96 @@
+//go:build !js
+
+package conversation_msg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+type groupReceiptListener struct {
+	open_im_sdk_callback.OnAdvancedMsgListener
+	receipts []string
+}
+
+func (l *groupReceiptListener) OnRecvGroupReadReceipt(groupMsgReceiptList string) {
+	l.receipts = append(l.receipts, groupMsgReceiptList)
+}
+
+func TestGroupReadReceipt(t *testing.T) {
+	ctx := context.Background()
+	c := newTestConversation(t, testUserID, t.TempDir())
+	listener := &groupReceiptListener{}
+	c.msgListener = func() open_im_sdk_callback.OnAdvancedMsgListener { return listener }
+	conversationID, groupID := "sg_g1", "g1"
+	if err := c.db.InsertConversation(ctx, &model_struct.LocalConversation{ConversationID: conversationID, ConversationType: constant.ReadGroupChatType, GroupID: groupID}); err != nil {
+		t.Fatal(err)
+	}
+	for _, userID := range []string{testUserID, "a", "b", "c"} {
+		if err := c.db.InsertGroupMember(ctx, &model_struct.LocalGroupMember{GroupID: groupID, UserID: userID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m1, m2 := testTextMessage("m1", 1, "one"), testTextMessage("m2", 2, "two")
+	m1.AttachedInfo = utils.StructToJsonString(sdk_struct.AttachedInfoElem{GroupHasReadInfo: sdk_struct.GroupHasReadInfo{NeedReadReceipt: true, GroupMemberCount: 4}})
+	if err := c.db.BatchInsertMessageList(ctx, conversationID, []*model_struct.LocalChatLog{m1, m2}); err != nil {
+		t.Fatal(err)
+	}
+	read := func(userID string, seqs ...int64) {
+		t.Helper()
+		tips := &sdkws.MarkAsReadTips{MarkAsReadUserID: userID, ConversationID: conversationID, Seqs: seqs, HasReadSeq: 2}
+		content := utils.StructToJsonString(sdk_struct.NotificationElem{Detail: utils.StructToJsonString(tips)})
+		if err := c.doReadDrawing(ctx, &sdkws.MsgData{Content: []byte(content), SendTime: 100}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	read("b", 1, 2)
+	read("b", 1) // notification echo
+	read("a", 2) // m2 does not request receipts
+	read("a")
+	read("a", 1)
+	want := []string{
+		`[{"groupID":"g1","userID":"b","msgIDList":["m1"],"readTime":100,"msgFrom":0,"contentType":0,"sessionType":3}]`,
+		`[{"groupID":"g1","userID":"a","msgIDList":["m1"],"readTime":100,"msgFrom":0,"contentType":0,"sessionType":3}]`,
+	}
+	if len(listener.receipts) != len(want) {
+		t.Fatalf("receipts %v", listener.receipts)
+	}
+	for i := range want {
+		if listener.receipts[i] != want[i] {
+			t.Fatalf("receipt %d: %s, want %s", i, listener.receipts[i], want[i])
+		}
+	}
+
+	readers, err := c.getGroupMessageReaderList(ctx, conversationID, "m1", constant.GroupMessageReaderFilterRead, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if readers.TotalCount != 2 || len(readers.GroupMemberList) != 2 || readers.GroupMemberList[0].UserID != "b" || readers.GroupMemberList[1].UserID != "a" {
+		t.Fatalf("readers %s", utils.StructToJsonString(readers))
+	}
+	unread, err := c.getGroupMessageReaderList(ctx, conversationID, "m1", constant.GroupMessageReaderFilterUnread, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unread.TotalCount != 1 || len(unread.GroupMemberList) != 1 || unread.GroupMemberList[0].UserID != "c" {
+		t.Fatalf("unread %s", utils.StructToJsonString(unread))
+	}
+	page, err := c.getGroupMessageReaderList(ctx, conversationID, "m1", constant.GroupMessageReaderFilterRead, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.TotalCount != 2 || len(page.GroupMemberList) != 1 || page.GroupMemberList[0].UserID != "a" {
+		t.Fatalf("page %s", utils.StructToJsonString(page))
+	}
+	if _, err := c.getGroupMessageReaderList(ctx, conversationID, "m2", constant.GroupMessageReaderFilterRead, 0, 10); err == nil {
+		t.Fatal("reader list of a message without receipts")
+	}
+}
@@ -124,6 +124,8 @@ func (c *Conversation) revokeMessage(ctx context.Context, tips *sdkws.RevokeMsgT
 		}
 
 	}
+	c.removeMessagePins(ctx, tips.ConversationID, tips.RevokerUserID, []string{revokedMsg.ClientMsgID})
+	c.removeMessageMentions(ctx, tips.ConversationID, []string{revokedMsg.ClientMsgID})
 	c.msgListener().OnNewRecvMessageRevoked(utils.StructToJsonString(m))
 	msgList, err := c.db.SearchAllMessageByContentType(ctx, conversation.ConversationID, constant.Quote)
 	if err != nil {
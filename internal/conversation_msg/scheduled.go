@@ -0,0 +1,234 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/ccontext"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/protocol/sdkws"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+const (
+	// scheduledMessageMaxAttempts bounds the sends of a due message that fail on the network.
+	scheduledMessageMaxAttempts = 3
+	// scheduledMessageRetryWait is how often due messages are retried while the connection is down.
+	scheduledMessageRetryWait = 5 * time.Second
+	// scheduledMessageIdleWait caps the sleep of the dispatcher so clock changes are noticed.
+	scheduledMessageIdleWait = time.Minute
+)
+
+// ScheduleMessage queues a created message to be sent to recvID or groupID at sendAt, a unix
+// timestamp in milliseconds. The queue is kept in the local database and survives restarts.
+func (c *Conversation) ScheduleMessage(ctx context.Context, s *sdk_struct.MsgStruct, recvID, groupID string, sendAt int64, offlinePushInfo *sdkws.OfflinePushInfo) (*sdk_struct.MsgStruct, error) {
+	if s == nil || s.ClientMsgID == "" {
+		return nil, sdkerrs.ErrArgs.WrapMsg("message is not created")
+	}
+	if recvID == "" && groupID == "" {
+		return nil, sdkerrs.ErrArgs.WrapMsg("recvID and groupID are both empty")
+	}
+	if sendAt <= time.Now().UnixMilli() {
+		return nil, sdkerrs.ErrArgs.WrapMsg("sendAt is not in the future")
+	}
+	if _, err := c.db.GetScheduledMessage(ctx, s.ClientMsgID); err == nil {
+		return nil, sdkerrs.ErrMsgRepeated
+	} else if errs.Unwrap(err) != errs.ErrRecordNotFound {
+		return nil, err
+	}
+	message := &model_struct.LocalScheduledMessage{
+		ClientMsgID: s.ClientMsgID,
+		RecvID:      recvID,
+		GroupID:     groupID,
+		SendAt:      sendAt,
+		Message:     utils.StructToJsonString(s),
+		CreateTime:  time.Now().UnixMilli(),
+	}
+	if offlinePushInfo != nil {
+		message.OfflinePushInfo = utils.StructToJsonString(offlinePushInfo)
+	}
+	if err := c.db.InsertScheduledMessage(ctx, message); err != nil {
+		return nil, err
+	}
+	c.wakeScheduledMessages()
+	return s, nil
+}
+
+// CancelScheduledMessage removes a message from the queue before it is sent.
+func (c *Conversation) CancelScheduledMessage(ctx context.Context, clientMsgID string) error {
+	if _, err := c.db.GetScheduledMessage(ctx, clientMsgID); err != nil {
+		if errs.Unwrap(err) == errs.ErrRecordNotFound {
+			return sdkerrs.ErrArgs.WrapMsg("scheduled message not found " + clientMsgID)
+		}
+		return err
+	}
+	if err := c.db.DeleteScheduledMessage(ctx, clientMsgID); err != nil {
+		return err
+	}
+	c.wakeScheduledMessages()
+	return nil
+}
+
+// GetScheduledMessages returns the queued messages, the earliest first.
+func (c *Conversation) GetScheduledMessages(ctx context.Context) ([]*sdk_params_callback.ScheduledMessage, error) {
+	list, err := c.db.GetAllScheduledMessages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*sdk_params_callback.ScheduledMessage, 0, len(list))
+	for _, v := range list {
+		var s sdk_struct.MsgStruct
+		if err := utils.JsonStringToStruct(v.Message, &s); err != nil {
+			log.ZWarn(ctx, "scheduled message is broken", err, "clientMsgID", v.ClientMsgID)
+			continue
+		}
+		res = append(res, &sdk_params_callback.ScheduledMessage{
+			Message:    &s,
+			RecvID:     v.RecvID,
+			GroupID:    v.GroupID,
+			SendAt:     v.SendAt,
+			CreateTime: v.CreateTime,
+		})
+	}
+	return res, nil
+}
+
+func (c *Conversation) wakeScheduledMessages() {
+	select {
+	case c.scheduledWake <- struct{}{}:
+	default:
+	}
+}
+
+// RunScheduledMessages sends queued messages as they fall due until ctx is done. Due messages
+// wait for the long connection, so the ones due while offline or before a restart are sent
+// once connected.
+func (c *Conversation) RunScheduledMessages(ctx context.Context) {
+	for {
+		timer := time.NewTimer(c.dispatchScheduledMessages(ctx))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-c.scheduledWake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// dispatchScheduledMessages sends the due messages and returns how long to wait for the next one.
+func (c *Conversation) dispatchScheduledMessages(ctx context.Context) time.Duration {
+	due, err := c.db.GetDueScheduledMessages(ctx, time.Now().UnixMilli())
+	if err != nil {
+		log.ZWarn(ctx, "get due scheduled messages failed", err)
+		return scheduledMessageRetryWait
+	}
+	if len(due) > 0 {
+		if !c.IsConnected() {
+			return scheduledMessageRetryWait
+		}
+		for _, message := range due {
+			if ctx.Err() != nil {
+				return 0
+			}
+			c.sendScheduledMessage(ctx, message)
+		}
+	}
+	return c.nextScheduledWait(ctx)
+}
+
+func (c *Conversation) nextScheduledWait(ctx context.Context) time.Duration {
+	list, err := c.db.GetAllScheduledMessages(ctx)
+	if err != nil {
+		log.ZWarn(ctx, "get scheduled messages failed", err)
+		return scheduledMessageRetryWait
+	}
+	if len(list) == 0 {
+		return scheduledMessageIdleWait
+	}
+	wait := time.Until(time.UnixMilli(list[0].SendAt))
+	if wait < scheduledMessageRetryWait && list[0].Attempts > 0 {
+		wait = scheduledMessageRetryWait
+	}
+	if wait > scheduledMessageIdleWait {
+		wait = scheduledMessageIdleWait
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	return wait
+}
+
+// sendScheduledMessage sends a due message through SendMessage. A network failure keeps it
+// queued for the next connection until it runs out of attempts, any other outcome removes it
+// and is reported to the message listener.
+func (c *Conversation) sendScheduledMessage(ctx context.Context, message *model_struct.LocalScheduledMessage) {
+	ctx = ccontext.WithOperationID(ctx, utils.OperationIDGenerator())
+	ctx = context.WithValue(ctx, "callback", emptySendMsgCallback{})
+	var s sdk_struct.MsgStruct
+	err := utils.JsonStringToStruct(message.Message, &s)
+	if err == nil {
+		var offlinePushInfo *sdkws.OfflinePushInfo
+		if message.OfflinePushInfo != "" {
+			offlinePushInfo = &sdkws.OfflinePushInfo{}
+			_ = utils.JsonStringToStruct(message.OfflinePushInfo, offlinePushInfo)
+		}
+		log.ZInfo(ctx, "send scheduled message", "clientMsgID", message.ClientMsgID, "sendAt", message.SendAt, "attempts", message.Attempts)
+		var sent *sdk_struct.MsgStruct
+		sent, err = c.SendMessage(ctx, &s, message.RecvID, message.GroupID, offlinePushInfo, false)
+		if sent != nil {
+			s = *sent
+		}
+	}
+	if err != nil && (sdkerrs.ErrNetwork.Is(err) || sdkerrs.ErrNetworkTimeOut.Is(err)) && message.Attempts+1 < scheduledMessageMaxAttempts {
+		message.Attempts++
+		if updateErr := c.db.UpdateScheduledMessage(ctx, message); updateErr == nil {
+			log.ZWarn(ctx, "scheduled message send failed, retry", err, "clientMsgID", message.ClientMsgID, "attempts", message.Attempts)
+			return
+		}
+	}
+	if deleteErr := c.db.DeleteScheduledMessage(ctx, message.ClientMsgID); deleteErr != nil {
+		log.ZError(ctx, "delete scheduled message failed", deleteErr, "clientMsgID", message.ClientMsgID)
+	}
+	if err != nil {
+		log.ZError(ctx, "scheduled message send failed", err, "clientMsgID", message.ClientMsgID)
+		code, msg := sdkerrs.SdkInternalError, err.Error()
+		if codeErr, ok := errs.Unwrap(err).(errs.CodeError); ok {
+			code, msg = codeErr.Code(), codeErr.Msg()
+		}
+		c.msgListener().OnScheduledMessageFailed(utils.StructToJsonString(s), int32(code), msg)
+		return
+	}
+	c.msgListener().OnScheduledMessageSent(utils.StructToJsonString(s))
+}
+
+// emptySendMsgCallback stands in for the caller callback of SendMessage, nobody waits on a
+// scheduled send.
+type emptySendMsgCallback struct{}
+
+func (emptySendMsgCallback) OnError(int32, string) {}
+
+func (emptySendMsgCallback) OnSuccess(string) {}
+
+func (emptySendMsgCallback) OnProgress(int) {}
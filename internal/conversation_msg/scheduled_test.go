@@ -0,0 +1,72 @@
+//go:build !js
+
+package conversation_msg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+func TestScheduledMessageQueue(t *testing.T) {
+	ctx := context.Background()
+	c := newTestConversation(t, "scheduleUser", t.TempDir())
+	now := time.Now().UnixMilli()
+	message := func(clientMsgID string) *sdk_struct.MsgStruct {
+		return &sdk_struct.MsgStruct{ClientMsgID: clientMsgID, ContentType: constant.Text, TextElem: &sdk_struct.TextElem{Content: clientMsgID}}
+	}
+
+	if _, err := c.ScheduleMessage(ctx, message("past"), "peer", "", now-1, nil); !sdkerrs.ErrArgs.Is(err) {
+		t.Fatalf("schedule in the past: %v", err)
+	}
+	if _, err := c.ScheduleMessage(ctx, message("nobody"), "", "", now+time.Hour.Milliseconds(), nil); !sdkerrs.ErrArgs.Is(err) {
+		t.Fatalf("schedule without receiver: %v", err)
+	}
+	if _, err := c.ScheduleMessage(ctx, message("later"), "", "group", now+2*time.Hour.Milliseconds(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ScheduleMessage(ctx, message("soon"), "peer", "", now+time.Hour.Milliseconds(), &sdkws.OfflinePushInfo{Title: "title"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ScheduleMessage(ctx, message("soon"), "peer", "", now+time.Hour.Milliseconds(), nil); !sdkerrs.ErrMsgRepeated.Is(err) {
+		t.Fatalf("schedule twice: %v", err)
+	}
+
+	list, err := c.GetScheduledMessages(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list[0].Message.ClientMsgID != "soon" || list[0].RecvID != "peer" || list[1].GroupID != "group" {
+		t.Fatalf("scheduled messages %+v", list)
+	}
+	if list[0].Message.TextElem == nil || list[0].Message.TextElem.Content != "soon" {
+		t.Fatalf("scheduled message content %+v", list[0].Message)
+	}
+	if wait := c.nextScheduledWait(ctx); wait != scheduledMessageIdleWait {
+		t.Fatalf("next wait %s, want %s", wait, scheduledMessageIdleWait)
+	}
+	// nothing is due, so the dispatcher neither sends nor needs the connection
+	if wait := c.dispatchScheduledMessages(ctx); wait != scheduledMessageIdleWait {
+		t.Fatalf("dispatch wait %s", wait)
+	}
+
+	if err := c.CancelScheduledMessage(ctx, "soon"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CancelScheduledMessage(ctx, "soon"); !sdkerrs.ErrArgs.Is(err) {
+		t.Fatalf("cancel twice: %v", err)
+	}
+	if list, err := c.GetScheduledMessages(ctx); err != nil || len(list) != 1 || list[0].Message.ClientMsgID != "later" {
+		t.Fatalf("scheduled messages after cancel %+v, %v", list, err)
+	}
+	select {
+	case <-c.scheduledWake:
+	default:
+		t.Fatal("dispatcher not woken")
+	}
+}
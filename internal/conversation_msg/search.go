@@ -0,0 +1,100 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"sort"
+	"unicode"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	sdk "github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+)
+
+const (
+	// snippetContext is the number of runes kept before the first match.
+	snippetContext = 16
+	// snippetLength is the maximum number of runes of a snippet, ellipses excluded.
+	snippetLength   = 64
+	snippetEllipsis = '…'
+)
+
+// searchSnippet cuts the searchable text of a matched message around its first keyword match
+// and reports where every match falls inside the cut. It returns nil without any match.
+func searchSnippet(message *model_struct.LocalChatLog, keywordList []string) *sdk.MessageSnippet {
+	text := []rune(utils.SearchableText(message.ContentType, message.Content))
+	matches := keywordMatches(text, keywordList)
+	if len(matches) == 0 {
+		return nil
+	}
+	start := max(0, matches[0][0]-snippetContext)
+	end := min(len(text), start+snippetLength)
+	var snippet []rune
+	shift := -start
+	if start > 0 {
+		snippet = append(snippet, snippetEllipsis)
+		shift++
+	}
+	snippet = append(snippet, text[start:end]...)
+	if end < len(text) {
+		snippet = append(snippet, snippetEllipsis)
+	}
+	offsets := make([][2]int, 0, len(matches))
+	for _, match := range matches {
+		if match[0] < start || match[1] > end {
+			continue
+		}
+		offsets = append(offsets, [2]int{match[0] + shift, match[1] + shift})
+	}
+	return &sdk.MessageSnippet{ClientMsgID: message.ClientMsgID, Snippet: string(snippet), Offsets: offsets}
+}
+
+// keywordMatches returns the case insensitive, non overlapping [start, end) rune ranges of the
+// keywords in text, in text order.
+func keywordMatches(text []rune, keywordList []string) [][2]int {
+	lower := make([]rune, len(text))
+	for i, r := range text {
+		lower[i] = unicode.ToLower(r)
+	}
+	var matches [][2]int
+	for _, keyword := range keywordList {
+		k := []rune(keyword)
+		if len(k) == 0 {
+			continue
+		}
+		for i := range k {
+			k[i] = unicode.ToLower(k[i])
+		}
+		for i := 0; i+len(k) <= len(lower); {
+			if equalRunes(lower[i:i+len(k)], k) {
+				matches = append(matches, [2]int{i, i + len(k)})
+				i += len(k)
+				continue
+			}
+			i++
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i][0] < matches[j][0] })
+	return matches
+}
+
+func equalRunes(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
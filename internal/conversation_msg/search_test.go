@@ -0,0 +1,40 @@
+package conversation_msg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+func TestSearchSnippet(t *testing.T) {
+	text := strings.Repeat("前言", 20) + "Hello 世界, hello again" + strings.Repeat("后记", 40)
+	message := &model_struct.LocalChatLog{
+		ClientMsgID: "clientMsgID",
+		ContentType: constant.Text,
+		Content:     utils.StructToJsonString(sdk_struct.TextElem{Content: text}),
+	}
+	snippet := searchSnippet(message, []string{"hello", "世界"})
+	if snippet == nil {
+		t.Fatal("expected snippet")
+	}
+	runes := []rune(snippet.Snippet)
+	if runes[0] != snippetEllipsis || runes[len(runes)-1] != snippetEllipsis {
+		t.Fatalf("snippet is not cut: %s", snippet.Snippet)
+	}
+	want := []string{"Hello", "世界", "hello"}
+	if len(snippet.Offsets) != len(want) {
+		t.Fatalf("offsets %v", snippet.Offsets)
+	}
+	for i, offset := range snippet.Offsets {
+		if got := string(runes[offset[0]:offset[1]]); got != want[i] {
+			t.Fatalf("match %d is %q, want %q", i, got, want[i])
+		}
+	}
+	if searchSnippet(message, []string{"missing"}) != nil {
+		t.Fatal("expected no snippet without match")
+	}
+}
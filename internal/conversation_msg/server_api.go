@@ -3,6 +3,7 @@ package conversation_msg
 import (
 	"context"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/api"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/server_api_params"
 	pbConversation "github.com/openimsdk/protocol/conversation"
 	pbMsg "github.com/openimsdk/protocol/msg"
 )
@@ -46,6 +47,27 @@ func (c *Conversation) revokeMessageFromServer(ctx context.Context, conversation
 	return api.RevokeMsg.Execute(ctx, req)
 }
 
+func (c *Conversation) editMessageFromServer(ctx context.Context, conversationID string, seq int64, clientMsgID, content string) (*server_api_params.EditMsgResp, error) {
+	req := &server_api_params.EditMsgReq{UserID: c.loginUserID, ConversationID: conversationID, Seq: seq, ClientMsgID: clientMsgID, Content: content}
+	return api.EditMsg.Invoke(ctx, req)
+}
+
+func (c *Conversation) setMessageReactionFromServer(ctx context.Context, conversationID string, seq int64, clientMsgID string, reactionType int, info string, remove bool) (*server_api_params.MsgReactionResp, error) {
+	req := &server_api_params.MsgReactionReq{UserID: c.loginUserID, ConversationID: conversationID, Seq: seq, ClientMsgID: clientMsgID, ReactionType: reactionType, Info: info}
+	if remove {
+		return api.RemoveMsgReaction.Invoke(ctx, req)
+	}
+	return api.AddMsgReaction.Invoke(ctx, req)
+}
+
+func (c *Conversation) setMessagePinFromServer(ctx context.Context, conversationID string, seq int64, clientMsgID string, pinned bool) (*server_api_params.MsgPinResp, error) {
+	req := &server_api_params.MsgPinReq{UserID: c.loginUserID, ConversationID: conversationID, Seq: seq, ClientMsgID: clientMsgID}
+	if pinned {
+		return api.PinMsg.Invoke(ctx, req)
+	}
+	return api.UnpinMsg.Invoke(ctx, req)
+}
+
 func (c *Conversation) getHasReadAndMaxSeqsFromServer(ctx context.Context, conversationIDs ...string) (*pbMsg.GetConversationsHasReadAndMaxSeqResp, error) {
 	req := pbMsg.GetConversationsHasReadAndMaxSeqReq{UserID: c.loginUserID, ConversationIDs: conversationIDs}
 	return api.GetConversationsHasReadAndMaxSeq.Invoke(ctx, &req)
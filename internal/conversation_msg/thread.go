@@ -0,0 +1,110 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation_msg
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+)
+
+// A thread is a root message with the quotes replying to it. Quoting a reply joins the thread of
+// the reply, so a thread is one level deep and every reply records the same root.
+
+// threadRootOf returns the thread root a quote of message replies in.
+func threadRootOf(message *sdk_struct.MsgStruct) string {
+	if message.ThreadRootID != "" {
+		return message.ThreadRootID
+	}
+	if message.ContentType == constant.Quote && message.QuoteElem != nil {
+		if message.QuoteElem.ThreadRootID != "" {
+			return message.QuoteElem.ThreadRootID
+		}
+		if message.QuoteElem.QuoteMessage != nil {
+			return message.QuoteElem.QuoteMessage.ClientMsgID
+		}
+	}
+	return message.ClientMsgID
+}
+
+func (c *Conversation) getThreadMessages(ctx context.Context, req sdk_params_callback.GetThreadMessagesParams) (*sdk_params_callback.GetThreadMessagesCallback, error) {
+	if req.RootClientMsgID == "" {
+		return nil, sdkerrs.ErrArgs.WrapMsg("rootClientMsgID is empty")
+	}
+	if req.Count <= 0 {
+		return nil, sdkerrs.ErrArgs.WrapMsg("count must be greater than 0")
+	}
+	var startTime int64
+	if req.StartClientMsgID != "" {
+		start, err := c.db.GetMessage(ctx, req.ConversationID, req.StartClientMsgID)
+		if err != nil {
+			return nil, err
+		}
+		startTime = start.SendTime
+	}
+	list, err := c.db.GetThreadMessages(ctx, req.ConversationID, req.RootClientMsgID, startTime, req.StartClientMsgID, req.Count)
+	if err != nil {
+		return nil, err
+	}
+	res := &sdk_params_callback.GetThreadMessagesCallback{MessageList: c.LocalChatLog2MsgStruct(list), IsEnd: len(list) < req.Count}
+	// the root may be deleted or not synced, its replies are still shown
+	root, err := c.db.GetMessage(ctx, req.ConversationID, req.RootClientMsgID)
+	if err == nil {
+		res.RootMessage = LocalChatLogToMsgStruct(root)
+		c.attachThreadInfo(ctx, req.ConversationID, []*sdk_struct.MsgStruct{res.RootMessage})
+	} else if errs.Unwrap(err) != errs.ErrRecordNotFound {
+		log.ZWarn(ctx, "get thread root failed", err, "conversationID", req.ConversationID, "rootClientMsgID", req.RootClientMsgID)
+	}
+	return res, nil
+}
+
+// attachThreadInfo sets the thread summary of the messages that have replies. It only logs
+// failures, a message list is still shown without them.
+func (c *Conversation) attachThreadInfo(ctx context.Context, conversationID string, messages []*sdk_struct.MsgStruct) {
+	var roots []string
+	for _, message := range messages {
+		// replies are never roots
+		if message.ThreadRootID == "" {
+			roots = append(roots, message.ClientMsgID)
+		}
+	}
+	if len(roots) == 0 {
+		return
+	}
+	summaries, err := c.db.GetThreadSummaries(ctx, conversationID, roots)
+	if err != nil {
+		log.ZWarn(ctx, "GetThreadSummaries failed", err, "conversationID", conversationID)
+		return
+	}
+	summaryMap := datautil.SliceToMap(summaries, func(s *model_struct.LocalThreadSummary) string { return s.RootClientMsgID })
+	for _, message := range messages {
+		summary, ok := summaryMap[message.ClientMsgID]
+		if !ok || message.ThreadRootID != "" {
+			continue
+		}
+		info := &sdk_struct.ThreadInfo{ReplyCount: summary.ReplyCount}
+		if summary.LatestReply != nil {
+			info.LatestReply = LocalChatLogToMsgStruct(summary.LatestReply)
+		}
+		message.ThreadInfo = info
+	}
+}
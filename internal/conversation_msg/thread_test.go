@@ -0,0 +1,66 @@
+//go:build !js
+
+package conversation_msg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+// threadReply is a quote of quoted as CreateQuoteMessage builds it.
+func threadReply(clientMsgID string, seq int64, quoted *sdk_struct.MsgStruct) *model_struct.LocalChatLog {
+	reply := &sdk_struct.MsgStruct{ClientMsgID: clientMsgID, SendID: testUserID, ContentType: constant.Quote,
+		Status: constant.MsgStatusSendSuccess, Seq: seq, SendTime: 1000 + seq, ThreadRootID: threadRootOf(quoted)}
+	reply.QuoteElem = &sdk_struct.QuoteElem{Text: clientMsgID, QuoteMessage: quoted, ThreadRootID: reply.ThreadRootID}
+	return MsgStructToLocalChatLog(reply)
+}
+
+func TestThreadMessages(t *testing.T) {
+	ctx := context.Background()
+	c := newTestConversation(t, testUserID, t.TempDir())
+	conversationID := "si_testUser_peer"
+	root := testTextMessage("root", 1, "root")
+	r1 := threadReply("r1", 2, LocalChatLogToMsgStruct(root))
+	// quoting a reply joins the thread of the reply
+	r2 := threadReply("r2", 3, LocalChatLogToMsgStruct(r1))
+	if r1.ThreadRootID != "root" || r2.ThreadRootID != "root" {
+		t.Fatalf("thread roots %q %q", r1.ThreadRootID, r2.ThreadRootID)
+	}
+	if err := c.db.BatchInsertMessageList(ctx, conversationID, []*model_struct.LocalChatLog{root, r1, r2, testTextMessage("other", 4, "other")}); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := c.getThreadMessages(ctx, sdk_params_callback.GetThreadMessagesParams{ConversationID: conversationID, RootClientMsgID: "root", Count: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.MessageList) != 1 || res.MessageList[0].ClientMsgID != "r1" || res.IsEnd {
+		t.Fatalf("first thread page %+v", res)
+	}
+	if info := res.RootMessage.ThreadInfo; info == nil || info.ReplyCount != 2 || info.LatestReply.ClientMsgID != "r2" {
+		t.Fatalf("root thread info %+v", info)
+	}
+	res, err = c.getThreadMessages(ctx, sdk_params_callback.GetThreadMessagesParams{ConversationID: conversationID, RootClientMsgID: "root",
+		StartClientMsgID: "r1", Count: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.MessageList) != 1 || res.MessageList[0].ClientMsgID != "r2" || !res.IsEnd {
+		t.Fatalf("next thread page %+v", res)
+	}
+	if res.MessageList[0].ThreadRootID != "root" || res.MessageList[0].QuoteElem.ThreadRootID != "root" {
+		t.Fatalf("reply thread root %+v", res.MessageList[0])
+	}
+
+	// the history carries the summary on roots only
+	history := c.LocalChatLog2MsgStruct([]*model_struct.LocalChatLog{root, r1, testTextMessage("other", 4, "other")})
+	c.attachThreadInfo(ctx, conversationID, history)
+	if history[0].ThreadInfo == nil || history[1].ThreadInfo != nil || history[2].ThreadInfo != nil {
+		t.Fatalf("history thread info %+v %+v %+v", history[0].ThreadInfo, history[1].ThreadInfo, history[2].ThreadInfo)
+	}
+}
@@ -29,7 +29,6 @@ import (
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/page"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/syncer"
-	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
 	"github.com/openimsdk/protocol/group"
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/log"
@@ -54,7 +53,7 @@ func NewGroup(loginUserID string, db db_interface.DataBase,
 }
 
 type Group struct {
-	listener                func() open_im_sdk_callback.OnGroupListener
+	listener                open_im_sdk_callback.OnGroupListenerSdk
 	loginUserID             string
 	db                      db_interface.DataBase
 	groupSyncer             *syncer.Syncer[*model_struct.LocalGroup, group.GetJoinedGroupListResp, string]
@@ -100,7 +99,7 @@ func (g *Group) initSyncer() {
 			case syncer.Insert:
 				// when a user kicked to the group and invited to the group again, group info maybe updated,
 				// so conversation info need to be updated
-				g.listener().OnJoinedGroupAdded(utils.StructToJsonString(server))
+				g.listener.OnJoinedGroupAdded(*server)
 				_ = common.TriggerCmdUpdateConversation(ctx, common.UpdateConNode{
 					Action: constant.UpdateConFaceUrlAndNickName,
 					Args: common.SourceIDAndSessionType{
@@ -110,7 +109,7 @@ func (g *Group) initSyncer() {
 				}, g.conversationCh)
 			case syncer.Delete:
 				local.MemberCount = 0
-				g.listener().OnJoinedGroupDeleted(utils.StructToJsonString(local))
+				g.listener.OnJoinedGroupDeleted(*local)
 			case syncer.Update:
 				log.ZInfo(ctx, "groupSyncer trigger update", "groupID",
 					server.GroupID, "data", server, "isDismissed", server.Status == constant.GroupStatusDismissed)
@@ -118,9 +117,9 @@ func (g *Group) initSyncer() {
 					if err := g.db.DeleteGroupAllMembers(ctx, server.GroupID); err != nil {
 						log.ZError(ctx, "delete group all members failed", err)
 					}
-					g.listener().OnGroupDismissed(utils.StructToJsonString(server))
+					g.listener.OnGroupDismissed(*server)
 				} else {
-					g.listener().OnGroupInfoChanged(utils.StructToJsonString(server))
+					g.listener.OnGroupInfoChanged(*server)
 					if server.GroupName != local.GroupName || local.FaceURL != server.FaceURL {
 						_ = common.TriggerCmdUpdateConversation(ctx, common.UpdateConNode{
 							Action: constant.UpdateConFaceUrlAndNickName,
@@ -168,7 +167,7 @@ func (g *Group) initSyncer() {
 		syncer.WithNotice[*model_struct.LocalGroupMember, group.GetGroupMemberListResp, [2]string](func(ctx context.Context, state int, server, local *model_struct.LocalGroupMember) error {
 			switch state {
 			case syncer.Insert:
-				g.listener().OnGroupMemberAdded(utils.StructToJsonString(server))
+				g.listener.OnGroupMemberAdded(*server)
 				// When a user is kicked and invited to the group again, group member info will be updated.
 				_ = common.TriggerCmdUpdateMessage(ctx,
 					common.UpdateMessageNode{
@@ -179,9 +178,9 @@ func (g *Group) initSyncer() {
 						},
 					}, g.conversationCh)
 			case syncer.Delete:
-				g.listener().OnGroupMemberDeleted(utils.StructToJsonString(local))
+				g.listener.OnGroupMemberDeleted(*local)
 			case syncer.Update:
-				g.listener().OnGroupMemberInfoChanged(utils.StructToJsonString(server))
+				g.listener.OnGroupMemberInfoChanged(*server)
 				if server.Nickname != local.Nickname || server.FaceURL != local.FaceURL {
 					_ = common.TriggerCmdUpdateMessage(ctx,
 						common.UpdateMessageNode{
@@ -226,15 +225,15 @@ func (g *Group) initSyncer() {
 	}, nil, func(ctx context.Context, state int, server, local *model_struct.LocalGroupRequest) error {
 		switch state {
 		case syncer.Insert:
-			g.listener().OnGroupApplicationAdded(utils.StructToJsonString(server))
+			g.listener.OnGroupApplicationAdded(*server)
 		case syncer.Update:
 			switch server.HandleResult {
 			case constant.FriendResponseAgree:
-				g.listener().OnGroupApplicationAccepted(utils.StructToJsonString(server))
+				g.listener.OnGroupApplicationAccepted(*server)
 			case constant.FriendResponseRefuse:
-				g.listener().OnGroupApplicationRejected(utils.StructToJsonString(server))
+				g.listener.OnGroupApplicationRejected(*server)
 			default:
-				g.listener().OnGroupApplicationAdded(utils.StructToJsonString(server))
+				g.listener.OnGroupApplicationAdded(*server)
 			}
 		}
 		return nil
@@ -251,15 +250,15 @@ func (g *Group) initSyncer() {
 	}, nil, func(ctx context.Context, state int, server, local *model_struct.LocalAdminGroupRequest) error {
 		switch state {
 		case syncer.Insert:
-			g.listener().OnGroupApplicationAdded(utils.StructToJsonString(server))
+			g.listener.OnGroupApplicationAdded(server.LocalGroupRequest)
 		case syncer.Update:
 			switch server.HandleResult {
 			case constant.FriendResponseAgree:
-				g.listener().OnGroupApplicationAccepted(utils.StructToJsonString(server))
+				g.listener.OnGroupApplicationAccepted(server.LocalGroupRequest)
 			case constant.FriendResponseRefuse:
-				g.listener().OnGroupApplicationRejected(utils.StructToJsonString(server))
+				g.listener.OnGroupApplicationRejected(server.LocalGroupRequest)
 			default:
-				g.listener().OnGroupApplicationAdded(utils.StructToJsonString(server))
+				g.listener.OnGroupApplicationAdded(server.LocalGroupRequest)
 			}
 		}
 		return nil
@@ -268,7 +267,7 @@ func (g *Group) initSyncer() {
 }
 
 func (g *Group) SetGroupListener(listener func() open_im_sdk_callback.OnGroupListener) {
-	g.listener = listener
+	g.listener = open_im_sdk_callback.NewOnGroupListenerSdk(listener)
 }
 
 func (g *Group) SetListenerForService(listener open_im_sdk_callback.OnListenerForService) {
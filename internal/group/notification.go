@@ -186,7 +186,9 @@ func (g *Group) doNotification(ctx context.Context, msg *sdkws.MsgData) error {
 			if err := utils.UnmarshalNotificationElem(msg.Content, &detail); err != nil {
 				return err
 			}
-			g.listener().OnGroupDismissed(utils.StructToJsonString(detail.Group))
+			if detail.Group != nil {
+				g.listener.OnGroupDismissed(*ServerGroupToLocalGroup(detail.Group))
+			}
 
 			return g.IncrSyncJoinGroup(ctx)
 		case constant.GroupMemberMutedNotification: // 1512
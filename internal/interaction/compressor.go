@@ -18,14 +18,28 @@ import (
 	"bytes"
 	"compress/gzip"
 	"errors"
-	"github.com/openimsdk/tools/errs"
 	"io"
 	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	GzipCompression = "gzip"
+	ZstdCompression = "zstd"
+	NoCompression   = "none"
+
+	// compressionHeader is set by the gateway on the handshake response to confirm the compressor it will use.
+	compressionHeader = "X-Ws-Compression"
 )
 
 var (
 	gzipWriterPool = sync.Pool{New: func() any { return gzip.NewWriter(nil) }}
 	gzipReaderPool = sync.Pool{New: func() any { return new(gzip.Reader) }}
+
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
 )
 
 type Compressor interface {
@@ -33,6 +47,26 @@ type Compressor interface {
 	CompressWithPool(rawData []byte) ([]byte, error)
 	DeCompress(compressedData []byte) ([]byte, error)
 	DecompressWithPool(compressedData []byte) ([]byte, error)
+	// Name returns the compression name sent to the gateway when dialing.
+	Name() string
+	// IsCompressed reports whether data is a frame produced by this compressor. Frames under the
+	// compression threshold are sent raw and are told apart from compressed ones by their magic bytes.
+	IsCompressed(data []byte) bool
+}
+
+// NewCompressor returns the compressor registered under name. An empty name selects gzip,
+// which is what gateways expect when no compressor is negotiated.
+func NewCompressor(name string) (Compressor, error) {
+	switch name {
+	case "", GzipCompression:
+		return NewGzipCompressor(), nil
+	case ZstdCompression:
+		return NewZstdCompressor(), nil
+	case NoCompression:
+		return NewNoopCompressor(), nil
+	default:
+		return nil, errs.New("unsupported ws compression", "compression", name).Wrap()
+	}
 }
 
 type GzipCompressor struct {
@@ -104,3 +138,100 @@ func (g *GzipCompressor) DecompressWithPool(compressedData []byte) ([]byte, erro
 	_ = reader.Close()
 	return compressedData, nil
 }
+
+func (g *GzipCompressor) Name() string {
+	return GzipCompression
+}
+
+func (g *GzipCompressor) IsCompressed(data []byte) bool {
+	return bytes.HasPrefix(data, gzipMagic)
+}
+
+var (
+	zstdEncoderOnce sync.Once
+	zstdEncoder     *zstd.Encoder
+	zstdDecoderOnce sync.Once
+	zstdDecoder     *zstd.Decoder
+)
+
+// ZstdCompressor shares one encoder and decoder; EncodeAll and DecodeAll are safe for concurrent use.
+type ZstdCompressor struct {
+}
+
+func NewZstdCompressor() *ZstdCompressor {
+	return &ZstdCompressor{}
+}
+
+func (z *ZstdCompressor) encoder() *zstd.Encoder {
+	zstdEncoderOnce.Do(func() {
+		zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1))
+	})
+	return zstdEncoder
+}
+
+func (z *ZstdCompressor) decoder() *zstd.Decoder {
+	zstdDecoderOnce.Do(func() {
+		zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(maxMessageSize*64))
+	})
+	return zstdDecoder
+}
+
+func (z *ZstdCompressor) Compress(rawData []byte) ([]byte, error) {
+	return z.encoder().EncodeAll(rawData, nil), nil
+}
+
+func (z *ZstdCompressor) CompressWithPool(rawData []byte) ([]byte, error) {
+	return z.Compress(rawData)
+}
+
+func (z *ZstdCompressor) DeCompress(compressedData []byte) ([]byte, error) {
+	data, err := z.decoder().DecodeAll(compressedData, nil)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "DecodeAll failed")
+	}
+	return data, nil
+}
+
+func (z *ZstdCompressor) DecompressWithPool(compressedData []byte) ([]byte, error) {
+	return z.DeCompress(compressedData)
+}
+
+func (z *ZstdCompressor) Name() string {
+	return ZstdCompression
+}
+
+func (z *ZstdCompressor) IsCompressed(data []byte) bool {
+	return bytes.HasPrefix(data, zstdMagic)
+}
+
+// NoopCompressor passes frames through unchanged.
+type NoopCompressor struct {
+}
+
+func NewNoopCompressor() *NoopCompressor {
+	return &NoopCompressor{}
+}
+
+func (n *NoopCompressor) Compress(rawData []byte) ([]byte, error) {
+	return rawData, nil
+}
+
+func (n *NoopCompressor) CompressWithPool(rawData []byte) ([]byte, error) {
+	return rawData, nil
+}
+
+func (n *NoopCompressor) DeCompress(compressedData []byte) ([]byte, error) {
+	return compressedData, nil
+}
+
+func (n *NoopCompressor) DecompressWithPool(compressedData []byte) ([]byte, error) {
+	return compressedData, nil
+}
+
+func (n *NoopCompressor) Name() string {
+	return NoCompression
+}
+
+func (n *NoopCompressor) IsCompressed(_ []byte) bool {
+	return false
+}
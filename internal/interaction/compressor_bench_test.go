@@ -0,0 +1,112 @@
+package interaction
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var compressions = []string{GzipCompression, ZstdCompression, NoCompression}
+
+// recordedFrames returns the gob encoded PushMsg frames under testdata, recorded from the
+// frames the fake server pushes to a member of a single and a group chat by TestRecordPushFrames
+// of pkg/fakeserver. Every frame holds one message, as the gateway pushes them.
+func recordedFrames(tb testing.TB) [][]byte {
+	names, err := filepath.Glob(filepath.Join("testdata", "push_*.bin"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if len(names) == 0 {
+		tb.Fatal("no recorded frames in testdata")
+	}
+	frames := make([][]byte, 0, len(names))
+	for _, name := range names {
+		frame, err := os.ReadFile(name)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		frames = append(frames, frame)
+	}
+	return frames
+}
+
+func TestCompressorRoundTrip(t *testing.T) {
+	frames := recordedFrames(t)
+	for _, name := range compressions {
+		compressor, err := NewCompressor(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if compressor.Name() != name {
+			t.Fatalf("compressor name %s, want %s", compressor.Name(), name)
+		}
+		for _, frame := range frames {
+			compressed, err := compressor.CompressWithPool(frame)
+			if err != nil {
+				t.Fatalf("%s compress: %v", name, err)
+			}
+			if compressor.IsCompressed(compressed) != (name != NoCompression) {
+				t.Fatalf("%s compressed frame not detected", name)
+			}
+			if compressor.IsCompressed(frame) {
+				t.Fatalf("%s detected raw frame as compressed", name)
+			}
+			data, err := compressor.DecompressWithPool(compressed)
+			if err != nil {
+				t.Fatalf("%s decompress: %v", name, err)
+			}
+			if !bytes.Equal(frame, data) {
+				t.Fatalf("%s round trip mismatch", name)
+			}
+		}
+	}
+	if _, err := NewCompressor("brotli"); err == nil {
+		t.Fatal("expected error for unknown compression")
+	}
+}
+
+func BenchmarkCompressor(b *testing.B) {
+	frames := recordedFrames(b)
+	var size int
+	for _, frame := range frames {
+		size += len(frame)
+	}
+	for _, name := range compressions {
+		compressor, err := NewCompressor(name)
+		if err != nil {
+			b.Fatal(err)
+		}
+		compressed := make([][]byte, len(frames))
+		var compressedSize int
+		for i, frame := range frames {
+			if compressed[i], err = compressor.CompressWithPool(frame); err != nil {
+				b.Fatal(err)
+			}
+			compressedSize += len(compressed[i])
+		}
+		b.Run(name+"/compress", func(b *testing.B) {
+			b.SetBytes(int64(size))
+			b.ReportMetric(float64(compressedSize)/float64(size), "ratio")
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, frame := range frames {
+					if _, err := compressor.CompressWithPool(frame); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+		b.Run(name+"/decompress", func(b *testing.B) {
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, data := range compressed {
+					if _, err := compressor.DecompressWithPool(data); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build faultinject
+
+package interaction
+
+import "sync"
+
+var (
+	connWrapperLock sync.Mutex
+	// connWrapper wraps the long connection of the LongConnMgrs created afterwards, tests set it
+	// to inject faults.
+	connWrapper func(LongConn) LongConn
+)
+
+// SetLongConnWrapper sets the wrapper of the long connections of new LongConnMgrs, nil removes it.
+// It returns the previous wrapper. It only exists in builds with the faultinject tag.
+func SetLongConnWrapper(wrapper func(LongConn) LongConn) func(LongConn) LongConn {
+	connWrapperLock.Lock()
+	defer connWrapperLock.Unlock()
+	prev := connWrapper
+	connWrapper = wrapper
+	return prev
+}
+
+func wrapLongConn(conn LongConn) LongConn {
+	connWrapperLock.Lock()
+	wrapper := connWrapper
+	connWrapperLock.Unlock()
+	if wrapper == nil {
+		return conn
+	}
+	return wrapper(conn)
+}
@@ -19,6 +19,12 @@ const (
 	Tcp
 )
 
+// Transport names accepted in IMConfig.
+const (
+	WebSocketTransport = "websocket"
+	TcpTransport       = "tcp"
+)
+
 const (
 	// MessageText is for UTF-8 encoded text messages like JSON.
 	MessageText = iota + 1
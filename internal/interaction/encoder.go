@@ -17,12 +17,42 @@ package interaction
 import (
 	"bytes"
 	"encoding/gob"
+	"encoding/json"
+	"fmt"
+
 	"github.com/openimsdk/tools/errs"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	GobCodec      = "gob"
+	JsonCodec     = "json"
+	ProtobufCodec = "protobuf"
+
+	// codecHeader is set by the gateway on the handshake response to confirm the codec it will speak.
+	codecHeader = "X-Ws-Codec"
 )
 
 type Encoder interface {
 	Encode(data interface{}) ([]byte, error)
 	Decode(encodeData []byte, decodeData interface{}) error
+	// Name returns the codec name sent to the gateway when dialing.
+	Name() string
+}
+
+// NewEncoder returns the encoder registered under name. An empty name selects GobEncoder,
+// which is what gateways expect when no codec is negotiated.
+func NewEncoder(name string) (Encoder, error) {
+	switch name {
+	case "", GobCodec:
+		return NewGobEncoder(), nil
+	case JsonCodec:
+		return NewJsonEncoder(), nil
+	case ProtobufCodec:
+		return NewProtobufEncoder(), nil
+	default:
+		return nil, errs.New("unsupported ws codec", "codec", name).Wrap()
+	}
 }
 
 type GobEncoder struct {
@@ -49,3 +79,179 @@ func (g *GobEncoder) Decode(encodeData []byte, decodeData interface{}) error {
 	}
 	return nil
 }
+
+func (g *GobEncoder) Name() string {
+	return GobCodec
+}
+
+// JsonEncoder encodes the envelopes as JSON objects, Data is carried as a base64 string.
+type JsonEncoder struct {
+}
+
+func NewJsonEncoder() *JsonEncoder {
+	return &JsonEncoder{}
+}
+
+func (j *JsonEncoder) Encode(data interface{}) ([]byte, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return b, nil
+}
+
+func (j *JsonEncoder) Decode(encodeData []byte, decodeData interface{}) error {
+	if err := json.Unmarshal(encodeData, decodeData); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (j *JsonEncoder) Name() string {
+	return JsonCodec
+}
+
+// ProtobufEncoder encodes the envelopes in protobuf wire format using the field numbers below,
+// so non-Go peers can describe them with a plain .proto message.
+//
+//	message GeneralWsReq  { int32 reqIdentifier = 1; string token = 2; string sendID = 3; string operationID = 4; string msgIncr = 5; bytes data = 6; }
+//	message GeneralWsResp { int32 reqIdentifier = 1; int32 errCode = 2; string errMsg = 3; string msgIncr = 4; string operationID = 5; bytes data = 6; }
+type ProtobufEncoder struct {
+}
+
+func NewProtobufEncoder() *ProtobufEncoder {
+	return &ProtobufEncoder{}
+}
+
+func (p *ProtobufEncoder) Encode(data interface{}) ([]byte, error) {
+	var b []byte
+	switch v := data.(type) {
+	case GeneralWsReq:
+		return p.Encode(&v)
+	case GeneralWsResp:
+		return p.Encode(&v)
+	case *GeneralWsReq:
+		b = appendVarintField(b, 1, int64(v.ReqIdentifier))
+		b = appendStringField(b, 2, v.Token)
+		b = appendStringField(b, 3, v.SendID)
+		b = appendStringField(b, 4, v.OperationID)
+		b = appendStringField(b, 5, v.MsgIncr)
+		b = appendBytesField(b, 6, v.Data)
+	case *GeneralWsResp:
+		b = appendVarintField(b, 1, int64(v.ReqIdentifier))
+		b = appendVarintField(b, 2, int64(v.ErrCode))
+		b = appendStringField(b, 3, v.ErrMsg)
+		b = appendStringField(b, 4, v.MsgIncr)
+		b = appendStringField(b, 5, v.OperationID)
+		b = appendBytesField(b, 6, v.Data)
+	default:
+		return nil, errs.New("protobuf encoder unsupported type", "type", fmt.Sprintf("%T", data)).Wrap()
+	}
+	return b, nil
+}
+
+func (p *ProtobufEncoder) Decode(encodeData []byte, decodeData interface{}) error {
+	switch v := decodeData.(type) {
+	case *GeneralWsReq:
+		*v = GeneralWsReq{}
+		return consumeFields(encodeData, func(num protowire.Number, varint int64, bytes []byte) {
+			switch num {
+			case 1:
+				v.ReqIdentifier = int(varint)
+			case 2:
+				v.Token = string(bytes)
+			case 3:
+				v.SendID = string(bytes)
+			case 4:
+				v.OperationID = string(bytes)
+			case 5:
+				v.MsgIncr = string(bytes)
+			case 6:
+				v.Data = bytes
+			}
+		})
+	case *GeneralWsResp:
+		*v = GeneralWsResp{}
+		return consumeFields(encodeData, func(num protowire.Number, varint int64, bytes []byte) {
+			switch num {
+			case 1:
+				v.ReqIdentifier = int(varint)
+			case 2:
+				v.ErrCode = int(varint)
+			case 3:
+				v.ErrMsg = string(bytes)
+			case 4:
+				v.MsgIncr = string(bytes)
+			case 5:
+				v.OperationID = string(bytes)
+			case 6:
+				v.Data = bytes
+			}
+		})
+	default:
+		return errs.New("protobuf decoder unsupported type", "type", fmt.Sprintf("%T", decodeData)).Wrap()
+	}
+}
+
+func (p *ProtobufEncoder) Name() string {
+	return ProtobufCodec
+}
+
+func appendVarintField(b []byte, num protowire.Number, v int64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, uint64(v))
+}
+
+func appendStringField(b []byte, num protowire.Number, v string) []byte {
+	if v == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, v)
+}
+
+func appendBytesField(b []byte, num protowire.Number, v []byte) []byte {
+	if len(v) == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// consumeFields walks a protobuf message and hands every varint or length-delimited field to fn.
+// Unknown fields of other wire types are skipped so newer gateways can extend the envelope.
+func consumeFields(b []byte, fn func(num protowire.Number, varint int64, bytes []byte)) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return errs.Wrap(protowire.ParseError(n))
+		}
+		b = b[n:]
+		switch typ {
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return errs.Wrap(protowire.ParseError(n))
+			}
+			fn(num, int64(v), nil)
+			b = b[n:]
+		case protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return errs.Wrap(protowire.ParseError(n))
+			}
+			fn(num, 0, append([]byte(nil), v...))
+			b = b[n:]
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return errs.Wrap(protowire.ParseError(n))
+			}
+			b = b[n:]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package interaction
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+)
+
+var reqIdentifiers = []int{
+	constant.GetNewestSeq,
+	constant.PullMsgByRange,
+	constant.SendMsg,
+	constant.SendSignalMsg,
+	constant.PullMsgBySeqList,
+	constant.GetConvMaxReadSeq,
+	constant.PullConvLastMessage,
+	constant.PushMsg,
+	constant.KickOnlineMsg,
+	constant.LogoutMsg,
+	constant.SetBackgroundStatus,
+	constant.WsSubUserOnlineStatus,
+}
+
+func TestEncoderConformance(t *testing.T) {
+	for _, codec := range []string{GobCodec, JsonCodec, ProtobufCodec} {
+		encoder, err := NewEncoder(codec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if encoder.Name() != codec {
+			t.Fatalf("encoder name %s, want %s", encoder.Name(), codec)
+		}
+		for _, reqIdentifier := range reqIdentifiers {
+			req := GeneralWsReq{
+				ReqIdentifier: reqIdentifier,
+				Token:         "token",
+				SendID:        "sendID",
+				OperationID:   "operationID",
+				MsgIncr:       "sendID_msgIncr",
+				Data:          []byte{0, 1, 2, 255},
+			}
+			data, err := encoder.Encode(req)
+			if err != nil {
+				t.Fatalf("%s encode req %d: %v", codec, reqIdentifier, err)
+			}
+			var decodedReq GeneralWsReq
+			if err := encoder.Decode(data, &decodedReq); err != nil {
+				t.Fatalf("%s decode req %d: %v", codec, reqIdentifier, err)
+			}
+			if !reflect.DeepEqual(req, decodedReq) {
+				t.Fatalf("%s req %d round trip mismatch: %+v != %+v", codec, reqIdentifier, req, decodedReq)
+			}
+
+			resp := GeneralWsResp{
+				ReqIdentifier: reqIdentifier,
+				ErrCode:       -1,
+				ErrMsg:        "errMsg",
+				MsgIncr:       "sendID_msgIncr",
+				OperationID:   "operationID",
+				Data:          []byte("data"),
+			}
+			data, err = encoder.Encode(resp)
+			if err != nil {
+				t.Fatalf("%s encode resp %d: %v", codec, reqIdentifier, err)
+			}
+			var decodedResp GeneralWsResp
+			if err := encoder.Decode(data, &decodedResp); err != nil {
+				t.Fatalf("%s decode resp %d: %v", codec, reqIdentifier, err)
+			}
+			if !reflect.DeepEqual(resp, decodedResp) {
+				t.Fatalf("%s resp %d round trip mismatch: %+v != %+v", codec, reqIdentifier, resp, decodedResp)
+			}
+		}
+	}
+}
+
+func TestNewEncoderUnknown(t *testing.T) {
+	if _, err := NewEncoder("xml"); err == nil {
+		t.Fatal("expected error for unknown codec")
+	}
+}
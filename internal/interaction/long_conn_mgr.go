@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"runtime"
 	"runtime/debug"
 	"strconv"
@@ -55,7 +56,7 @@ const (
 	// Maximum message size allowed from peer.
 	maxMessageSize = 1024 * 1024
 
-	//Maximum number of reconnection attempts
+	// Maximum number of write attempts while disconnected.
 	maxReconnectAttempts = 300
 
 	sendAndWaitTime = time.Second * 10
@@ -80,6 +81,8 @@ type LongConnMgr struct {
 	//conn status mutex
 	w          sync.Mutex
 	connStatus int
+	// connected is closed while the connection is established
+	connected chan struct{}
 	// The long connection,can be set tcp or websocket.
 	conn       LongConn
 	listener   open_im_sdk_callback.OnConnListener
@@ -94,8 +97,14 @@ type LongConnMgr struct {
 	IsCompression      bool
 	Syncer             *WsRespAsyn
 	encoder            Encoder
+	codec              string
 	compressor         Compressor
+	compression        string
 	reconnectStrategy  ReconnectStrategy
+	// reconnectNow cuts the wait before the next reconnect attempt short.
+	reconnectNow chan struct{}
+	// closedErrLock guards closedErr, the read and write pumps both set it.
+	closedErrLock sync.Mutex
 
 	mutex        sync.Mutex
 	IsBackground bool
@@ -103,6 +112,11 @@ type LongConnMgr struct {
 	connWrite *sync.Mutex
 
 	sub *subscription
+
+	// compressionThreshold is the smallest encoded frame that is compressed. It only applies once
+	// the gateway has confirmed the compressor, legacy gateways expect every frame compressed.
+	compressionThreshold int
+	thresholdConfirmed   bool
 }
 
 type Message struct {
@@ -111,20 +125,35 @@ type Message struct {
 }
 
 func NewLongConnMgr(ctx context.Context, listener open_im_sdk_callback.OnConnListener, userOnline func(map[string][]int32), pushMsgAndMaxSeqCh, loginMgrCh chan common.Cmd2Value) *LongConnMgr {
+	encoder, err := NewEncoder(ccontext.Info(ctx).WsCodec())
+	if err != nil {
+		log.ZWarn(ctx, "ws codec not supported, use gob", err)
+		encoder = NewGobEncoder()
+	}
+	compressor, err := NewCompressor(ccontext.Info(ctx).Compression())
+	if err != nil {
+		log.ZWarn(ctx, "ws compression not supported, use gzip", err)
+		compressor = NewGzipCompressor()
+	}
 	l := &LongConnMgr{
 		listener:           listener,
 		userOnline:         userOnline,
 		pushMsgAndMaxSeqCh: pushMsgAndMaxSeqCh,
 		loginMgrCh:         loginMgrCh,
-		IsCompression:      true,
+		IsCompression:      compressor.Name() != NoCompression,
 		Syncer:             NewWsRespAsyn(),
-		encoder:            NewGobEncoder(),
-		compressor:         NewGzipCompressor(),
-		reconnectStrategy:  NewExponentialRetry(),
+		encoder:            encoder,
+		codec:              encoder.Name(),
+		compressor:         compressor,
+		compression:        compressor.Name(),
+		reconnectStrategy:  NewBackoffRetry(ccontext.Info(ctx).Reconnect()),
+		reconnectNow:       make(chan struct{}, 1),
 		sub:                newSubscription(),
 	}
+	l.compressionThreshold = ccontext.Info(ctx).CompressionThreshold()
 	l.send = make(chan Message, 10)
-	l.conn = NewWebSocket(WebSocket)
+	l.conn = NewLongConn(ctx, ccontext.Info(ctx).Transport())
+	l.conn = wrapLongConn(l.conn)
 	l.connWrite = new(sync.Mutex)
 	l.ctx = ctx
 	return l
@@ -186,13 +215,13 @@ func (c *LongConnMgr) readPump(ctx context.Context) {
 	log.ZDebug(ctx, "readPump start", "goroutine ID:", getGoroutineID())
 	defer func() {
 		_ = c.close()
-		log.ZWarn(c.ctx, "readPump closed", c.closedErr)
+		log.ZWarn(c.ctx, "readPump closed", c.getClosedErr())
 	}()
 	connNum := 0
 	for {
 		select {
 		case <-ctx.Done():
-			c.closedErr = ctx.Err()
+			c.setClosedErr(ctx.Err())
 			log.ZInfo(c.ctx, "readPump done, sdk logout.....")
 			return
 		default:
@@ -200,12 +229,13 @@ func (c *LongConnMgr) readPump(ctx context.Context) {
 		ctx = ccontext.WithOperationID(ctx, utils.OperationIDGenerator())
 		needRecon, err := c.reConn(ctx, &connNum)
 		if !needRecon {
-			c.closedErr = err
+			// token errors are not retried, a new login is needed
+			c.setClosedErr(err)
 			return
 		}
 		if err != nil {
 			log.ZWarn(c.ctx, "reConn", err)
-			time.Sleep(c.reconnectStrategy.GetSleepInterval())
+			c.waitReconnect(ctx)
 			continue
 		}
 		c.conn.SetReadLimit(maxMessageSize)
@@ -220,21 +250,55 @@ func (c *LongConnMgr) readPump(ctx context.Context) {
 		switch messageType {
 		case MessageBinary:
 			err := c.handleMessage(message)
+			if isFrameError(err) {
+				c.dropConn(err)
+				continue
+			}
 			if err != nil {
-				c.closedErr = err
+				c.setClosedErr(err)
 				return
 			}
 		case MessageText:
-			c.closedErr = ErrNotSupportMessageProtocol
-			return
+			if c.encoder.Name() != JsonCodec {
+				c.setClosedErr(ErrNotSupportMessageProtocol)
+				return
+			}
+			err := c.handleMessage(message)
+			if isFrameError(err) {
+				c.dropConn(err)
+				continue
+			}
+			if err != nil {
+				c.setClosedErr(err)
+				return
+			}
 		case CloseMessage:
-			c.closedErr = ErrClientClosed
+			c.setClosedErr(ErrClientClosed)
 			return
 		default:
 		}
 	}
 }
 
+// isFrameError reports whether err is a frame the SDK could not decode. The frame is lost, so the
+// connection is dropped and the messages are synced again on reconnect.
+func isFrameError(err error) bool {
+	if err == nil {
+		return false
+	}
+	err = errs.Unwrap(err)
+	return errors.Is(err, sdkerrs.ErrMsgDeCompression) || errors.Is(err, sdkerrs.ErrMsgDecodeBinaryWs) ||
+		errors.Is(err, sdkerrs.ErrMsgBinaryTypeNotSupport)
+}
+
+// dropConn closes the connection after the unreadable frame of err, readPump reconnects.
+func (c *LongConnMgr) dropConn(err error) {
+	log.ZWarn(c.ctx, "invalid frame, reconnect", err)
+	c.setClosedErr(err)
+	_ = c.close()
+	c.sub.onConnClosed(err)
+}
+
 // writePump pumps messages from the hub to the websocket connection.
 //
 // A goroutine running writePump is started for each connection. The
@@ -258,7 +322,7 @@ func (c *LongConnMgr) writePump(ctx context.Context) {
 	for {
 		select {
 		case <-ctx.Done():
-			c.closedErr = ctx.Err()
+			c.setClosedErr(ctx.Err())
 			log.ZInfo(c.ctx, "writePump done, sdk logout.....")
 			return
 		case message, ok := <-c.send:
@@ -269,7 +333,7 @@ func (c *LongConnMgr) writePump(ctx context.Context) {
 				if err != nil {
 					log.ZError(c.ctx, "send close message error", err)
 				}
-				c.closedErr = ErrChanClosed
+				c.setClosedErr(ErrChanClosed)
 				return
 			}
 			log.ZDebug(c.ctx, "writePump recv message", "reqIdentifier", message.Message.ReqIdentifier,
@@ -379,7 +443,7 @@ func (c *LongConnMgr) writeBinaryMsgAndRetry(msg *GeneralWsReq) (chan *GeneralWs
 		err := c.writeBinaryMsg(*msg)
 		if err != nil {
 			log.ZError(c.ctx, "send binary message error", err, "message", msg)
-			c.closedErr = err
+			c.setClosedErr(err)
 			_ = c.close()
 			time.Sleep(time.Second * 1)
 			continue
@@ -442,7 +506,7 @@ func (c *LongConnMgr) writeBinaryMsgNoLock(req GeneralWsReq) error {
 		return sdkerrs.ErrNetwork.WrapMsg("connection closed,re conning...")
 	}
 	_ = c.conn.SetWriteDeadline(writeWait)
-	if c.IsCompression {
+	if c.IsCompression && (!c.thresholdConfirmed || len(encodeBuf) >= c.compressionThreshold) {
 		resultBuf, compressErr := c.compressor.CompressWithPool(encodeBuf)
 		if compressErr != nil {
 			return compressErr
@@ -460,12 +524,25 @@ func (c *LongConnMgr) close() error {
 		return nil
 	}
 	c.connStatus = Closed
-	log.ZWarn(c.ctx, "conn closed", c.closedErr)
+	c.connected = make(chan struct{})
+	log.ZWarn(c.ctx, "conn closed", c.getClosedErr())
 	return c.conn.Close()
 }
 
+func (c *LongConnMgr) setClosedErr(err error) {
+	c.closedErrLock.Lock()
+	defer c.closedErrLock.Unlock()
+	c.closedErr = err
+}
+
+func (c *LongConnMgr) getClosedErr() error {
+	c.closedErrLock.Lock()
+	defer c.closedErrLock.Unlock()
+	return c.closedErr
+}
+
 func (c *LongConnMgr) handleMessage(message []byte) error {
-	if c.IsCompression {
+	if c.IsCompression && c.compressor.IsCompressed(message) {
 		var decompressErr error
 		message, decompressErr = c.compressor.DecompressWithPool(message)
 		if decompressErr != nil {
@@ -623,9 +700,33 @@ func (c *LongConnMgr) GetConnectionStatus() int {
 func (c *LongConnMgr) SetConnectionStatus(status int) {
 	c.w.Lock()
 	defer c.w.Unlock()
+	connected := c.connectedCh()
+	if status == Connected && c.connStatus != Connected {
+		close(connected)
+	} else if status != Connected && c.connStatus == Connected {
+		c.connected = make(chan struct{})
+	}
 	c.connStatus = status
 }
 
+// ConnectedNotify returns a channel that is closed once the connection is established, it is
+// already closed while connected.
+func (c *LongConnMgr) ConnectedNotify() <-chan struct{} {
+	c.w.Lock()
+	defer c.w.Unlock()
+	return c.connectedCh()
+}
+
+func (c *LongConnMgr) connectedCh() chan struct{} {
+	if c.connected == nil {
+		c.connected = make(chan struct{})
+		if c.connStatus == Connected {
+			close(c.connected)
+		}
+	}
+	return c.connected
+}
+
 func (c *LongConnMgr) reConn(ctx context.Context, num *int) (needRecon bool, err error) {
 	if c.IsConnected() {
 		return true, nil
@@ -638,7 +739,10 @@ func (c *LongConnMgr) reConn(ctx context.Context, num *int) (needRecon bool, err
 		ccontext.Info(ctx).WsAddr(), ccontext.Info(ctx).UserID(), ccontext.Info(ctx).Token(),
 		ccontext.Info(ctx).PlatformID(), ccontext.Info(ctx).OperationID(), c.GetBackground())
 	if c.IsCompression {
-		url += fmt.Sprintf("&compression=%s", "gzip")
+		url += fmt.Sprintf("&compression=%s", c.compression)
+	}
+	if c.codec != GobCodec {
+		url += fmt.Sprintf("&codec=%s", c.codec)
 	}
 	log.ZDebug(ctx, "conn start", "url", url)
 	resp, err := c.conn.Dial(url, nil)
@@ -677,6 +781,8 @@ func (c *LongConnMgr) reConn(ctx context.Context, num *int) (needRecon bool, err
 		c.listener.OnConnectFailed(sdkerrs.NetworkError, err.Error())
 		return true, err
 	}
+	c.negotiateCodec(ctx, resp)
+	c.negotiateCompression(ctx, resp)
 	if err := c.writeConnFirstSubMsg(ctx); err != nil {
 		log.ZError(ctx, "first write user online sub info error", err)
 		ccontext.GetApiErrCodeCallback(ctx).OnError(ctx, err)
@@ -694,6 +800,10 @@ func (c *LongConnMgr) reConn(ctx context.Context, num *int) (needRecon bool, err
 	*num++
 	log.ZInfo(c.ctx, "long conn establish success", "localAddr", c.conn.LocalAddr(), "connNum", *num)
 	c.reconnectStrategy.Reset()
+	select {
+	case <-c.reconnectNow:
+	default:
+	}
 	_ = common.TriggerCmdConnected(ctx, c.pushMsgAndMaxSeqCh)
 	return true, nil
 }
@@ -706,15 +816,45 @@ func (c *LongConnMgr) doPushMsg(ctx context.Context, wsResp GeneralWsResp) error
 	}
 	return common.TriggerCmdPushMsg(ctx, &msg, c.pushMsgAndMaxSeqCh)
 }
+
+// waitReconnect waits out the backoff before the next reconnect attempt. A network change cuts
+// the wait short, and is the only way out once the attempts are exhausted.
+func (c *LongConnMgr) waitReconnect(ctx context.Context) {
+	attempt, wait, ok := c.reconnectStrategy.Next()
+	if !ok {
+		log.ZWarn(ctx, "reconnect attempts exhausted, wait for network change", nil, "attempts", attempt)
+		c.listener.OnReconnecting(int32(attempt), -1)
+		select {
+		case <-ctx.Done():
+		case <-c.reconnectNow:
+		}
+		return
+	}
+	log.ZDebug(ctx, "reconnect backoff", "attempt", attempt, "wait", wait)
+	c.listener.OnReconnecting(int32(attempt), wait.Milliseconds())
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-c.reconnectNow:
+	case <-timer.C:
+	}
+}
+
+// Close closes the connection on a network change and reconnects right away with a fresh backoff.
 func (c *LongConnMgr) Close(ctx context.Context) {
 	if c.GetConnectionStatus() == Connected {
 		log.ZInfo(ctx, "network change conn close")
-		c.closedErr = errors.New("closed by client network change")
+		c.setClosedErr(errors.New("closed by client network change"))
 		_ = c.close()
 	} else {
 		log.ZInfo(ctx, "conn already closed")
 	}
-
+	c.reconnectStrategy.Reset()
+	select {
+	case c.reconnectNow <- struct{}{}:
+	default:
+	}
 }
 func (c *LongConnMgr) GetBackground() bool {
 	c.mutex.Lock()
@@ -756,3 +896,56 @@ func (c *LongConnMgr) writePongMsg() error {
 
 	return c.conn.WriteMessage(PongMessage, nil)
 }
+
+// negotiateCodec picks the encoder for the new connection from the codec the gateway confirmed
+// in the handshake response. Gateways that do not understand the codec parameter reply without
+// the header and are spoken to in gob.
+func (c *LongConnMgr) negotiateCodec(ctx context.Context, resp *http.Response) {
+	if c.codec == GobCodec || resp == nil {
+		return
+	}
+	name := resp.Header.Get(codecHeader)
+	if name == "" {
+		log.ZWarn(ctx, "gateway did not confirm ws codec, fall back to gob", nil, "codec", c.codec)
+		name = GobCodec
+	}
+	if name == c.encoder.Name() {
+		return
+	}
+	encoder, err := NewEncoder(name)
+	if err != nil {
+		log.ZWarn(ctx, "gateway chose unsupported ws codec", err, "codec", name)
+		return
+	}
+	log.ZInfo(ctx, "ws codec negotiated", "requested", c.codec, "codec", name)
+	c.encoder = encoder
+}
+
+// negotiateCompression picks the compressor for the new connection from the one the gateway
+// confirmed in the handshake response. Gateways that do not know the compression parameter
+// reply without the header and only understand gzip, so every frame is gzip compressed.
+func (c *LongConnMgr) negotiateCompression(ctx context.Context, resp *http.Response) {
+	c.thresholdConfirmed = false
+	if !c.IsCompression || resp == nil {
+		return
+	}
+	name := resp.Header.Get(compressionHeader)
+	if name == "" {
+		if c.compression != GzipCompression {
+			log.ZWarn(ctx, "gateway did not confirm ws compression, fall back to gzip", nil, "compression", c.compression)
+		}
+		name = GzipCompression
+	} else {
+		c.thresholdConfirmed = true
+	}
+	if name == c.compressor.Name() {
+		return
+	}
+	compressor, err := NewCompressor(name)
+	if err != nil {
+		log.ZWarn(ctx, "gateway chose unsupported ws compression", err, "compression", name)
+		return
+	}
+	log.ZInfo(ctx, "ws compression negotiated", "requested", c.compression, "compression", name)
+	c.compressor = compressor
+}
@@ -1,32 +1,125 @@
 package interaction
 
 import (
+	"math/rand"
+	"sync"
 	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+const (
+	// JitterNone waits exactly the backoff, the default.
+	JitterNone = "none"
+	// JitterFull waits a random time between zero and the backoff.
+	JitterFull = "full"
+	// JitterDecorrelated waits a random time between the base and the previous wait times the
+	// multiplier.
+	JitterDecorrelated = "decorrelated"
+
+	defaultReconnectBase       = time.Second
+	defaultReconnectCap        = 16 * time.Second
+	defaultReconnectMultiplier = 2
 )
 
 type ReconnectStrategy interface {
-	GetSleepInterval() time.Duration
+	// Next counts a failed attempt and returns the number of failed attempts since the last
+	// Reset and the wait before the next one, ok is false once the attempts are exhausted.
+	Next() (attempt int, wait time.Duration, ok bool)
 	Reset()
 }
 
-type ExponentialRetry struct {
-	attempts []int
-	index    int
+// BackoffRetry backs off exponentially from the base up to the cap, optionally with jitter so
+// that clients dropped together do not reconnect in lockstep.
+type BackoffRetry struct {
+	base        time.Duration
+	cap         time.Duration
+	multiplier  float64
+	jitter      string
+	maxAttempts int
+
+	lock    sync.Mutex
+	rand    *rand.Rand
+	attempt int
+	prev    time.Duration
+}
+
+func NewBackoffRetry(conf sdk_struct.ReconnectConfig) *BackoffRetry {
+	return newBackoffRetry(conf, time.Now().UnixNano())
+}
+
+func newBackoffRetry(conf sdk_struct.ReconnectConfig, seed int64) *BackoffRetry {
+	rs := &BackoffRetry{
+		base:        time.Duration(conf.Base) * time.Millisecond,
+		cap:         time.Duration(conf.Cap) * time.Millisecond,
+		multiplier:  conf.Multiplier,
+		jitter:      conf.Jitter,
+		maxAttempts: int(conf.MaxAttempts),
+		rand:        rand.New(rand.NewSource(seed)),
+	}
+	if rs.base <= 0 {
+		rs.base = defaultReconnectBase
+	}
+	if rs.cap <= 0 {
+		rs.cap = defaultReconnectCap
+	}
+	if rs.cap < rs.base {
+		rs.cap = rs.base
+	}
+	if rs.multiplier < 1 {
+		rs.multiplier = defaultReconnectMultiplier
+	}
+	switch rs.jitter {
+	case JitterFull, JitterDecorrelated:
+	default:
+		rs.jitter = JitterNone
+	}
+	return rs
+}
+
+func (rs *BackoffRetry) Next() (int, time.Duration, bool) {
+	rs.lock.Lock()
+	defer rs.lock.Unlock()
+	if rs.maxAttempts > 0 && rs.attempt >= rs.maxAttempts {
+		return rs.attempt, 0, false
+	}
+	rs.attempt++
+	var wait time.Duration
+	switch rs.jitter {
+	case JitterFull:
+		wait = time.Duration(rs.rand.Int63n(int64(rs.backoff()) + 1))
+	case JitterDecorrelated:
+		upper := rs.base
+		if rs.prev > 0 {
+			upper = rs.limit(float64(rs.prev) * rs.multiplier)
+		}
+		wait = rs.base + time.Duration(rs.rand.Int63n(int64(upper-rs.base)+1))
+	default:
+		wait = rs.backoff()
+	}
+	rs.prev = wait
+	return rs.attempt, wait, true
 }
 
-func NewExponentialRetry() *ExponentialRetry {
-	return &ExponentialRetry{
-		attempts: []int{1, 2, 4, 8, 16},
-		index:    -1,
+// backoff is the wait of the current attempt without jitter.
+func (rs *BackoffRetry) backoff() time.Duration {
+	wait := float64(rs.base)
+	for i := 1; i < rs.attempt && wait < float64(rs.cap); i++ {
+		wait *= rs.multiplier
 	}
+	return rs.limit(wait)
 }
 
-func (rs *ExponentialRetry) GetSleepInterval() time.Duration {
-	rs.index++
-	interval := rs.index % len(rs.attempts)
-	return time.Second * time.Duration(rs.attempts[interval])
+func (rs *BackoffRetry) limit(wait float64) time.Duration {
+	if wait >= float64(rs.cap) {
+		return rs.cap
+	}
+	return time.Duration(wait)
 }
 
-func (rs *ExponentialRetry) Reset() {
-	rs.index = -1
+func (rs *BackoffRetry) Reset() {
+	rs.lock.Lock()
+	defer rs.lock.Unlock()
+	rs.attempt = 0
+	rs.prev = 0
 }
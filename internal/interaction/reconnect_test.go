@@ -0,0 +1,137 @@
+//go:build !js
+
+package interaction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+func waits(t *testing.T, rs ReconnectStrategy, n int) []time.Duration {
+	t.Helper()
+	var res []time.Duration
+	for i := 1; i <= n; i++ {
+		attempt, wait, ok := rs.Next()
+		if !ok || attempt != i {
+			t.Fatalf("attempt %d: got attempt %d ok %v", i, attempt, ok)
+		}
+		res = append(res, wait)
+	}
+	return res
+}
+
+func TestBackoffRetryDefaults(t *testing.T) {
+	rs := newBackoffRetry(sdk_struct.ReconnectConfig{}, 1)
+	want := []time.Duration{1, 2, 4, 8, 16, 16, 16}
+	for i, wait := range waits(t, rs, len(want)) {
+		if wait != want[i]*time.Second {
+			t.Fatalf("attempt %d: wait %v, want %v", i+1, wait, want[i]*time.Second)
+		}
+	}
+	rs.Reset()
+	if attempt, wait, _ := rs.Next(); attempt != 1 || wait != time.Second {
+		t.Fatalf("after reset: attempt %d wait %v", attempt, wait)
+	}
+}
+
+func TestBackoffRetryConfig(t *testing.T) {
+	rs := newBackoffRetry(sdk_struct.ReconnectConfig{Base: 100, Cap: 1000, Multiplier: 3, MaxAttempts: 4}, 1)
+	want := []time.Duration{100, 300, 900, 1000}
+	for i, wait := range waits(t, rs, len(want)) {
+		if wait != want[i]*time.Millisecond {
+			t.Fatalf("attempt %d: wait %v, want %v", i+1, wait, want[i]*time.Millisecond)
+		}
+	}
+	if attempt, _, ok := rs.Next(); ok || attempt != 4 {
+		t.Fatalf("exhausted: attempt %d ok %v", attempt, ok)
+	}
+	rs.Reset()
+	if _, _, ok := rs.Next(); !ok {
+		t.Fatal("reset did not restore the attempts")
+	}
+}
+
+func TestBackoffRetryFullJitter(t *testing.T) {
+	rs := newBackoffRetry(sdk_struct.ReconnectConfig{Base: 100, Cap: 1000, Jitter: JitterFull}, 1)
+	spread := make(map[time.Duration]bool)
+	limit := 100 * time.Millisecond
+	for i, wait := range waits(t, rs, 50) {
+		if wait < 0 || wait > limit {
+			t.Fatalf("attempt %d: wait %v out of [0, %v]", i+1, wait, limit)
+		}
+		spread[wait] = true
+		limit = min(limit*2, time.Second)
+	}
+	if len(spread) < 40 {
+		t.Fatalf("full jitter waits are not spread: %d distinct", len(spread))
+	}
+}
+
+func TestBackoffRetryDecorrelatedJitter(t *testing.T) {
+	rs := newBackoffRetry(sdk_struct.ReconnectConfig{Base: 100, Cap: 1000, Jitter: JitterDecorrelated}, 1)
+	prev := 100 * time.Millisecond
+	for i, wait := range waits(t, rs, 50) {
+		limit := min(prev*2, time.Second)
+		if i == 0 {
+			limit = 100 * time.Millisecond
+		}
+		if wait < 100*time.Millisecond || wait > limit {
+			t.Fatalf("attempt %d: wait %v out of [100ms, %v]", i+1, wait, limit)
+		}
+		prev = wait
+	}
+}
+
+func TestBackoffRetrySeed(t *testing.T) {
+	conf := sdk_struct.ReconnectConfig{Jitter: JitterFull}
+	a, b := waits(t, newBackoffRetry(conf, 1), 10), waits(t, newBackoffRetry(conf, 2), 10)
+	for i := range a {
+		if a[i] != b[i] {
+			return
+		}
+	}
+	t.Fatal("clients with different seeds wait in lockstep")
+}
+
+type reconnectListener struct {
+	open_im_sdk_callback.OnConnListener
+	delays chan int64
+}
+
+func (l *reconnectListener) OnReconnecting(attempt int32, delayMs int64) {
+	l.delays <- delayMs
+}
+
+func TestWaitReconnectNetworkChange(t *testing.T) {
+	listener := &reconnectListener{delays: make(chan int64, 10)}
+	c := &LongConnMgr{
+		listener:          listener,
+		reconnectStrategy: newBackoffRetry(sdk_struct.ReconnectConfig{Base: 60000, MaxAttempts: 1}, 1),
+		reconnectNow:      make(chan struct{}, 1),
+	}
+	ctx := context.Background()
+	for _, want := range []int64{60000, -1} {
+		done := make(chan struct{})
+		go func() {
+			c.waitReconnect(ctx)
+			close(done)
+		}()
+		if delay := <-listener.delays; delay != want {
+			t.Fatalf("delay %d, want %d", delay, want)
+		}
+		c.Close(ctx)
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("network change did not cut the wait short")
+		}
+		if want == 60000 {
+			// the network change reset the attempts, fail again to exhaust them
+			c.reconnectStrategy.Next()
+		}
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+
+package interaction
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// A tcp frame is a 4 byte big endian payload length, a 1 byte message type and the payload.
+// Message types are shared with the websocket transport, plus handshakeMessage which carries
+// the dial url query to the gateway and its json reply back.
+const (
+	tcpFrameHeaderSize = 5
+	handshakeMessage   = 16
+
+	tcpScheme = "tcp"
+	tlsScheme = "tls"
+)
+
+var (
+	ErrTcpConnClosed    = errors.New("tcp connection closed by peer")
+	ErrTcpFrameTooLarge = errors.New("tcp frame exceeds read limit")
+)
+
+// tcpHandshakeResp is the gateway reply to the handshake. A non zero ErrCode rejects the
+// connection, Header carries the negotiated options such as the codec.
+type tcpHandshakeResp struct {
+	ErrCode int               `json:"errCode"`
+	ErrMsg  string            `json:"errMsg"`
+	ErrDlt  string            `json:"errDlt"`
+	Header  map[string]string `json:"header,omitempty"`
+}
+
+type TcpConn struct {
+	ConnType    int
+	conn        net.Conn
+	reader      *bufio.Reader
+	tlsConfig   *tls.Config
+	readLimit   int64
+	pingHandler PingPongHandler
+	pongHandler PingPongHandler
+	writeMutex  sync.Mutex
+}
+
+// NewTcpConn returns a length prefixed tcp transport. tlsConfig is used for tls:// addresses,
+// nil verifies the gateway against the system roots.
+func NewTcpConn(connType int, tlsConfig *tls.Config) *TcpConn {
+	return &TcpConn{ConnType: connType, tlsConfig: tlsConfig}
+}
+
+// NewLongConn returns the long connection for the configured transport, websocket by default.
+func NewLongConn(_ context.Context, transport string) LongConn {
+	if transport == TcpTransport {
+		return NewTcpConn(Tcp, nil)
+	}
+	return NewWebSocket(WebSocket)
+}
+
+func (t *TcpConn) Close() error {
+	return t.conn.Close()
+}
+
+func (t *TcpConn) WriteMessage(messageType int, message []byte) error {
+	t.writeMutex.Lock()
+	defer t.writeMutex.Unlock()
+	return writeTcpFrame(t.conn, messageType, message)
+}
+
+// ReadMessage returns the next data message. Ping and pong frames are handed to their
+// handlers on the way, a ping is answered with a pong when no handler is set.
+func (t *TcpConn) ReadMessage() (int, []byte, error) {
+	for {
+		messageType, message, err := readTcpFrame(t.reader, t.readLimit)
+		if err != nil {
+			return 0, nil, err
+		}
+		switch messageType {
+		case PingMessage:
+			if t.pingHandler != nil {
+				err = t.pingHandler(string(message))
+			} else {
+				err = t.WriteMessage(PongMessage, message)
+			}
+			if err != nil {
+				return 0, nil, err
+			}
+		case PongMessage:
+			if t.pongHandler != nil {
+				if err := t.pongHandler(string(message)); err != nil {
+					return 0, nil, err
+				}
+			}
+		case CloseMessage:
+			return 0, nil, ErrTcpConnClosed
+		default:
+			return messageType, message, nil
+		}
+	}
+}
+
+func (t *TcpConn) SetReadDeadline(timeout time.Duration) error {
+	return t.conn.SetReadDeadline(time.Now().Add(timeout))
+}
+
+func (t *TcpConn) SetWriteDeadline(timeout time.Duration) error {
+	return t.conn.SetWriteDeadline(time.Now().Add(timeout))
+}
+
+// Dial connects to a tcp:// or tls:// address and performs the handshake. The returned response
+// mirrors a websocket upgrade: the gateway header on success, the json error body on rejection.
+func (t *TcpConn) Dial(urlStr string, _ http.Header) (*http.Response, error) {
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{Timeout: writeWait}
+	var conn net.Conn
+	switch u.Scheme {
+	case tcpScheme:
+		conn, err = dialer.Dial("tcp", u.Host)
+	case tlsScheme:
+		config := t.tlsConfig
+		if config == nil {
+			config = &tls.Config{ServerName: u.Hostname()}
+		}
+		conn, err = tls.DialWithDialer(dialer, "tcp", u.Host, config)
+	default:
+		return nil, fmt.Errorf("unsupported tcp url scheme %q", u.Scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+	reader := bufio.NewReader(conn)
+	_ = conn.SetDeadline(time.Now().Add(writeWait))
+	if err := writeTcpFrame(conn, handshakeMessage, []byte(u.RequestURI())); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	messageType, body, err := readTcpFrame(reader, maxMessageSize)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if messageType != handshakeMessage {
+		_ = conn.Close()
+		return nil, fmt.Errorf("unexpected tcp handshake message type %d", messageType)
+	}
+	_ = conn.SetDeadline(time.Time{})
+	var handshake tcpHandshakeResp
+	if err := json.Unmarshal(body, &handshake); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	resp := &http.Response{
+		StatusCode: http.StatusSwitchingProtocols,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}
+	for k, v := range handshake.Header {
+		resp.Header.Set(k, v)
+	}
+	if handshake.ErrCode != 0 {
+		_ = conn.Close()
+		resp.StatusCode = http.StatusUnauthorized
+		return resp, fmt.Errorf("tcp handshake rejected: %d %s", handshake.ErrCode, handshake.ErrMsg)
+	}
+	t.conn = conn
+	t.reader = reader
+	return resp, nil
+}
+
+func (t *TcpConn) IsNil() bool {
+	return t.conn == nil
+}
+
+func (t *TcpConn) SetReadLimit(limit int64) {
+	t.readLimit = limit
+}
+
+func (t *TcpConn) SetPingHandler(handler PingPongHandler) {
+	t.pingHandler = handler
+}
+
+func (t *TcpConn) SetPongHandler(handler PingPongHandler) {
+	t.pongHandler = handler
+}
+
+func (t *TcpConn) LocalAddr() string {
+	return t.conn.LocalAddr().String()
+}
+
+func writeTcpFrame(w io.Writer, messageType int, message []byte) error {
+	buf := make([]byte, tcpFrameHeaderSize+len(message))
+	binary.BigEndian.PutUint32(buf, uint32(len(message)))
+	buf[4] = byte(messageType)
+	copy(buf[tcpFrameHeaderSize:], message)
+	_, err := w.Write(buf)
+	return err
+}
+
+func readTcpFrame(r io.Reader, limit int64) (int, []byte, error) {
+	var header [tcpFrameHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, err
+	}
+	length := binary.BigEndian.Uint32(header[:4])
+	if limit > 0 && int64(length) > limit {
+		return 0, nil, ErrTcpFrameTooLarge
+	}
+	message := make([]byte, length)
+	if _, err := io.ReadFull(r, message); err != nil {
+		return 0, nil, err
+	}
+	return int(header[4]), message, nil
+}
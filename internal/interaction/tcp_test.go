@@ -0,0 +1,219 @@
+//go:build !js
+
+package interaction
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// echoGateway is an in-process tcp gateway. It checks the handshake token, confirms the requested
+// codec, echoes data frames, answers pings and pings the client once after the handshake.
+type echoGateway struct {
+	listener net.Listener
+	pongs    chan string
+}
+
+func newEchoGateway(t *testing.T, config *tls.Config) *echoGateway {
+	var (
+		listener net.Listener
+		err      error
+	)
+	if config != nil {
+		listener, err = tls.Listen("tcp", "127.0.0.1:0", config)
+	} else {
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := &echoGateway{listener: listener, pongs: make(chan string, 4)}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go g.serve(conn)
+		}
+	}()
+	return g
+}
+
+func (g *echoGateway) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	messageType, uri, err := readTcpFrame(reader, maxMessageSize)
+	if err != nil || messageType != handshakeMessage {
+		return
+	}
+	u, err := url.ParseRequestURI(string(uri))
+	if err != nil {
+		return
+	}
+	var resp tcpHandshakeResp
+	if u.Query().Get("token") != "token" {
+		resp = tcpHandshakeResp{ErrCode: 1501, ErrMsg: "token invalid"}
+	} else if codec := u.Query().Get("codec"); codec != "" {
+		resp.Header = map[string]string{codecHeader: codec}
+	}
+	data, _ := json.Marshal(resp)
+	if err := writeTcpFrame(conn, handshakeMessage, data); err != nil || resp.ErrCode != 0 {
+		return
+	}
+	if err := writeTcpFrame(conn, PingMessage, []byte("server ping")); err != nil {
+		return
+	}
+	for {
+		messageType, message, err := readTcpFrame(reader, maxMessageSize)
+		if err != nil {
+			return
+		}
+		switch messageType {
+		case PingMessage:
+			err = writeTcpFrame(conn, PongMessage, message)
+		case PongMessage:
+			g.pongs <- string(message)
+		case CloseMessage:
+			return
+		default:
+			err = writeTcpFrame(conn, messageType, message)
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (g *echoGateway) addr(scheme string) string {
+	return scheme + "://" + g.listener.Addr().String() + "/?sendID=sendID&token=token&codec=json"
+}
+
+func testTcpConn(t *testing.T, gateway *echoGateway, conn *TcpConn, scheme string) {
+	resp, err := conn.Dial(gateway.addr(scheme), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if codec := resp.Header.Get(codecHeader); codec != JsonCodec {
+		t.Fatalf("handshake codec %q, want %q", codec, JsonCodec)
+	}
+	pings := make(chan string, 1)
+	pongs := make(chan string, 1)
+	conn.SetPingHandler(func(appData string) error {
+		pings <- appData
+		return conn.WriteMessage(PongMessage, []byte(appData))
+	})
+	conn.SetPongHandler(func(appData string) error {
+		pongs <- appData
+		return nil
+	})
+	conn.SetReadLimit(maxMessageSize)
+	if err := conn.SetReadDeadline(time.Second * 5); err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.WriteMessage(PingMessage, []byte("client ping")); err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.WriteMessage(MessageBinary, []byte("hello gateway")); err != nil {
+		t.Fatal(err)
+	}
+	messageType, message, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if messageType != MessageBinary || string(message) != "hello gateway" {
+		t.Fatalf("echo %d %q", messageType, message)
+	}
+	if appData := <-pings; appData != "server ping" {
+		t.Fatalf("ping handler got %q", appData)
+	}
+	if appData := <-pongs; appData != "client ping" {
+		t.Fatalf("pong handler got %q", appData)
+	}
+	if appData := <-gateway.pongs; appData != "server ping" {
+		t.Fatalf("gateway got pong %q", appData)
+	}
+}
+
+func TestTcpConn(t *testing.T) {
+	gateway := newEchoGateway(t, nil)
+	testTcpConn(t, gateway, NewTcpConn(Tcp, nil), tcpScheme)
+}
+
+func TestTcpConnTLS(t *testing.T) {
+	cert, pool := selfSignedCert(t)
+	gateway := newEchoGateway(t, &tls.Config{Certificates: []tls.Certificate{cert}})
+	testTcpConn(t, gateway, NewTcpConn(Tcp, &tls.Config{RootCAs: pool, ServerName: "127.0.0.1"}), tlsScheme)
+}
+
+func TestTcpConnHandshakeRejected(t *testing.T) {
+	gateway := newEchoGateway(t, nil)
+	conn := NewTcpConn(Tcp, nil)
+	resp, err := conn.Dial(strings.Replace(gateway.addr(tcpScheme), "token=token", "token=expired", 1), nil)
+	if err == nil {
+		t.Fatal("expected handshake error")
+	}
+	if resp == nil {
+		t.Fatal("expected handshake response")
+	}
+	body, _ := io.ReadAll(resp.Body)
+	var handshake tcpHandshakeResp
+	if err := json.Unmarshal(body, &handshake); err != nil || handshake.ErrCode != 1501 {
+		t.Fatalf("handshake body %s", body)
+	}
+	if !conn.IsNil() {
+		t.Fatal("rejected conn must stay nil")
+	}
+}
+
+func TestTcpFrameReadLimit(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+	go writeTcpFrame(server, MessageBinary, make([]byte, 64))
+	if _, _, err := readTcpFrame(client, 32); err != ErrTcpFrameTooLarge {
+		t.Fatalf("read limit error %v", err)
+	}
+}
+
+func selfSignedCert(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "gateway"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(leaf)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, pool
+}
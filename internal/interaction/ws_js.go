@@ -62,6 +62,15 @@ func (w *JSWebSocket) LocalAddr() string {
 	return ""
 }
 
+// NewLongConn returns the long connection for the configured transport. Browsers cannot open
+// raw tcp sockets, so the tcp transport falls back to websocket.
+func NewLongConn(ctx context.Context, transport string) LongConn {
+	if transport == TcpTransport {
+		log.ZWarn(ctx, "tcp transport is not supported in js, use websocket", nil)
+	}
+	return NewWebSocket(WebSocket)
+}
+
 func NewWebSocket(connType int) *JSWebSocket {
 	return &JSWebSocket{ConnType: connType}
 }
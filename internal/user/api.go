@@ -5,7 +5,6 @@ import (
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/common"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
-	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
 	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
 	"github.com/openimsdk/protocol/sdkws"
 	userPb "github.com/openimsdk/protocol/user"
@@ -42,7 +41,7 @@ func (u *User) UserOnlineStatusChange(users map[string][]int32) {
 		} else {
 			status.Status = constant.Online
 		}
-		u.listener().OnUserStatusChanged(utils.StructToJsonString(&status))
+		u.listener.OnUserStatusChanged(&status)
 	}
 }
 
@@ -90,6 +89,26 @@ func (u *User) ProcessUserCommandUpdate(ctx context.Context, userCommand *userPb
 	return u.SyncAllCommand(ctx)
 }
 
+// ProcessUserCommands applies several command changes on the server and syncs the commands once.
+func (u *User) ProcessUserCommands(ctx context.Context, adds []*userPb.ProcessUserCommandAddReq, updates []*userPb.ProcessUserCommandUpdateReq, deletes []*userPb.ProcessUserCommandDeleteReq) error {
+	for _, add := range adds {
+		if err := u.processUserCommandAdd(ctx, &userPb.ProcessUserCommandAddReq{UserID: u.loginUserID, Type: add.Type, Uuid: add.Uuid, Value: add.Value}); err != nil {
+			return err
+		}
+	}
+	for _, update := range updates {
+		if err := u.processUserCommandUpdate(ctx, &userPb.ProcessUserCommandUpdateReq{UserID: u.loginUserID, Type: update.Type, Uuid: update.Uuid, Value: update.Value}); err != nil {
+			return err
+		}
+	}
+	for _, del := range deletes {
+		if err := u.processUserCommandDelete(ctx, &userPb.ProcessUserCommandDeleteReq{UserID: u.loginUserID, Type: del.Type, Uuid: del.Uuid}); err != nil {
+			return err
+		}
+	}
+	return u.SyncAllCommand(ctx)
+}
+
 func (u *User) GetUsersInfo(ctx context.Context, userIDs []string) ([]*sdk_struct.PublicUser, error) {
 	usersInfo, err := u.GetUsersInfoWithCache(ctx, userIDs)
 	if err != nil {
@@ -55,6 +55,9 @@ func (u *User) userInfoUpdatedNotification(ctx context.Context, msg *sdkws.MsgDa
 // userCommandAddNotification handle notification when user add favorite
 func (u *User) userCommandAddNotification(ctx context.Context, msg *sdkws.MsgData) error {
 	tip := sdkws.UserCommandAddTips{}
+	if err := utils.UnmarshalNotificationElem(msg.Content, &tip); err != nil {
+		return err
+	}
 	if tip.ToUserID == u.loginUserID {
 		err := u.SyncAllCommand(ctx)
 		if err != nil {
@@ -69,6 +72,9 @@ func (u *User) userCommandAddNotification(ctx context.Context, msg *sdkws.MsgDat
 // userCommandDeleteNotification handle notification when user delete favorite
 func (u *User) userCommandDeleteNotification(ctx context.Context, msg *sdkws.MsgData) error {
 	tip := sdkws.UserCommandDeleteTips{}
+	if err := utils.UnmarshalNotificationElem(msg.Content, &tip); err != nil {
+		return err
+	}
 	if tip.ToUserID == u.loginUserID {
 		err := u.SyncAllCommand(ctx)
 		if err != nil {
@@ -83,6 +89,9 @@ func (u *User) userCommandDeleteNotification(ctx context.Context, msg *sdkws.Msg
 // userCommandUpdateNotification handle notification when user update favorite
 func (u *User) userCommandUpdateNotification(ctx context.Context, msg *sdkws.MsgData) error {
 	tip := sdkws.UserCommandUpdateTips{}
+	if err := utils.UnmarshalNotificationElem(msg.Content, &tip); err != nil {
+		return err
+	}
 	if tip.ToUserID == u.loginUserID {
 		err := u.SyncAllCommand(ctx)
 		if err != nil {
@@ -28,7 +28,6 @@ import (
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/db_interface"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/syncer"
-	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
 	"github.com/openimsdk/tools/utils/datautil"
 )
 
@@ -50,7 +49,7 @@ func NewUser(dataBase db_interface.DataBase, loginUserID string, conversationCh
 type User struct {
 	db_interface.DataBase
 	loginUserID    string
-	listener       func() open_im_sdk_callback.OnUserListener
+	listener       open_im_sdk_callback.OnUserListenerSdk
 	userSyncer     *syncer.Syncer[*model_struct.LocalUser, syncer.NoResp, string]
 	commandSyncer  *syncer.Syncer[*model_struct.LocalUserCommand, syncer.NoResp, string]
 	conversationCh chan common.Cmd2Value
@@ -61,7 +60,7 @@ type User struct {
 
 // SetListener sets the user's listener.
 func (u *User) SetListener(listener func() open_im_sdk_callback.OnUserListener) {
-	u.listener = listener
+	u.listener = open_im_sdk_callback.NewOnUserListenerSdk(listener)
 }
 
 func (u *User) initSyncer() {
@@ -83,7 +82,7 @@ func (u *User) initSyncer() {
 		func(ctx context.Context, state int, server, local *model_struct.LocalUser) error {
 			switch state {
 			case syncer.Update:
-				u.listener().OnSelfInfoUpdated(utils.StructToJsonString(server))
+				u.listener.OnSelfInfoUpdated(*server)
 				if server.Nickname != local.Nickname || server.FaceURL != local.FaceURL {
 					_ = common.TriggerCmdUpdateMessage(ctx, common.UpdateMessageNode{Action: constant.UpdateMsgFaceUrlAndNickName,
 						Args: common.UpdateMessageInfo{SessionType: constant.SingleChatType, UserID: server.UserID, FaceURL: server.FaceURL, Nickname: server.Nickname}}, u.conversationCh)
@@ -128,11 +127,11 @@ func (u *User) initSyncer() {
 			}
 			switch state {
 			case syncer.Delete:
-				u.listener().OnUserCommandDelete(utils.StructToJsonString(serverCommand))
+				u.listener.OnUserCommandDelete(*serverCommand)
 			case syncer.Update:
-				u.listener().OnUserCommandUpdate(utils.StructToJsonString(serverCommand))
+				u.listener.OnUserCommandUpdate(*serverCommand)
 			case syncer.Insert:
-				u.listener().OnUserCommandAdd(utils.StructToJsonString(serverCommand))
+				u.listener.OnUserCommandAdd(*serverCommand)
 			}
 			return nil
 		},
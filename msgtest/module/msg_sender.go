@@ -330,9 +330,10 @@ func (c *ConnListener) OnConnectSuccess() {}
 func (c *ConnListener) OnConnectFailed(errCode int32, errMsg string) {
 	// log.ZError(context.Background(), "connect failed", nil, "errCode", errCode, "errMsg", errMsg)
 }
-func (c *ConnListener) OnKickedOffline()                 {}
-func (c *ConnListener) OnUserTokenExpired()              {}
-func (c *ConnListener) OnUserTokenInvalid(errMsg string) {}
+func (c *ConnListener) OnKickedOffline()                            {}
+func (c *ConnListener) OnUserTokenExpired()                         {}
+func (c *ConnListener) OnUserTokenInvalid(errMsg string)            {}
+func (c *ConnListener) OnReconnecting(attempt int32, delayMs int64) {}
 
 type UserListener struct{}
 
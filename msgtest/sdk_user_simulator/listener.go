@@ -21,6 +21,9 @@ func (c *conversationCallBack) OnConversationChanged(conversationList string) {
 func (c *conversationCallBack) OnTotalUnreadMessageCountChanged(totalUnreadCount int32) {
 }
 
+func (c *conversationCallBack) OnUnreadMentionCountChanged(totalUnreadCount int32) {
+}
+
 func (c *conversationCallBack) OnRecvMessageExtensionsChanged(msgID string, reactionExtensionList string) {
 }
 
@@ -100,6 +103,15 @@ func (m *MsgListenerCallBak) OnRecvC2CReadReceipt(msgReceiptList string) {
 func (m *MsgListenerCallBak) OnMsgDeleted(s string) {}
 func (m *MsgListenerCallBak) OnMsgEdited(s string)  {}
 
+func (m *MsgListenerCallBak) OnScheduledMessageSent(message string) {}
+
+func (m *MsgListenerCallBak) OnScheduledMessageFailed(message string, errCode int32, errMsg string) {}
+
+func (m *MsgListenerCallBak) OnOutboxMessageStatusChanged(message string, status int32, attempts int32) {
+}
+
+func (m *MsgListenerCallBak) OnMsgPinChanged(pinChange string) {}
+
 func (m *MsgListenerCallBak) OnRecvOfflineNewMessage(message string) {
 }
 
@@ -232,6 +244,10 @@ func (t *testConnListener) OnKickedOffline() {
 
 }
 
+func (t *testConnListener) OnReconnecting(attempt int32, delayMs int64) {
+
+}
+
 func (t *testConnListener) OnSelfInfoUpdated(info string) {
 
 }
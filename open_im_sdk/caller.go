@@ -89,17 +89,17 @@ func setNumeric(in interface{}, out interface{}) {
 	}
 }
 
-func call_(operationID string, fn any, args ...any) (res any, err error) {
+func call_(mgr *LoginMgr, operationID string, fn any, args ...any) (res any, err error) {
 	t := time.Now()
 	funcPtr := reflect.ValueOf(fn).Pointer()
 	funcName := runtime.FuncForPC(funcPtr).Name()
 	if operationID == "" {
 		return nil, sdkerrs.ErrArgs.WrapMsg("call function operationID is empty")
 	}
-	if err := CheckResourceLoad(UserForSDK, funcName); err != nil {
+	if err := CheckResourceLoad(mgr, funcName); err != nil {
 		return nil, sdkerrs.ErrResourceLoad.WrapMsg("not load resource")
 	}
-	ctx := ccontext.WithOperationID(UserForSDK.Context(), operationID)
+	ctx := ccontext.WithOperationID(mgr.Context(), operationID)
 
 	defer func(start time.Time) {
 		if r := recover(); r != nil {
@@ -216,13 +216,13 @@ func call_(operationID string, fn any, args ...any) (res any, err error) {
 	return val, nil
 }
 
-func call(callback open_im_sdk_callback.Base, operationID string, fn any, args ...any) {
+func call(mgr *LoginMgr, callback open_im_sdk_callback.Base, operationID string, fn any, args ...any) {
 	if callback == nil {
 		log.ZWarn(context.Background(), "callback is nil", nil)
 		return
 	}
 	go func() {
-		res, err := call_(operationID, fn, args...)
+		res, err := call_(mgr, operationID, fn, args...)
 		if err != nil {
 			if code, ok := errs.Unwrap(err).(errs.CodeError); ok {
 				callback.OnError(int32(code.Code()), err.Error())
@@ -240,7 +240,7 @@ func call(callback open_im_sdk_callback.Base, operationID string, fn any, args .
 	}()
 }
 
-func syncCall(operationID string, fn any, args ...any) (res string) {
+func syncCall(mgr *LoginMgr, operationID string, fn any, args ...any) (res string) {
 	err := error(nil)
 	if operationID == "" {
 		return ""
@@ -252,7 +252,7 @@ func syncCall(operationID string, fn any, args ...any) (res string) {
 	}
 	funcPtr := reflect.ValueOf(fn).Pointer()
 	funcName := runtime.FuncForPC(funcPtr).Name()
-	if err = CheckResourceLoad(UserForSDK, funcName); err != nil {
+	if err = CheckResourceLoad(mgr, funcName); err != nil {
 		return ""
 	}
 	fnt := fnv.Type()
@@ -263,7 +263,7 @@ func syncCall(operationID string, fn any, args ...any) (res string) {
 	}
 	ins := make([]reflect.Value, 0, numIn)
 
-	ctx := ccontext.WithOperationID(UserForSDK.Context(), operationID)
+	ctx := ccontext.WithOperationID(mgr.Context(), operationID)
 	t := time.Now()
 	defer func(start time.Time) {
 		if r := recover(); r != nil {
@@ -360,14 +360,14 @@ func syncCall(operationID string, fn any, args ...any) (res string) {
 	}
 	return string(jsonData)
 }
-func messageCall(callback open_im_sdk_callback.SendMsgCallBack, operationID string, fn any, args ...any) {
+func messageCall(mgr *LoginMgr, callback open_im_sdk_callback.SendMsgCallBack, operationID string, fn any, args ...any) {
 	if callback == nil {
 		log.ZWarn(context.Background(), "callback is nil", nil)
 		return
 	}
-	go messageCall_(callback, operationID, fn, args...)
+	go messageCall_(mgr, callback, operationID, fn, args...)
 }
-func messageCall_(callback open_im_sdk_callback.SendMsgCallBack, operationID string, fn any, args ...any) {
+func messageCall_(mgr *LoginMgr, callback open_im_sdk_callback.SendMsgCallBack, operationID string, fn any, args ...any) {
 	defer func() {
 		if r := recover(); r != nil {
 			fmt.Println(" panic err:", r, string(debug.Stack()))
@@ -379,7 +379,7 @@ func messageCall_(callback open_im_sdk_callback.SendMsgCallBack, operationID str
 		callback.OnError(sdkerrs.ArgsError, sdkerrs.ErrArgs.WrapMsg("operationID is empty").Error())
 		return
 	}
-	if err := CheckResourceLoad(UserForSDK, ""); err != nil {
+	if err := CheckResourceLoad(mgr, ""); err != nil {
 		callback.OnError(sdkerrs.ResourceLoadNotCompleteError, "resource load error: "+err.Error())
 		return
 	}
@@ -397,7 +397,7 @@ func messageCall_(callback open_im_sdk_callback.SendMsgCallBack, operationID str
 
 	t := time.Now()
 	ins := make([]reflect.Value, 0, numIn)
-	ctx := ccontext.WithOperationID(UserForSDK.Context(), operationID)
+	ctx := ccontext.WithOperationID(mgr.Context(), operationID)
 	ctx = ccontext.WithSendMessageCallback(ctx, callback)
 	funcPtr := reflect.ValueOf(fn).Pointer()
 	funcName := runtime.FuncForPC(funcPtr).Name()
@@ -483,10 +483,10 @@ func messageCall_(callback open_im_sdk_callback.SendMsgCallBack, operationID str
 	callback.OnSuccess(string(jsonData))
 }
 
-func listenerCall(fn any, listener any) {
+func listenerCall(mgr *LoginMgr, fn any, listener any) {
 	ctx := context.Background()
-	if UserForSDK == nil {
-		log.ZWarn(ctx, "UserForSDK is nil,set listener is invalid", nil)
+	if mgr == nil {
+		log.ZWarn(ctx, "LoginMgr is nil,set listener is invalid", nil)
 		return
 	}
 	fnv := reflect.ValueOf(fn)
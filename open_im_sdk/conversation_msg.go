@@ -20,179 +20,279 @@ import (
 	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
 )
 
-func GetAllConversationList(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.Conversation().GetAllConversationList)
+func (i *Instance) GetAllConversationList(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetAllConversationList)
 }
 
-func GetConversationListSplit(callback open_im_sdk_callback.Base, operationID string, offset int, count int) {
-	call(callback, operationID, UserForSDK.Conversation().GetConversationListSplit, offset, count)
+func (i *Instance) GetConversationListSplit(callback open_im_sdk_callback.Base, operationID string, offset int, count int) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetConversationListSplit, offset, count)
 }
 
-func GetOneConversation(callback open_im_sdk_callback.Base, operationID string, sessionType int32, sourceID string) {
-	call(callback, operationID, UserForSDK.Conversation().GetOneConversation, sessionType, sourceID)
+func (i *Instance) CreateConversationLabel(callback open_im_sdk_callback.Base, operationID string, name string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().CreateConversationLabel, name)
 }
 
-func GetMultipleConversation(callback open_im_sdk_callback.Base, operationID string, conversationIDList string) {
-	call(callback, operationID, UserForSDK.Conversation().GetMultipleConversation, conversationIDList)
+func (i *Instance) RenameConversationLabel(callback open_im_sdk_callback.Base, operationID string, labelID, name string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().RenameConversationLabel, labelID, name)
 }
 
-func SetConversation(callback open_im_sdk_callback.Base, operationID string, conversationID string, req string) {
-	call(callback, operationID, UserForSDK.Conversation().SetConversation, conversationID, req)
+func (i *Instance) DeleteConversationLabel(callback open_im_sdk_callback.Base, operationID string, labelID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().DeleteConversationLabel, labelID)
 }
 
-func HideConversation(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
-	call(callback, operationID, UserForSDK.Conversation().HideConversation, conversationID)
+func (i *Instance) ReorderConversationLabels(callback open_im_sdk_callback.Base, operationID string, labelIDs string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().ReorderConversationLabels, labelIDs)
 }
 
-func SetConversationDraft(callback open_im_sdk_callback.Base, operationID string, conversationID string, draftText string) {
-	call(callback, operationID, UserForSDK.Conversation().SetConversationDraft, conversationID, draftText)
+func (i *Instance) AddConversationsToLabel(callback open_im_sdk_callback.Base, operationID string, labelID string, conversationIDs string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().AddConversationsToLabel, labelID, conversationIDs)
 }
 
-func GetTotalUnreadMsgCount(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.Conversation().GetTotalUnreadMsgCount)
+func (i *Instance) RemoveConversationsFromLabel(callback open_im_sdk_callback.Base, operationID string, labelID string, conversationIDs string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().RemoveConversationsFromLabel, labelID, conversationIDs)
 }
-func GetAtAllTag(operationID string) string {
-	return syncCall(operationID, UserForSDK.Conversation().GetAtAllTag)
 
+func (i *Instance) GetConversationLabels(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetConversationLabels)
 }
-func CreateAdvancedTextMessage(operationID string, text, messageEntityList string) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateAdvancedTextMessage, text, messageEntityList)
+
+func (i *Instance) GetConversationListByLabel(callback open_im_sdk_callback.Base, operationID string, labelID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetConversationListByLabel, labelID)
+}
+
+func (i *Instance) GetOneConversation(callback open_im_sdk_callback.Base, operationID string, sessionType int32, sourceID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetOneConversation, sessionType, sourceID)
+}
+
+func (i *Instance) GetMultipleConversation(callback open_im_sdk_callback.Base, operationID string, conversationIDList string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetMultipleConversation, conversationIDList)
+}
+
+func (i *Instance) SetConversation(callback open_im_sdk_callback.Base, operationID string, conversationID string, req string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().SetConversation, conversationID, req)
+}
+
+func (i *Instance) HideConversation(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().HideConversation, conversationID)
+}
+
+func (i *Instance) SetConversationDraft(callback open_im_sdk_callback.Base, operationID string, conversationID string, draftText string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().SetConversationDraft, conversationID, draftText)
+}
+
+func (i *Instance) GetTotalUnreadMsgCount(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetTotalUnreadMsgCount)
+}
+func (i *Instance) GetAtAllTag(operationID string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().GetAtAllTag)
+
+}
+func (i *Instance) CreateAdvancedTextMessage(operationID string, text, messageEntityList string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateAdvancedTextMessage, text, messageEntityList)
+}
+func (i *Instance) CreateTextAtMessage(operationID string, text, atUserList, atUsersInfo, message string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateTextAtMessage, text, atUserList, atUsersInfo, message)
+}
+func (i *Instance) CreateTextMessage(operationID string, text string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateTextMessage, text)
+}
+
+func (i *Instance) CreateLocationMessage(operationID string, description string, longitude, latitude float64) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateLocationMessage, description, longitude, latitude)
+}
+func (i *Instance) CreateCustomMessage(operationID string, data, extension string, description string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateCustomMessage, data, extension, description)
+}
+func (i *Instance) CreateQuoteMessage(operationID string, text string, message string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateQuoteMessage, text, message)
+}
+func (i *Instance) CreateAdvancedQuoteMessage(operationID string, text string, message, messageEntityList string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateAdvancedQuoteMessage, text, message, messageEntityList)
+}
+func (i *Instance) CreateCardMessage(operationID string, cardInfo string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateCardMessage, cardInfo)
+
 }
-func CreateTextAtMessage(operationID string, text, atUserList, atUsersInfo, message string) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateTextAtMessage, text, atUserList, atUsersInfo, message)
+func (i *Instance) CreateImageMessage(operationID string, imageSourcePath string, sourcePicture, bigPicture, snapshotPicture string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateImageMessage, imageSourcePath, sourcePicture, bigPicture, snapshotPicture)
 }
-func CreateTextMessage(operationID string, text string) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateTextMessage, text)
+func (i *Instance) CreateSoundMessage(operationID string, soundPath string, duration int64, soundBaseInfo string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateSoundMessage, soundPath, duration, soundBaseInfo)
+}
+func (i *Instance) CreateVideoMessage(operationID string, videoSourcePath string, videoType string, duration int64, snapshotSourcePath string, videoBaseInfo string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateVideoMessage, videoSourcePath, videoType, duration, snapshotSourcePath, videoBaseInfo)
+}
+func (i *Instance) CreateFileMessage(operationID string, fileSourcePath string, fileName string, fileBaseInfo string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateFileMessage, fileSourcePath, fileName, fileBaseInfo)
+}
+func (i *Instance) CreateMergerMessage(operationID string, messageList, title, summaryList string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateMergerMessage, messageList, title, summaryList)
+}
+func (i *Instance) CreateFaceMessage(operationID string, index int, data string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateFaceMessage, index, data)
+}
+func (i *Instance) CreateForwardMessage(operationID string, m string) string {
+	return syncCall(i.mgr, operationID, i.mgr.Conversation().CreateForwardMessage, m)
+}
+func (i *Instance) GetConversationIDBySessionType(operationID string, sourceID string, sessionType int) string {
+	return i.mgr.Conversation().GetConversationIDBySessionType(context.Background(), sourceID, sessionType)
+}
+func (i *Instance) SendMessage(callback open_im_sdk_callback.SendMsgCallBack, operationID, message, recvID, groupID, offlinePushInfo string, isOnlineOnly bool) {
+	messageCall(i.mgr, callback, operationID, i.mgr.Conversation().SendMessage, message, recvID, groupID, offlinePushInfo, isOnlineOnly)
 }
 
-func CreateLocationMessage(operationID string, description string, longitude, latitude float64) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateLocationMessage, description, longitude, latitude)
+func (i *Instance) FindMessageList(callback open_im_sdk_callback.Base, operationID string, findMessageOptions string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().FindMessageList, findMessageOptions)
 }
-func CreateCustomMessage(operationID string, data, extension string, description string) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateCustomMessage, data, extension, description)
+
+func (i *Instance) GetAdvancedHistoryMessageList(callback open_im_sdk_callback.Base, operationID string, getMessageOptions string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetAdvancedHistoryMessageList, getMessageOptions)
 }
-func CreateQuoteMessage(operationID string, text string, message string) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateQuoteMessage, text, message)
+
+func (i *Instance) GetThreadMessages(callback open_im_sdk_callback.Base, operationID string, getThreadOptions string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetThreadMessages, getThreadOptions)
 }
-func CreateAdvancedQuoteMessage(operationID string, text string, message, messageEntityList string) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateAdvancedQuoteMessage, text, message, messageEntityList)
+
+func (i *Instance) GetAdvancedHistoryMessageListReverse(callback open_im_sdk_callback.Base, operationID string, getMessageOptions string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetAdvancedHistoryMessageListReverse, getMessageOptions)
 }
-func CreateCardMessage(operationID string, cardInfo string) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateCardMessage, cardInfo)
 
+func (i *Instance) RevokeMessage(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().RevokeMessage, conversationID, clientMsgID)
 }
-func CreateImageMessage(operationID string, imageSourcePath string, sourcePicture, bigPicture, snapshotPicture string) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateImageMessage, imageSourcePath, sourcePicture, bigPicture, snapshotPicture)
+
+func (i *Instance) ScheduleMessage(callback open_im_sdk_callback.Base, operationID string, message, recvID, groupID string, sendAt int64, offlinePushInfo string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().ScheduleMessage, message, recvID, groupID, sendAt, offlinePushInfo)
 }
-func CreateSoundMessage(operationID string, soundPath string, duration int64, soundBaseInfo string) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateSoundMessage, soundPath, duration, soundBaseInfo)
+
+func (i *Instance) CancelScheduledMessage(callback open_im_sdk_callback.Base, operationID string, clientMsgID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().CancelScheduledMessage, clientMsgID)
 }
-func CreateVideoMessage(operationID string, videoSourcePath string, videoType string, duration int64, snapshotSourcePath string, videoBaseInfo string) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateVideoMessage, videoSourcePath, videoType, duration, snapshotSourcePath, videoBaseInfo)
+
+func (i *Instance) GetScheduledMessages(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetScheduledMessages)
 }
-func CreateFileMessage(operationID string, fileSourcePath string, fileName string, fileBaseInfo string) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateFileMessage, fileSourcePath, fileName, fileBaseInfo)
+
+func (i *Instance) ExportConversations(callback open_im_sdk_callback.Base, operationID string, conversationIDs string, path string, withMedia bool, progress open_im_sdk_callback.ArchiveProgress) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().ExportConversations, conversationIDs, path, withMedia, progress)
+}
+
+func (i *Instance) ImportArchive(callback open_im_sdk_callback.Base, operationID string, path string, progress open_im_sdk_callback.ArchiveProgress) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().ImportArchive, path, progress)
+}
+
+func (i *Instance) EditMessage(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID, newContent string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().EditMessage, conversationID, clientMsgID, newContent)
+}
+
+func (i *Instance) AddMessageReaction(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID string, reactionType int, info string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().AddMessageReaction, conversationID, clientMsgID, reactionType, info)
 }
-func CreateMergerMessage(operationID string, messageList, title, summaryList string) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateMergerMessage, messageList, title, summaryList)
+
+func (i *Instance) RemoveMessageReaction(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID string, reactionType int) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().RemoveMessageReaction, conversationID, clientMsgID, reactionType)
 }
-func CreateFaceMessage(operationID string, index int, data string) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateFaceMessage, index, data)
+
+func (i *Instance) GetMessageReactions(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgIDs string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetMessageReactions, conversationID, clientMsgIDs)
 }
-func CreateForwardMessage(operationID string, m string) string {
-	return syncCall(operationID, UserForSDK.Conversation().CreateForwardMessage, m)
+
+func (i *Instance) PinMessage(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().PinMessage, conversationID, clientMsgID)
 }
-func GetConversationIDBySessionType(operationID string, sourceID string, sessionType int) string {
-	return UserForSDK.Conversation().GetConversationIDBySessionType(context.Background(), sourceID, sessionType)
+
+func (i *Instance) UnpinMessage(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().UnpinMessage, conversationID, clientMsgID)
 }
-func SendMessage(callback open_im_sdk_callback.SendMsgCallBack, operationID, message, recvID, groupID, offlinePushInfo string, isOnlineOnly bool) {
-	messageCall(callback, operationID, UserForSDK.Conversation().SendMessage, message, recvID, groupID, offlinePushInfo, isOnlineOnly)
+
+func (i *Instance) GetPinnedMessages(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetPinnedMessages, conversationID)
 }
 
-func FindMessageList(callback open_im_sdk_callback.Base, operationID string, findMessageOptions string) {
-	call(callback, operationID, UserForSDK.Conversation().FindMessageList, findMessageOptions)
+func (i *Instance) GetMentionMessages(callback open_im_sdk_callback.Base, operationID string, getMentionOptions string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetMentionMessages, getMentionOptions)
 }
 
-func GetAdvancedHistoryMessageList(callback open_im_sdk_callback.Base, operationID string, getMessageOptions string) {
-	call(callback, operationID, UserForSDK.Conversation().GetAdvancedHistoryMessageList, getMessageOptions)
+func (i *Instance) MarkMentionsRead(callback open_im_sdk_callback.Base, operationID string, conversationID string, clientMsgIDs string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().MarkMentionsRead, conversationID, clientMsgIDs)
 }
 
-func GetAdvancedHistoryMessageListReverse(callback open_im_sdk_callback.Base, operationID string, getMessageOptions string) {
-	call(callback, operationID, UserForSDK.Conversation().GetAdvancedHistoryMessageListReverse, getMessageOptions)
+func (i *Instance) GetUnreadMentionCount(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetUnreadMentionCount)
 }
 
-func RevokeMessage(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID string) {
-	call(callback, operationID, UserForSDK.Conversation().RevokeMessage, conversationID, clientMsgID)
+func (i *Instance) GetGroupMessageReaderList(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID string, filter, offset, count int32) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetGroupMessageReaderList, conversationID, clientMsgID, filter, offset, count)
 }
 
-func TypingStatusUpdate(callback open_im_sdk_callback.Base, operationID string, recvID string, msgTip string) {
-	call(callback, operationID, UserForSDK.Conversation().TypingStatusUpdate, recvID, msgTip)
+func (i *Instance) TypingStatusUpdate(callback open_im_sdk_callback.Base, operationID string, recvID string, msgTip string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().TypingStatusUpdate, recvID, msgTip)
 }
 
 // mark as read
-func MarkConversationMessageAsRead(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
-	call(callback, operationID, UserForSDK.Conversation().MarkConversationMessageAsRead, conversationID)
+func (i *Instance) MarkConversationMessageAsRead(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().MarkConversationMessageAsRead, conversationID)
 }
 
-func MarkAllConversationMessageAsRead(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.Conversation().MarkAllConversationMessageAsRead)
+func (i *Instance) MarkAllConversationMessageAsRead(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().MarkAllConversationMessageAsRead)
 }
 
-func MarkMessagesAsReadByMsgID(callback open_im_sdk_callback.Base, operationID string, conversationID string, clientMsgIDs string) {
-	call(callback, operationID, UserForSDK.Conversation().MarkMessagesAsReadByMsgID, conversationID, clientMsgIDs)
+func (i *Instance) MarkMessagesAsReadByMsgID(callback open_im_sdk_callback.Base, operationID string, conversationID string, clientMsgIDs string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().MarkMessagesAsReadByMsgID, conversationID, clientMsgIDs)
 }
 
-func DeleteMessageFromLocalStorage(callback open_im_sdk_callback.Base, operationID string, conversationID string, clientMsgID string) {
-	call(callback, operationID, UserForSDK.Conversation().DeleteMessageFromLocalStorage, conversationID, clientMsgID)
+func (i *Instance) DeleteMessageFromLocalStorage(callback open_im_sdk_callback.Base, operationID string, conversationID string, clientMsgID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().DeleteMessageFromLocalStorage, conversationID, clientMsgID)
 }
 
-func DeleteMessage(callback open_im_sdk_callback.Base, operationID string, conversationID string, clientMsgID string) {
-	call(callback, operationID, UserForSDK.Conversation().DeleteMessage, conversationID, clientMsgID)
+func (i *Instance) DeleteMessage(callback open_im_sdk_callback.Base, operationID string, conversationID string, clientMsgID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().DeleteMessage, conversationID, clientMsgID)
 }
 
-func HideAllConversations(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.Conversation().HideAllConversations)
+func (i *Instance) HideAllConversations(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().HideAllConversations)
 }
 
-func DeleteAllMsgFromLocalAndSvr(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.Conversation().DeleteAllMsgFromLocalAndServer)
+func (i *Instance) DeleteAllMsgFromLocalAndSvr(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().DeleteAllMsgFromLocalAndServer)
 }
 
-func DeleteAllMsgFromLocal(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.Conversation().DeleteAllMessageFromLocalStorage)
+func (i *Instance) DeleteAllMsgFromLocal(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().DeleteAllMessageFromLocalStorage)
 }
 
-func ClearConversationAndDeleteAllMsg(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
-	call(callback, operationID, UserForSDK.Conversation().ClearConversationAndDeleteAllMsg, conversationID)
+func (i *Instance) ClearConversationAndDeleteAllMsg(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().ClearConversationAndDeleteAllMsg, conversationID)
 }
 
-func DeleteConversationAndDeleteAllMsg(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
-	call(callback, operationID, UserForSDK.Conversation().DeleteConversationAndDeleteAllMsg, conversationID)
+func (i *Instance) DeleteConversationAndDeleteAllMsg(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().DeleteConversationAndDeleteAllMsg, conversationID)
 }
 
-func InsertSingleMessageToLocalStorage(callback open_im_sdk_callback.Base, operationID string, message string, recvID string, sendID string) {
-	call(callback, operationID, UserForSDK.Conversation().InsertSingleMessageToLocalStorage, message, recvID, sendID)
+func (i *Instance) InsertSingleMessageToLocalStorage(callback open_im_sdk_callback.Base, operationID string, message string, recvID string, sendID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().InsertSingleMessageToLocalStorage, message, recvID, sendID)
 }
 
-func InsertGroupMessageToLocalStorage(callback open_im_sdk_callback.Base, operationID string, message string, groupID string, sendID string) {
-	call(callback, operationID, UserForSDK.Conversation().InsertGroupMessageToLocalStorage, message, groupID, sendID)
+func (i *Instance) InsertGroupMessageToLocalStorage(callback open_im_sdk_callback.Base, operationID string, message string, groupID string, sendID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().InsertGroupMessageToLocalStorage, message, groupID, sendID)
 }
 
-func SearchLocalMessages(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
-	call(callback, operationID, UserForSDK.Conversation().SearchLocalMessages, searchParam)
+func (i *Instance) SearchLocalMessages(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().SearchLocalMessages, searchParam)
 }
-func SetMessageLocalEx(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID, localEx string) {
-	call(callback, operationID, UserForSDK.Conversation().SetMessageLocalEx, conversationID, clientMsgID, localEx)
+func (i *Instance) SetMessageLocalEx(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID, localEx string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().SetMessageLocalEx, conversationID, clientMsgID, localEx)
 }
 
-func SearchConversation(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
-	call(callback, operationID, UserForSDK.Conversation().SearchConversation, searchParam)
+func (i *Instance) SearchConversation(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().SearchConversation, searchParam)
 }
 
-func ChangeInputStates(callback open_im_sdk_callback.Base, operationID string, conversationID string, focus bool) {
-	call(callback, operationID, UserForSDK.Conversation().ChangeInputStates, conversationID, focus)
+func (i *Instance) ChangeInputStates(callback open_im_sdk_callback.Base, operationID string, conversationID string, focus bool) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().ChangeInputStates, conversationID, focus)
 }
 
-func GetInputStates(callback open_im_sdk_callback.Base, operationID string, conversationID string, userID string) {
-	call(callback, operationID, UserForSDK.Conversation().GetInputStates, conversationID, userID)
+func (i *Instance) GetInputStates(callback open_im_sdk_callback.Base, operationID string, conversationID string, userID string) {
+	call(i.mgr, callback, operationID, i.mgr.Conversation().GetInputStates, conversationID, userID)
 }
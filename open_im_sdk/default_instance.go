@@ -0,0 +1,609 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package open_im_sdk
+
+import (
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+)
+
+// The functions below call the default instance, see Instance for the other accounts.
+
+func Login(callback open_im_sdk_callback.Base, operationID string, userID, token string) {
+	Default().Login(callback, operationID, userID, token)
+}
+
+func Logout(callback open_im_sdk_callback.Base, operationID string) {
+	Default().Logout(callback, operationID)
+}
+
+func SetAppBackgroundStatus(callback open_im_sdk_callback.Base, operationID string, isBackground bool) {
+	Default().SetAppBackgroundStatus(callback, operationID, isBackground)
+}
+
+func NetworkStatusChanged(callback open_im_sdk_callback.Base, operationID string) {
+	Default().NetworkStatusChanged(callback, operationID)
+}
+
+// RekeyDatabase re-encrypts the local database of the logged in user with newKey,
+// an empty key decrypts it.
+func RekeyDatabase(callback open_im_sdk_callback.Base, operationID string, newKey string) {
+	Default().RekeyDatabase(callback, operationID, newKey)
+}
+
+func SetDBKeyProvider(provider open_im_sdk_callback.DBKeyProvider) {
+	Default().SetDBKeyProvider(provider)
+}
+
+func GetLoginStatus(operationID string) int {
+	return Default().GetLoginStatus(operationID)
+}
+
+func GetLoginUserID() string {
+	return Default().GetLoginUserID()
+}
+
+func GetAllConversationList(callback open_im_sdk_callback.Base, operationID string) {
+	Default().GetAllConversationList(callback, operationID)
+}
+
+func GetConversationListSplit(callback open_im_sdk_callback.Base, operationID string, offset int, count int) {
+	Default().GetConversationListSplit(callback, operationID, offset, count)
+}
+
+func CreateConversationLabel(callback open_im_sdk_callback.Base, operationID string, name string) {
+	Default().CreateConversationLabel(callback, operationID, name)
+}
+
+func RenameConversationLabel(callback open_im_sdk_callback.Base, operationID string, labelID, name string) {
+	Default().RenameConversationLabel(callback, operationID, labelID, name)
+}
+
+func DeleteConversationLabel(callback open_im_sdk_callback.Base, operationID string, labelID string) {
+	Default().DeleteConversationLabel(callback, operationID, labelID)
+}
+
+func ReorderConversationLabels(callback open_im_sdk_callback.Base, operationID string, labelIDs string) {
+	Default().ReorderConversationLabels(callback, operationID, labelIDs)
+}
+
+func AddConversationsToLabel(callback open_im_sdk_callback.Base, operationID string, labelID string, conversationIDs string) {
+	Default().AddConversationsToLabel(callback, operationID, labelID, conversationIDs)
+}
+
+func RemoveConversationsFromLabel(callback open_im_sdk_callback.Base, operationID string, labelID string, conversationIDs string) {
+	Default().RemoveConversationsFromLabel(callback, operationID, labelID, conversationIDs)
+}
+
+func GetConversationLabels(callback open_im_sdk_callback.Base, operationID string) {
+	Default().GetConversationLabels(callback, operationID)
+}
+
+func GetConversationListByLabel(callback open_im_sdk_callback.Base, operationID string, labelID string) {
+	Default().GetConversationListByLabel(callback, operationID, labelID)
+}
+
+func GetOneConversation(callback open_im_sdk_callback.Base, operationID string, sessionType int32, sourceID string) {
+	Default().GetOneConversation(callback, operationID, sessionType, sourceID)
+}
+
+func GetMultipleConversation(callback open_im_sdk_callback.Base, operationID string, conversationIDList string) {
+	Default().GetMultipleConversation(callback, operationID, conversationIDList)
+}
+
+func SetConversation(callback open_im_sdk_callback.Base, operationID string, conversationID string, req string) {
+	Default().SetConversation(callback, operationID, conversationID, req)
+}
+
+func HideConversation(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
+	Default().HideConversation(callback, operationID, conversationID)
+}
+
+func SetConversationDraft(callback open_im_sdk_callback.Base, operationID string, conversationID string, draftText string) {
+	Default().SetConversationDraft(callback, operationID, conversationID, draftText)
+}
+
+func GetTotalUnreadMsgCount(callback open_im_sdk_callback.Base, operationID string) {
+	Default().GetTotalUnreadMsgCount(callback, operationID)
+}
+
+func GetAtAllTag(operationID string) string {
+	return Default().GetAtAllTag(operationID)
+}
+
+func CreateAdvancedTextMessage(operationID string, text, messageEntityList string) string {
+	return Default().CreateAdvancedTextMessage(operationID, text, messageEntityList)
+}
+
+func CreateTextAtMessage(operationID string, text, atUserList, atUsersInfo, message string) string {
+	return Default().CreateTextAtMessage(operationID, text, atUserList, atUsersInfo, message)
+}
+
+func CreateTextMessage(operationID string, text string) string {
+	return Default().CreateTextMessage(operationID, text)
+}
+
+func CreateLocationMessage(operationID string, description string, longitude, latitude float64) string {
+	return Default().CreateLocationMessage(operationID, description, longitude, latitude)
+}
+
+func CreateCustomMessage(operationID string, data, extension string, description string) string {
+	return Default().CreateCustomMessage(operationID, data, extension, description)
+}
+
+func CreateQuoteMessage(operationID string, text string, message string) string {
+	return Default().CreateQuoteMessage(operationID, text, message)
+}
+
+func CreateAdvancedQuoteMessage(operationID string, text string, message, messageEntityList string) string {
+	return Default().CreateAdvancedQuoteMessage(operationID, text, message, messageEntityList)
+}
+
+func CreateCardMessage(operationID string, cardInfo string) string {
+	return Default().CreateCardMessage(operationID, cardInfo)
+}
+
+func CreateImageMessage(operationID string, imageSourcePath string, sourcePicture, bigPicture, snapshotPicture string) string {
+	return Default().CreateImageMessage(operationID, imageSourcePath, sourcePicture, bigPicture, snapshotPicture)
+}
+
+func CreateSoundMessage(operationID string, soundPath string, duration int64, soundBaseInfo string) string {
+	return Default().CreateSoundMessage(operationID, soundPath, duration, soundBaseInfo)
+}
+
+func CreateVideoMessage(operationID string, videoSourcePath string, videoType string, duration int64, snapshotSourcePath string, videoBaseInfo string) string {
+	return Default().CreateVideoMessage(operationID, videoSourcePath, videoType, duration, snapshotSourcePath, videoBaseInfo)
+}
+
+func CreateFileMessage(operationID string, fileSourcePath string, fileName string, fileBaseInfo string) string {
+	return Default().CreateFileMessage(operationID, fileSourcePath, fileName, fileBaseInfo)
+}
+
+func CreateMergerMessage(operationID string, messageList, title, summaryList string) string {
+	return Default().CreateMergerMessage(operationID, messageList, title, summaryList)
+}
+
+func CreateFaceMessage(operationID string, index int, data string) string {
+	return Default().CreateFaceMessage(operationID, index, data)
+}
+
+func CreateForwardMessage(operationID string, m string) string {
+	return Default().CreateForwardMessage(operationID, m)
+}
+
+func GetConversationIDBySessionType(operationID string, sourceID string, sessionType int) string {
+	return Default().GetConversationIDBySessionType(operationID, sourceID, sessionType)
+}
+
+func SendMessage(callback open_im_sdk_callback.SendMsgCallBack, operationID, message, recvID, groupID, offlinePushInfo string, isOnlineOnly bool) {
+	Default().SendMessage(callback, operationID, message, recvID, groupID, offlinePushInfo, isOnlineOnly)
+}
+
+func FindMessageList(callback open_im_sdk_callback.Base, operationID string, findMessageOptions string) {
+	Default().FindMessageList(callback, operationID, findMessageOptions)
+}
+
+func GetAdvancedHistoryMessageList(callback open_im_sdk_callback.Base, operationID string, getMessageOptions string) {
+	Default().GetAdvancedHistoryMessageList(callback, operationID, getMessageOptions)
+}
+
+func GetThreadMessages(callback open_im_sdk_callback.Base, operationID string, getThreadOptions string) {
+	Default().GetThreadMessages(callback, operationID, getThreadOptions)
+}
+
+func GetAdvancedHistoryMessageListReverse(callback open_im_sdk_callback.Base, operationID string, getMessageOptions string) {
+	Default().GetAdvancedHistoryMessageListReverse(callback, operationID, getMessageOptions)
+}
+
+func RevokeMessage(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID string) {
+	Default().RevokeMessage(callback, operationID, conversationID, clientMsgID)
+}
+
+func ScheduleMessage(callback open_im_sdk_callback.Base, operationID string, message, recvID, groupID string, sendAt int64, offlinePushInfo string) {
+	Default().ScheduleMessage(callback, operationID, message, recvID, groupID, sendAt, offlinePushInfo)
+}
+
+func CancelScheduledMessage(callback open_im_sdk_callback.Base, operationID string, clientMsgID string) {
+	Default().CancelScheduledMessage(callback, operationID, clientMsgID)
+}
+
+func GetScheduledMessages(callback open_im_sdk_callback.Base, operationID string) {
+	Default().GetScheduledMessages(callback, operationID)
+}
+
+func ExportConversations(callback open_im_sdk_callback.Base, operationID string, conversationIDs string, path string, withMedia bool, progress open_im_sdk_callback.ArchiveProgress) {
+	Default().ExportConversations(callback, operationID, conversationIDs, path, withMedia, progress)
+}
+
+func ImportArchive(callback open_im_sdk_callback.Base, operationID string, path string, progress open_im_sdk_callback.ArchiveProgress) {
+	Default().ImportArchive(callback, operationID, path, progress)
+}
+
+func EditMessage(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID, newContent string) {
+	Default().EditMessage(callback, operationID, conversationID, clientMsgID, newContent)
+}
+
+func AddMessageReaction(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID string, reactionType int, info string) {
+	Default().AddMessageReaction(callback, operationID, conversationID, clientMsgID, reactionType, info)
+}
+
+func RemoveMessageReaction(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID string, reactionType int) {
+	Default().RemoveMessageReaction(callback, operationID, conversationID, clientMsgID, reactionType)
+}
+
+func GetMessageReactions(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgIDs string) {
+	Default().GetMessageReactions(callback, operationID, conversationID, clientMsgIDs)
+}
+
+func PinMessage(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID string) {
+	Default().PinMessage(callback, operationID, conversationID, clientMsgID)
+}
+
+func UnpinMessage(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID string) {
+	Default().UnpinMessage(callback, operationID, conversationID, clientMsgID)
+}
+
+func GetPinnedMessages(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
+	Default().GetPinnedMessages(callback, operationID, conversationID)
+}
+
+func GetMentionMessages(callback open_im_sdk_callback.Base, operationID string, getMentionOptions string) {
+	Default().GetMentionMessages(callback, operationID, getMentionOptions)
+}
+
+func MarkMentionsRead(callback open_im_sdk_callback.Base, operationID string, conversationID string, clientMsgIDs string) {
+	Default().MarkMentionsRead(callback, operationID, conversationID, clientMsgIDs)
+}
+
+func GetUnreadMentionCount(callback open_im_sdk_callback.Base, operationID string) {
+	Default().GetUnreadMentionCount(callback, operationID)
+}
+
+func GetGroupMessageReaderList(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID string, filter, offset, count int32) {
+	Default().GetGroupMessageReaderList(callback, operationID, conversationID, clientMsgID, filter, offset, count)
+}
+
+func TypingStatusUpdate(callback open_im_sdk_callback.Base, operationID string, recvID string, msgTip string) {
+	Default().TypingStatusUpdate(callback, operationID, recvID, msgTip)
+}
+
+// mark as read
+func MarkConversationMessageAsRead(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
+	Default().MarkConversationMessageAsRead(callback, operationID, conversationID)
+}
+
+func MarkAllConversationMessageAsRead(callback open_im_sdk_callback.Base, operationID string) {
+	Default().MarkAllConversationMessageAsRead(callback, operationID)
+}
+
+func MarkMessagesAsReadByMsgID(callback open_im_sdk_callback.Base, operationID string, conversationID string, clientMsgIDs string) {
+	Default().MarkMessagesAsReadByMsgID(callback, operationID, conversationID, clientMsgIDs)
+}
+
+func DeleteMessageFromLocalStorage(callback open_im_sdk_callback.Base, operationID string, conversationID string, clientMsgID string) {
+	Default().DeleteMessageFromLocalStorage(callback, operationID, conversationID, clientMsgID)
+}
+
+func DeleteMessage(callback open_im_sdk_callback.Base, operationID string, conversationID string, clientMsgID string) {
+	Default().DeleteMessage(callback, operationID, conversationID, clientMsgID)
+}
+
+func HideAllConversations(callback open_im_sdk_callback.Base, operationID string) {
+	Default().HideAllConversations(callback, operationID)
+}
+
+func DeleteAllMsgFromLocalAndSvr(callback open_im_sdk_callback.Base, operationID string) {
+	Default().DeleteAllMsgFromLocalAndSvr(callback, operationID)
+}
+
+func DeleteAllMsgFromLocal(callback open_im_sdk_callback.Base, operationID string) {
+	Default().DeleteAllMsgFromLocal(callback, operationID)
+}
+
+func ClearConversationAndDeleteAllMsg(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
+	Default().ClearConversationAndDeleteAllMsg(callback, operationID, conversationID)
+}
+
+func DeleteConversationAndDeleteAllMsg(callback open_im_sdk_callback.Base, operationID string, conversationID string) {
+	Default().DeleteConversationAndDeleteAllMsg(callback, operationID, conversationID)
+}
+
+func InsertSingleMessageToLocalStorage(callback open_im_sdk_callback.Base, operationID string, message string, recvID string, sendID string) {
+	Default().InsertSingleMessageToLocalStorage(callback, operationID, message, recvID, sendID)
+}
+
+func InsertGroupMessageToLocalStorage(callback open_im_sdk_callback.Base, operationID string, message string, groupID string, sendID string) {
+	Default().InsertGroupMessageToLocalStorage(callback, operationID, message, groupID, sendID)
+}
+
+func SearchLocalMessages(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
+	Default().SearchLocalMessages(callback, operationID, searchParam)
+}
+
+func SetMessageLocalEx(callback open_im_sdk_callback.Base, operationID string, conversationID, clientMsgID, localEx string) {
+	Default().SetMessageLocalEx(callback, operationID, conversationID, clientMsgID, localEx)
+}
+
+func SearchConversation(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
+	Default().SearchConversation(callback, operationID, searchParam)
+}
+
+func ChangeInputStates(callback open_im_sdk_callback.Base, operationID string, conversationID string, focus bool) {
+	Default().ChangeInputStates(callback, operationID, conversationID, focus)
+}
+
+func GetInputStates(callback open_im_sdk_callback.Base, operationID string, conversationID string, userID string) {
+	Default().GetInputStates(callback, operationID, conversationID, userID)
+}
+
+func CreateGroup(callback open_im_sdk_callback.Base, operationID string, groupReqInfo string) {
+	Default().CreateGroup(callback, operationID, groupReqInfo)
+}
+
+func JoinGroup(callback open_im_sdk_callback.Base, operationID string, groupID string, reqMsg string, joinSource int32, ex string) {
+	Default().JoinGroup(callback, operationID, groupID, reqMsg, joinSource, ex)
+}
+
+func QuitGroup(callback open_im_sdk_callback.Base, operationID string, groupID string) {
+	Default().QuitGroup(callback, operationID, groupID)
+}
+
+func DismissGroup(callback open_im_sdk_callback.Base, operationID string, groupID string) {
+	Default().DismissGroup(callback, operationID, groupID)
+}
+
+func ChangeGroupMute(callback open_im_sdk_callback.Base, operationID string, groupID string, isMute bool) {
+	Default().ChangeGroupMute(callback, operationID, groupID, isMute)
+}
+
+func ChangeGroupMemberMute(callback open_im_sdk_callback.Base, operationID string, groupID string, userID string, mutedSeconds int) {
+	Default().ChangeGroupMemberMute(callback, operationID, groupID, userID, mutedSeconds)
+}
+
+func TransferGroupOwner(callback open_im_sdk_callback.Base, operationID string, groupID string, newOwnerUserID string) {
+	Default().TransferGroupOwner(callback, operationID, groupID, newOwnerUserID)
+}
+
+func KickGroupMember(callback open_im_sdk_callback.Base, operationID string, groupID string, reason string, userIDList string) {
+	Default().KickGroupMember(callback, operationID, groupID, reason, userIDList)
+}
+
+func SetGroupInfo(callback open_im_sdk_callback.Base, operationID string, groupInfo string) {
+	Default().SetGroupInfo(callback, operationID, groupInfo)
+}
+
+func SetGroupMemberInfo(callback open_im_sdk_callback.Base, operationID string, groupMemberInfo string) {
+	Default().SetGroupMemberInfo(callback, operationID, groupMemberInfo)
+}
+
+func GetJoinedGroupList(callback open_im_sdk_callback.Base, operationID string) {
+	Default().GetJoinedGroupList(callback, operationID)
+}
+
+func GetJoinedGroupListPage(callback open_im_sdk_callback.Base, operationID string, offset, count int32) {
+	Default().GetJoinedGroupListPage(callback, operationID, offset, count)
+}
+
+func GetSpecifiedGroupsInfo(callback open_im_sdk_callback.Base, operationID string, groupIDList string) {
+	Default().GetSpecifiedGroupsInfo(callback, operationID, groupIDList)
+}
+
+func SearchGroups(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
+	Default().SearchGroups(callback, operationID, searchParam)
+}
+
+func GetGroupMemberOwnerAndAdmin(callback open_im_sdk_callback.Base, operationID string, groupID string) {
+	Default().GetGroupMemberOwnerAndAdmin(callback, operationID, groupID)
+}
+
+func GetGroupMemberListByJoinTimeFilter(callback open_im_sdk_callback.Base, operationID string, groupID string, offset int32, count int32, joinTimeBegin int64, joinTimeEnd int64, filterUserIDList string) {
+	Default().GetGroupMemberListByJoinTimeFilter(callback, operationID, groupID, offset, count, joinTimeBegin, joinTimeEnd, filterUserIDList)
+}
+
+func GetSpecifiedGroupMembersInfo(callback open_im_sdk_callback.Base, operationID string, groupID string, userIDList string) {
+	Default().GetSpecifiedGroupMembersInfo(callback, operationID, groupID, userIDList)
+}
+
+func GetGroupMemberList(callback open_im_sdk_callback.Base, operationID string, groupID string, filter int32, offset int32, count int32) {
+	Default().GetGroupMemberList(callback, operationID, groupID, filter, offset, count)
+}
+
+func GetGroupApplicationListAsRecipient(callback open_im_sdk_callback.Base, operationID string) {
+	Default().GetGroupApplicationListAsRecipient(callback, operationID)
+}
+
+func GetGroupApplicationListAsApplicant(callback open_im_sdk_callback.Base, operationID string) {
+	Default().GetGroupApplicationListAsApplicant(callback, operationID)
+}
+
+func SearchGroupMembers(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
+	Default().SearchGroupMembers(callback, operationID, searchParam)
+}
+
+func IsJoinGroup(callback open_im_sdk_callback.Base, operationID string, groupID string) {
+	Default().IsJoinGroup(callback, operationID, groupID)
+}
+
+func GetUsersInGroup(callback open_im_sdk_callback.Base, operationID string, groupID, userIDList string) {
+	Default().GetUsersInGroup(callback, operationID, groupID, userIDList)
+}
+
+func InviteUserToGroup(callback open_im_sdk_callback.Base, operationID string, groupID string, reason string, userIDList string) {
+	Default().InviteUserToGroup(callback, operationID, groupID, reason, userIDList)
+}
+
+func AcceptGroupApplication(callback open_im_sdk_callback.Base, operationID string, groupID string, fromUserID string, handleMsg string) {
+	Default().AcceptGroupApplication(callback, operationID, groupID, fromUserID, handleMsg)
+}
+
+func RefuseGroupApplication(callback open_im_sdk_callback.Base, operationID string, groupID string, fromUserID string, handleMsg string) {
+	Default().RefuseGroupApplication(callback, operationID, groupID, fromUserID, handleMsg)
+}
+
+func GetSpecifiedFriendsInfo(callback open_im_sdk_callback.Base, operationID string, userIDList string, filterBlack bool) {
+	Default().GetSpecifiedFriendsInfo(callback, operationID, userIDList, filterBlack)
+}
+
+func GetFriendList(callback open_im_sdk_callback.Base, operationID string, filterBlack bool) {
+	Default().GetFriendList(callback, operationID, filterBlack)
+}
+
+func GetFriendListPage(callback open_im_sdk_callback.Base, operationID string, offset int32, count int32, filterBlack bool) {
+	Default().GetFriendListPage(callback, operationID, offset, count, filterBlack)
+}
+
+func SearchFriends(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
+	Default().SearchFriends(callback, operationID, searchParam)
+}
+
+func CheckFriend(callback open_im_sdk_callback.Base, operationID string, userIDList string) {
+	Default().CheckFriend(callback, operationID, userIDList)
+}
+
+func AddFriend(callback open_im_sdk_callback.Base, operationID string, userIDReqMsg string) {
+	Default().AddFriend(callback, operationID, userIDReqMsg)
+}
+
+func UpdateFriends(callback open_im_sdk_callback.Base, operationID string, req string) {
+	Default().UpdateFriends(callback, operationID, req)
+}
+
+func DeleteFriend(callback open_im_sdk_callback.Base, operationID string, friendUserID string) {
+	Default().DeleteFriend(callback, operationID, friendUserID)
+}
+
+func GetFriendApplicationListAsRecipient(callback open_im_sdk_callback.Base, operationID string) {
+	Default().GetFriendApplicationListAsRecipient(callback, operationID)
+}
+
+func GetFriendApplicationListAsApplicant(callback open_im_sdk_callback.Base, operationID string) {
+	Default().GetFriendApplicationListAsApplicant(callback, operationID)
+}
+
+func AcceptFriendApplication(callback open_im_sdk_callback.Base, operationID string, userIDHandleMsg string) {
+	Default().AcceptFriendApplication(callback, operationID, userIDHandleMsg)
+}
+
+func RefuseFriendApplication(callback open_im_sdk_callback.Base, operationID string, userIDHandleMsg string) {
+	Default().RefuseFriendApplication(callback, operationID, userIDHandleMsg)
+}
+
+func AddBlack(callback open_im_sdk_callback.Base, operationID string, blackUserID string, ex string) {
+	Default().AddBlack(callback, operationID, blackUserID, ex)
+}
+
+func GetBlackList(callback open_im_sdk_callback.Base, operationID string) {
+	Default().GetBlackList(callback, operationID)
+}
+
+func RemoveBlack(callback open_im_sdk_callback.Base, operationID string, removeUserID string) {
+	Default().RemoveBlack(callback, operationID, removeUserID)
+}
+
+func GetUsersInfo(callback open_im_sdk_callback.Base, operationID string, userIDs string) {
+	Default().GetUsersInfo(callback, operationID, userIDs)
+}
+
+// SetSelfInfo sets the user's own information.
+func SetSelfInfo(callback open_im_sdk_callback.Base, operationID string, userInfo string) {
+	Default().SetSelfInfo(callback, operationID, userInfo)
+}
+
+// GetSelfUserInfo obtains the user's own information.
+func GetSelfUserInfo(callback open_im_sdk_callback.Base, operationID string) {
+	Default().GetSelfUserInfo(callback, operationID)
+}
+
+// AddUserCommand add to user's favorite
+func AddUserCommand(callback open_im_sdk_callback.Base, operationID string, Type int32, uuid string, value string) {
+	Default().AddUserCommand(callback, operationID, Type, uuid, value)
+}
+
+// DeleteUserCommand delete from user's favorite
+func DeleteUserCommand(callback open_im_sdk_callback.Base, operationID string, Type int32, uuid string) {
+	Default().DeleteUserCommand(callback, operationID, Type, uuid)
+}
+
+// GetAllUserCommands get user's favorite
+func GetAllUserCommands(callback open_im_sdk_callback.Base, operationID string, Type int32) {
+	Default().GetAllUserCommands(callback, operationID, Type)
+}
+
+// SubscribeUsersStatus Presence status of subscribed users.
+func SubscribeUsersStatus(callback open_im_sdk_callback.Base, operationID string, userIDs string) {
+	Default().SubscribeUsersStatus(callback, operationID, userIDs)
+}
+
+// UnsubscribeUsersStatus Unsubscribe a user's presence.
+func UnsubscribeUsersStatus(callback open_im_sdk_callback.Base, operationID string, userIDs string) {
+	Default().UnsubscribeUsersStatus(callback, operationID, userIDs)
+}
+
+// GetSubscribeUsersStatus Get the online status of subscribers.
+func GetSubscribeUsersStatus(callback open_im_sdk_callback.Base, operationID string) {
+	Default().GetSubscribeUsersStatus(callback, operationID)
+}
+
+// GetUserStatus Get the online status of users.
+func GetUserStatus(callback open_im_sdk_callback.Base, operationID string, userIDs string) {
+	Default().GetUserStatus(callback, operationID, userIDs)
+}
+
+func UpdateFcmToken(callback open_im_sdk_callback.Base, operationID, fcmToken string, expireTime int64) {
+	Default().UpdateFcmToken(callback, operationID, fcmToken, expireTime)
+}
+
+func SetAppBadge(callback open_im_sdk_callback.Base, operationID string, appUnreadCount int32) {
+	Default().SetAppBadge(callback, operationID, appUnreadCount)
+}
+
+func UploadLogs(callback open_im_sdk_callback.Base, operationID string, line int, ex string, progress open_im_sdk_callback.UploadLogProgress) {
+	Default().UploadLogs(callback, operationID, line, ex, progress)
+}
+
+func Logs(callback open_im_sdk_callback.Base, operationID string, logLevel int, file string, line int, msgs string, err string, keyAndValue string) {
+	Default().Logs(callback, operationID, logLevel, file, line, msgs, err, keyAndValue)
+}
+
+func UploadFile(callback open_im_sdk_callback.Base, operationID string, req string, progress open_im_sdk_callback.UploadFileCallback) {
+	Default().UploadFile(callback, operationID, req, progress)
+}
+
+func SetGroupListener(listener open_im_sdk_callback.OnGroupListener) {
+	Default().SetGroupListener(listener)
+}
+
+func SetConversationListener(listener open_im_sdk_callback.OnConversationListener) {
+	Default().SetConversationListener(listener)
+}
+
+func SetAdvancedMsgListener(listener open_im_sdk_callback.OnAdvancedMsgListener) {
+	Default().SetAdvancedMsgListener(listener)
+}
+
+func SetUserListener(listener open_im_sdk_callback.OnUserListener) {
+	Default().SetUserListener(listener)
+}
+
+func SetFriendListener(listener open_im_sdk_callback.OnFriendshipListener) {
+	Default().SetFriendListener(listener)
+}
+
+func SetCustomBusinessListener(listener open_im_sdk_callback.OnCustomBusinessListener) {
+	Default().SetCustomBusinessListener(listener)
+}
+
+func SetMessageKvInfoListener(listener open_im_sdk_callback.OnMessageKvInfoListener) {
+	Default().SetMessageKvInfoListener(listener)
+}
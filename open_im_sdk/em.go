@@ -183,6 +183,11 @@ func (e *emptyConversationListener) OnConversationUserInputStatusChanged(change
 
 }
 
+func (e *emptyConversationListener) OnUnreadMentionCountChanged(totalUnreadCount int32) {
+	log.ZWarn(e.ctx, "ConversationListener is not implemented", nil,
+		"totalUnreadMentionCount", totalUnreadCount)
+}
+
 type emptyAdvancedMsgListener struct {
 	ctx context.Context
 }
@@ -218,6 +223,22 @@ func (e *emptyAdvancedMsgListener) OnMsgEdited(msg string) {
 	log.ZWarn(e.ctx, "OnMsgEdited is not implemented", nil, "msg", msg)
 }
 
+func (e *emptyAdvancedMsgListener) OnScheduledMessageSent(message string) {
+	log.ZWarn(e.ctx, "OnScheduledMessageSent is not implemented", nil, "message", message)
+}
+
+func (e *emptyAdvancedMsgListener) OnScheduledMessageFailed(message string, errCode int32, errMsg string) {
+	log.ZWarn(e.ctx, "OnScheduledMessageFailed is not implemented", nil, "message", message, "errCode", errCode, "errMsg", errMsg)
+}
+
+func (e *emptyAdvancedMsgListener) OnOutboxMessageStatusChanged(message string, status int32, attempts int32) {
+	log.ZWarn(e.ctx, "OnOutboxMessageStatusChanged is not implemented", nil, "message", message, "status", status, "attempts", attempts)
+}
+
+func (e *emptyAdvancedMsgListener) OnMsgPinChanged(pinChange string) {
+	log.ZWarn(e.ctx, "OnMsgPinChanged is not implemented", nil, "pinChange", pinChange)
+}
+
 func (e *emptyAdvancedMsgListener) OnRecvMessageExtensionsChanged(msgID string, reactionExtensionList string) {
 	log.ZWarn(e.ctx, "AdvancedMsgListener is not implemented", nil, "msgID", msgID,
 		"reactionExtensionList", reactionExtensionList)
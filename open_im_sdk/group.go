@@ -16,20 +16,20 @@ package open_im_sdk
 
 import "github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
 
-func CreateGroup(callback open_im_sdk_callback.Base, operationID string, groupReqInfo string) {
-	call(callback, operationID, UserForSDK.Group().CreateGroup, groupReqInfo)
+func (i *Instance) CreateGroup(callback open_im_sdk_callback.Base, operationID string, groupReqInfo string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().CreateGroup, groupReqInfo)
 }
 
-func JoinGroup(callback open_im_sdk_callback.Base, operationID string, groupID string, reqMsg string, joinSource int32, ex string) {
-	call(callback, operationID, UserForSDK.Group().JoinGroup, groupID, reqMsg, joinSource, ex)
+func (i *Instance) JoinGroup(callback open_im_sdk_callback.Base, operationID string, groupID string, reqMsg string, joinSource int32, ex string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().JoinGroup, groupID, reqMsg, joinSource, ex)
 }
 
-func QuitGroup(callback open_im_sdk_callback.Base, operationID string, groupID string) {
-	call(callback, operationID, UserForSDK.Group().QuitGroup, groupID)
+func (i *Instance) QuitGroup(callback open_im_sdk_callback.Base, operationID string, groupID string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().QuitGroup, groupID)
 }
 
-func DismissGroup(callback open_im_sdk_callback.Base, operationID string, groupID string) {
-	call(callback, operationID, UserForSDK.Group().DismissGroup, groupID)
+func (i *Instance) DismissGroup(callback open_im_sdk_callback.Base, operationID string, groupID string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().DismissGroup, groupID)
 }
 
 //func SetGroupVerification(callback open_im_sdk_callback.Base, operationID string, groupID string, verification int32) {
@@ -44,28 +44,28 @@ func DismissGroup(callback open_im_sdk_callback.Base, operationID string, groupI
 //	call(callback, operationID, UserForSDK.Group().SetGroupLookMemberInfo, groupID, rule)
 //}
 
-func ChangeGroupMute(callback open_im_sdk_callback.Base, operationID string, groupID string, isMute bool) {
-	call(callback, operationID, UserForSDK.Group().ChangeGroupMute, groupID, isMute)
+func (i *Instance) ChangeGroupMute(callback open_im_sdk_callback.Base, operationID string, groupID string, isMute bool) {
+	call(i.mgr, callback, operationID, i.mgr.Group().ChangeGroupMute, groupID, isMute)
 }
 
-func ChangeGroupMemberMute(callback open_im_sdk_callback.Base, operationID string, groupID string, userID string, mutedSeconds int) {
-	call(callback, operationID, UserForSDK.Group().ChangeGroupMemberMute, groupID, userID, mutedSeconds)
+func (i *Instance) ChangeGroupMemberMute(callback open_im_sdk_callback.Base, operationID string, groupID string, userID string, mutedSeconds int) {
+	call(i.mgr, callback, operationID, i.mgr.Group().ChangeGroupMemberMute, groupID, userID, mutedSeconds)
 }
 
-func TransferGroupOwner(callback open_im_sdk_callback.Base, operationID string, groupID string, newOwnerUserID string) {
-	call(callback, operationID, UserForSDK.Group().TransferGroupOwner, groupID, newOwnerUserID)
+func (i *Instance) TransferGroupOwner(callback open_im_sdk_callback.Base, operationID string, groupID string, newOwnerUserID string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().TransferGroupOwner, groupID, newOwnerUserID)
 }
 
-func KickGroupMember(callback open_im_sdk_callback.Base, operationID string, groupID string, reason string, userIDList string) {
-	call(callback, operationID, UserForSDK.Group().KickGroupMember, groupID, reason, userIDList)
+func (i *Instance) KickGroupMember(callback open_im_sdk_callback.Base, operationID string, groupID string, reason string, userIDList string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().KickGroupMember, groupID, reason, userIDList)
 }
 
-func SetGroupInfo(callback open_im_sdk_callback.Base, operationID string, groupInfo string) {
-	call(callback, operationID, UserForSDK.Group().SetGroupInfo, groupInfo)
+func (i *Instance) SetGroupInfo(callback open_im_sdk_callback.Base, operationID string, groupInfo string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().SetGroupInfo, groupInfo)
 }
 
-func SetGroupMemberInfo(callback open_im_sdk_callback.Base, operationID string, groupMemberInfo string) {
-	call(callback, operationID, UserForSDK.Group().SetGroupMemberInfo, groupMemberInfo)
+func (i *Instance) SetGroupMemberInfo(callback open_im_sdk_callback.Base, operationID string, groupMemberInfo string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().SetGroupMemberInfo, groupMemberInfo)
 }
 
 //func SetGroupMemberRoleLevel(callback open_im_sdk_callback.Base, operationID string, groupID string, userID string, roleLevel int) {
@@ -76,66 +76,66 @@ func SetGroupMemberInfo(callback open_im_sdk_callback.Base, operationID string,
 //	call(callback, operationID, UserForSDK.Group().SetGroupMemberNickname, groupID, userID, groupMemberNickname)
 //}
 
-func GetJoinedGroupList(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.Group().GetJoinedGroupList)
+func (i *Instance) GetJoinedGroupList(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().GetJoinedGroupList)
 }
 
-func GetJoinedGroupListPage(callback open_im_sdk_callback.Base, operationID string, offset, count int32) {
-	call(callback, operationID, UserForSDK.Group().GetJoinedGroupListPage, offset, count)
+func (i *Instance) GetJoinedGroupListPage(callback open_im_sdk_callback.Base, operationID string, offset, count int32) {
+	call(i.mgr, callback, operationID, i.mgr.Group().GetJoinedGroupListPage, offset, count)
 }
 
-func GetSpecifiedGroupsInfo(callback open_im_sdk_callback.Base, operationID string, groupIDList string) {
-	call(callback, operationID, UserForSDK.Group().GetSpecifiedGroupsInfo, groupIDList)
+func (i *Instance) GetSpecifiedGroupsInfo(callback open_im_sdk_callback.Base, operationID string, groupIDList string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().GetSpecifiedGroupsInfo, groupIDList)
 }
 
-func SearchGroups(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
-	call(callback, operationID, UserForSDK.Group().SearchGroups, searchParam)
+func (i *Instance) SearchGroups(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().SearchGroups, searchParam)
 }
 
-func GetGroupMemberOwnerAndAdmin(callback open_im_sdk_callback.Base, operationID string, groupID string) {
-	call(callback, operationID, UserForSDK.Group().GetGroupMemberOwnerAndAdmin, groupID)
+func (i *Instance) GetGroupMemberOwnerAndAdmin(callback open_im_sdk_callback.Base, operationID string, groupID string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().GetGroupMemberOwnerAndAdmin, groupID)
 }
 
-func GetGroupMemberListByJoinTimeFilter(callback open_im_sdk_callback.Base, operationID string, groupID string, offset int32, count int32, joinTimeBegin int64, joinTimeEnd int64, filterUserIDList string) {
-	call(callback, operationID, UserForSDK.Group().GetGroupMemberListByJoinTimeFilter, groupID, offset, count, joinTimeBegin, joinTimeEnd, filterUserIDList)
+func (i *Instance) GetGroupMemberListByJoinTimeFilter(callback open_im_sdk_callback.Base, operationID string, groupID string, offset int32, count int32, joinTimeBegin int64, joinTimeEnd int64, filterUserIDList string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().GetGroupMemberListByJoinTimeFilter, groupID, offset, count, joinTimeBegin, joinTimeEnd, filterUserIDList)
 }
 
-func GetSpecifiedGroupMembersInfo(callback open_im_sdk_callback.Base, operationID string, groupID string, userIDList string) {
-	call(callback, operationID, UserForSDK.Group().GetSpecifiedGroupMembersInfo, groupID, userIDList)
+func (i *Instance) GetSpecifiedGroupMembersInfo(callback open_im_sdk_callback.Base, operationID string, groupID string, userIDList string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().GetSpecifiedGroupMembersInfo, groupID, userIDList)
 }
 
-func GetGroupMemberList(callback open_im_sdk_callback.Base, operationID string, groupID string, filter int32, offset int32, count int32) {
-	call(callback, operationID, UserForSDK.Group().GetGroupMemberList, groupID, filter, offset, count)
+func (i *Instance) GetGroupMemberList(callback open_im_sdk_callback.Base, operationID string, groupID string, filter int32, offset int32, count int32) {
+	call(i.mgr, callback, operationID, i.mgr.Group().GetGroupMemberList, groupID, filter, offset, count)
 }
 
-func GetGroupApplicationListAsRecipient(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.Group().GetGroupApplicationListAsRecipient)
+func (i *Instance) GetGroupApplicationListAsRecipient(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().GetGroupApplicationListAsRecipient)
 }
 
-func GetGroupApplicationListAsApplicant(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.Group().GetGroupApplicationListAsApplicant)
+func (i *Instance) GetGroupApplicationListAsApplicant(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().GetGroupApplicationListAsApplicant)
 }
 
-func SearchGroupMembers(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
-	call(callback, operationID, UserForSDK.Group().SearchGroupMembers, searchParam)
+func (i *Instance) SearchGroupMembers(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().SearchGroupMembers, searchParam)
 }
 
-func IsJoinGroup(callback open_im_sdk_callback.Base, operationID string, groupID string) {
-	call(callback, operationID, UserForSDK.Group().IsJoinGroup, groupID)
+func (i *Instance) IsJoinGroup(callback open_im_sdk_callback.Base, operationID string, groupID string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().IsJoinGroup, groupID)
 }
 
-func GetUsersInGroup(callback open_im_sdk_callback.Base, operationID string, groupID, userIDList string) {
-	call(callback, operationID, UserForSDK.Group().GetUsersInGroup, groupID, userIDList)
+func (i *Instance) GetUsersInGroup(callback open_im_sdk_callback.Base, operationID string, groupID, userIDList string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().GetUsersInGroup, groupID, userIDList)
 }
 
-func InviteUserToGroup(callback open_im_sdk_callback.Base, operationID string, groupID string, reason string, userIDList string) {
-	call(callback, operationID, UserForSDK.Group().InviteUserToGroup, groupID, reason, userIDList)
+func (i *Instance) InviteUserToGroup(callback open_im_sdk_callback.Base, operationID string, groupID string, reason string, userIDList string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().InviteUserToGroup, groupID, reason, userIDList)
 }
 
-func AcceptGroupApplication(callback open_im_sdk_callback.Base, operationID string, groupID string, fromUserID string, handleMsg string) {
-	call(callback, operationID, UserForSDK.Group().AcceptGroupApplication, groupID, fromUserID, handleMsg)
+func (i *Instance) AcceptGroupApplication(callback open_im_sdk_callback.Base, operationID string, groupID string, fromUserID string, handleMsg string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().AcceptGroupApplication, groupID, fromUserID, handleMsg)
 }
 
-func RefuseGroupApplication(callback open_im_sdk_callback.Base, operationID string, groupID string, fromUserID string, handleMsg string) {
-	call(callback, operationID, UserForSDK.Group().RefuseGroupApplication, groupID, fromUserID, handleMsg)
+func (i *Instance) RefuseGroupApplication(callback open_im_sdk_callback.Base, operationID string, groupID string, fromUserID string, handleMsg string) {
+	call(i.mgr, callback, operationID, i.mgr.Group().RefuseGroupApplication, groupID, fromUserID, handleMsg)
 }
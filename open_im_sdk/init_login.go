@@ -20,11 +20,14 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/openimsdk/openim-sdk-core/v3/internal/interaction"
 	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/ccontext"
 	pbConstant "github.com/openimsdk/protocol/constant"
 
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
 	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
 	"github.com/openimsdk/openim-sdk-core/v3/version"
 
@@ -46,37 +49,90 @@ func InitSDK(listener open_im_sdk_callback.OnConnListener, operationID string, c
 		fmt.Println(operationID, "Initialize multiple times, use the existing ", UserForSDK, " Previous configuration ", UserForSDK.ImConfig(), " now configuration: ", config)
 		return true
 	}
+	mgr := newLoginMgr(listener, operationID, config, true)
+	if mgr == nil {
+		return false
+	}
+	UserForSDK = mgr
+	return true
+}
+
+// newLoginMgr returns a LoginMgr initialized with the JSON config, nil when the config is invalid.
+func newLoginMgr(listener open_im_sdk_callback.OnConnListener, operationID string, config string, initLog bool) *LoginMgr {
 	var configArgs sdk_struct.IMConfig
 	if err := json.Unmarshal([]byte(config), &configArgs); err != nil {
 		fmt.Println(operationID, "Unmarshal failed ", err.Error(), config)
-		return false
+		return nil
 	}
 	if configArgs.PlatformID == 0 {
-		return false
+		return nil
 	}
-	if err := log.InitLoggerFromConfig("open-im-sdk-core", "", configArgs.SystemType, pbConstant.PlatformID2Name[int(configArgs.PlatformID)], int(configArgs.LogLevel), configArgs.IsLogStandardOutput, false, configArgs.LogFilePath, rotateCount, rotationTime, version.Version, true); err != nil {
-		fmt.Println(operationID, "log init failed ", err.Error())
+	if initLog {
+		if err := log.InitLoggerFromConfig("open-im-sdk-core", "", configArgs.SystemType, pbConstant.PlatformID2Name[int(configArgs.PlatformID)], int(configArgs.LogLevel), configArgs.IsLogStandardOutput, false, configArgs.LogFilePath, rotateCount, rotationTime, version.Version, true); err != nil {
+			fmt.Println(operationID, "log init failed ", err.Error())
+		}
+		fmt.Println("init log success")
 	}
-	fmt.Println("init log success")
 	// localLog.NewPrivateLog("", configArgs.LogLevel)
 	ctx := mcontext.NewCtx(operationID)
-	if !strings.Contains(configArgs.ApiAddr, "http") {
-		log.ZError(ctx, "api is http protocol, api format is invalid", nil)
-		return false
-	}
-	if !strings.Contains(configArgs.WsAddr, "ws") {
-		log.ZError(ctx, "ws is ws protocol, ws format is invalid", nil)
-		return false
+	if err := CheckIMConfig(configArgs); err != nil {
+		log.ZError(ctx, "config is invalid", err)
+		return nil
 	}
 
-	log.ZInfo(ctx, "InitSDK info", "config", configArgs)
+	logConfig := configArgs
+	if logConfig.DBKey != "" {
+		logConfig.DBKey = "******"
+	}
+	log.ZInfo(ctx, "InitSDK info", "config", logConfig)
 	if listener == nil || config == "" {
 		log.ZError(ctx, "listener or config is nil", nil)
-		return false
+		return nil
+	}
+	mgr := new(LoginMgr)
+	if !mgr.InitSDK(configArgs, listener) {
+		return nil
 	}
-	UserForSDK = new(LoginMgr)
-	return UserForSDK.InitSDK(configArgs, listener)
+	return mgr
 }
+
+// CheckIMConfig reports why config can not initialize the SDK.
+func CheckIMConfig(config sdk_struct.IMConfig) error {
+	if config.PlatformID == 0 {
+		return sdkerrs.ErrArgs.WrapMsg("platformID is empty")
+	}
+	if !strings.Contains(config.ApiAddr, "http") {
+		return sdkerrs.ErrArgs.WrapMsg("api is http protocol, api format is invalid", "apiAddr", config.ApiAddr)
+	}
+	switch config.Transport {
+	case "", interaction.WebSocketTransport:
+		if !strings.Contains(config.WsAddr, "ws") {
+			return sdkerrs.ErrArgs.WrapMsg("ws is ws protocol, ws format is invalid", "wsAddr", config.WsAddr)
+		}
+	case interaction.TcpTransport:
+		if !strings.HasPrefix(config.WsAddr, "tcp://") && !strings.HasPrefix(config.WsAddr, "tls://") {
+			return sdkerrs.ErrArgs.WrapMsg("tcp transport address must be tcp:// or tls://, ws format is invalid", "wsAddr", config.WsAddr)
+		}
+	default:
+		return sdkerrs.ErrArgs.WrapMsg("transport is invalid", "transport", config.Transport)
+	}
+	if _, err := interaction.NewEncoder(config.WsCodec); err != nil {
+		return sdkerrs.ErrArgs.WrapMsg("ws codec is invalid", "wsCodec", config.WsCodec)
+	}
+	if _, err := interaction.NewCompressor(config.Compression); err != nil {
+		return sdkerrs.ErrArgs.WrapMsg("ws compression is invalid", "compression", config.Compression)
+	}
+	if config.CompressionThreshold < 0 {
+		return sdkerrs.ErrArgs.WrapMsg("ws compression threshold is invalid", "compressionThreshold", config.CompressionThreshold)
+	}
+	switch config.Storage {
+	case "", db.PersistentStorage, db.MemoryStorage:
+	default:
+		return sdkerrs.ErrArgs.WrapMsg("storage is invalid", "storage", config.Storage)
+	}
+	return nil
+}
+
 func UnInitSDK(operationID string) {
 	if UserForSDK == nil {
 		fmt.Println(operationID, "UserForSDK is nil,")
@@ -87,33 +143,43 @@ func UnInitSDK(operationID string) {
 
 }
 
-func Login(callback open_im_sdk_callback.Base, operationID string, userID, token string) {
-	call(callback, operationID, UserForSDK.Login, userID, token)
+func (i *Instance) Login(callback open_im_sdk_callback.Base, operationID string, userID, token string) {
+	call(i.mgr, callback, operationID, i.mgr.Login, userID, token)
 }
 
-func Logout(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.Logout)
+func (i *Instance) Logout(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Logout)
 }
 
-func SetAppBackgroundStatus(callback open_im_sdk_callback.Base, operationID string, isBackground bool) {
-	call(callback, operationID, UserForSDK.SetAppBackgroundStatus, isBackground)
+func (i *Instance) SetAppBackgroundStatus(callback open_im_sdk_callback.Base, operationID string, isBackground bool) {
+	call(i.mgr, callback, operationID, i.mgr.SetAppBackgroundStatus, isBackground)
 }
-func NetworkStatusChanged(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.NetworkStatusChanged)
+func (i *Instance) NetworkStatusChanged(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.NetworkStatusChanged)
 }
 
-func GetLoginStatus(operationID string) int {
-	if UserForSDK == nil {
+// RekeyDatabase re-encrypts the local database of the logged in user with newKey,
+// an empty key decrypts it.
+func (i *Instance) RekeyDatabase(callback open_im_sdk_callback.Base, operationID string, newKey string) {
+	call(i.mgr, callback, operationID, i.mgr.RekeyDatabase, newKey)
+}
+
+func (i *Instance) SetDBKeyProvider(provider open_im_sdk_callback.DBKeyProvider) {
+	listenerCall(i.mgr, i.mgr.SetDBKeyProvider, provider)
+}
+
+func (i *Instance) GetLoginStatus(operationID string) int {
+	if i.mgr == nil {
 		return constant.Uninitialized
 	}
-	return UserForSDK.GetLoginStatus(ccontext.WithOperationID(context.Background(), operationID))
+	return i.mgr.GetLoginStatus(ccontext.WithOperationID(context.Background(), operationID))
 }
 
-func GetLoginUserID() string {
-	if UserForSDK == nil {
+func (i *Instance) GetLoginUserID() string {
+	if i.mgr == nil {
 		return ""
 	}
-	return UserForSDK.GetLoginUserID()
+	return i.mgr.GetLoginUserID()
 }
 
 func (u *LoginMgr) Login(ctx context.Context, userID, token string) error {
@@ -130,6 +196,9 @@ func (u *LoginMgr) SetAppBackgroundStatus(ctx context.Context, isBackground bool
 func (u *LoginMgr) NetworkStatusChanged(ctx context.Context) {
 	u.longConnMgr.Close(ctx)
 }
+func (u *LoginMgr) RekeyDatabase(ctx context.Context, newKey string) error {
+	return u.db.Rekey(ctx, newKey)
+}
 func (u *LoginMgr) GetLoginStatus(ctx context.Context) int {
 	return u.getLoginStatus(ctx)
 }
@@ -0,0 +1,126 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package open_im_sdk
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+)
+
+// Instance routes the exported API to one LoginMgr, each instance hosts one account with its
+// own database, long connection and listeners. The package level functions use the default
+// instance, UserForSDK.
+type Instance struct {
+	handle string
+	mgr    *LoginMgr
+}
+
+var (
+	instanceSeq atomic.Int64
+	instances   = struct {
+		sync.RWMutex
+		m map[string]*Instance
+	}{m: make(map[string]*Instance)}
+
+	// loginUsers holds the LoginMgr logged in to each local database, two instances
+	// can not share one.
+	loginUsers = struct {
+		sync.Mutex
+		m map[string]*LoginMgr
+	}{m: make(map[string]*LoginMgr)}
+)
+
+// Default returns the instance of UserForSDK, initialized by InitSDK.
+func Default() *Instance {
+	return &Instance{mgr: UserForSDK}
+}
+
+// CreateInstance initializes one more SDK instance with config and returns its handle,
+// an empty handle when the config is invalid. The logger is initialized by the first
+// instance of the process.
+func CreateInstance(listener open_im_sdk_callback.OnConnListener, operationID string, config string) string {
+	instances.Lock()
+	defer instances.Unlock()
+	mgr := newLoginMgr(listener, operationID, config, UserForSDK == nil && len(instances.m) == 0)
+	if mgr == nil {
+		return ""
+	}
+	handle := strconv.FormatInt(instanceSeq.Add(1), 10)
+	instances.m[handle] = &Instance{handle: handle, mgr: mgr}
+	return handle
+}
+
+// GetInstance returns the instance of handle, nil when it does not exist.
+func GetInstance(handle string) *Instance {
+	instances.RLock()
+	defer instances.RUnlock()
+	return instances.m[handle]
+}
+
+// DestroyInstance releases the instance of handle, it must be logged out.
+func DestroyInstance(operationID string, handle string) bool {
+	ctx := mcontext.NewCtx(operationID)
+	instances.Lock()
+	defer instances.Unlock()
+	instance, ok := instances.m[handle]
+	if !ok {
+		log.ZWarn(ctx, "instance not found", nil, "handle", handle)
+		return false
+	}
+	if instance.mgr.GetLoginStatus(ctx) == Logged {
+		log.ZWarn(ctx, "instance not logout, please logout first", nil, "handle", handle)
+		return false
+	}
+	instance.mgr.UnInitSDK()
+	delete(instances.m, handle)
+	return true
+}
+
+// Handle returns the handle of the instance, empty for the default instance.
+func (i *Instance) Handle() string {
+	return i.handle
+}
+
+func (i *Instance) LoginMgr() *LoginMgr {
+	return i.mgr
+}
+
+// claimLoginUser reserves the database of userID in dataDir for mgr.
+func claimLoginUser(mgr *LoginMgr, dataDir, userID string) error {
+	key := dataDir + "/" + userID
+	loginUsers.Lock()
+	defer loginUsers.Unlock()
+	if owner, ok := loginUsers.m[key]; ok && owner != mgr {
+		return sdkerrs.ErrLoginRepeat.WrapMsg("user is logged in by another instance", "userID", userID, "dataDir", dataDir)
+	}
+	loginUsers.m[key] = mgr
+	return nil
+}
+
+func releaseLoginUser(mgr *LoginMgr) {
+	loginUsers.Lock()
+	defer loginUsers.Unlock()
+	for key, owner := range loginUsers.m {
+		if owner == mgr {
+			delete(loginUsers.m, key)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+//go:build !js
+
+package open_im_sdk
+
+import (
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+type testConnListener struct {
+	open_im_sdk_callback.OnConnListener
+}
+
+func testConfig(t *testing.T) string {
+	return utils.StructToJsonString(sdk_struct.IMConfig{PlatformID: 1, ApiAddr: "http://127.0.0.1:10002", WsAddr: "ws://127.0.0.1:10001",
+		DataDir: t.TempDir(), LogFilePath: t.TempDir()})
+}
+
+func TestInstances(t *testing.T) {
+	if handle := CreateInstance(testConnListener{}, "op", `{"platformID":1}`); handle != "" {
+		t.Fatalf("invalid config created %q", handle)
+	}
+	first := CreateInstance(testConnListener{}, "op", testConfig(t))
+	second := CreateInstance(testConnListener{}, "op", testConfig(t))
+	if first == "" || second == "" || first == second {
+		t.Fatalf("handles %q %q", first, second)
+	}
+	a, b := GetInstance(first), GetInstance(second)
+	if a == nil || b == nil || a.LoginMgr() == b.LoginMgr() || a.Handle() != first {
+		t.Fatal("instances share a LoginMgr")
+	}
+	if status := a.GetLoginStatus("op"); status != LogoutStatus {
+		t.Fatalf("instance status %d", status)
+	}
+	if status := Default().GetLoginStatus("op"); status != constant.Uninitialized {
+		t.Fatalf("default status %d", status)
+	}
+	if !DestroyInstance("op", first) || GetInstance(first) != nil || DestroyInstance("op", first) {
+		t.Fatal("destroy instance")
+	}
+	if GetInstance(second) != b || !DestroyInstance("op", second) {
+		t.Fatal("destroy second instance")
+	}
+}
+
+func TestClaimLoginUser(t *testing.T) {
+	dir := t.TempDir()
+	a, b := NewLoginMgr(), NewLoginMgr()
+	if err := claimLoginUser(a, dir, "u1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := claimLoginUser(a, dir, "u1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := claimLoginUser(b, dir, "u1"); err == nil {
+		t.Fatal("two instances logged in to one database")
+	}
+	if err := claimLoginUser(b, dir, "u2"); err != nil {
+		t.Fatal(err)
+	}
+	releaseLoginUser(a)
+	if err := claimLoginUser(b, dir, "u1"); err != nil {
+		t.Fatal(err)
+	}
+	releaseLoginUser(b)
+}
@@ -18,31 +18,31 @@ import (
 	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
 )
 
-func SetGroupListener(listener open_im_sdk_callback.OnGroupListener) {
-	listenerCall(UserForSDK.SetGroupListener, listener)
+func (i *Instance) SetGroupListener(listener open_im_sdk_callback.OnGroupListener) {
+	listenerCall(i.mgr, i.mgr.SetGroupListener, listener)
 }
 
-func SetConversationListener(listener open_im_sdk_callback.OnConversationListener) {
-	listenerCall(UserForSDK.SetConversationListener, listener)
+func (i *Instance) SetConversationListener(listener open_im_sdk_callback.OnConversationListener) {
+	listenerCall(i.mgr, i.mgr.SetConversationListener, listener)
 }
 
-func SetAdvancedMsgListener(listener open_im_sdk_callback.OnAdvancedMsgListener) {
-	listenerCall(UserForSDK.SetAdvancedMsgListener, listener)
+func (i *Instance) SetAdvancedMsgListener(listener open_im_sdk_callback.OnAdvancedMsgListener) {
+	listenerCall(i.mgr, i.mgr.SetAdvancedMsgListener, listener)
 }
 
-func SetUserListener(listener open_im_sdk_callback.OnUserListener) {
-	listenerCall(UserForSDK.SetUserListener, listener)
+func (i *Instance) SetUserListener(listener open_im_sdk_callback.OnUserListener) {
+	listenerCall(i.mgr, i.mgr.SetUserListener, listener)
 
 }
 
-func SetFriendListener(listener open_im_sdk_callback.OnFriendshipListener) {
-	listenerCall(UserForSDK.SetFriendshipListener, listener)
+func (i *Instance) SetFriendListener(listener open_im_sdk_callback.OnFriendshipListener) {
+	listenerCall(i.mgr, i.mgr.SetFriendshipListener, listener)
 }
 
-func SetCustomBusinessListener(listener open_im_sdk_callback.OnCustomBusinessListener) {
-	listenerCall(UserForSDK.SetCustomBusinessListener, listener)
+func (i *Instance) SetCustomBusinessListener(listener open_im_sdk_callback.OnCustomBusinessListener) {
+	listenerCall(i.mgr, i.mgr.SetCustomBusinessListener, listener)
 }
 
-func SetMessageKvInfoListener(listener open_im_sdk_callback.OnMessageKvInfoListener) {
-	listenerCall(UserForSDK.SetMessageKvInfoListener, listener)
+func (i *Instance) SetMessageKvInfoListener(listener open_im_sdk_callback.OnMessageKvInfoListener) {
+	listenerCall(i.mgr, i.mgr.SetMessageKvInfoListener, listener)
 }
@@ -5,21 +5,21 @@ import (
 )
 
 // SubscribeUsersStatus Presence status of subscribed users.
-func SubscribeUsersStatus(callback open_im_sdk_callback.Base, operationID string, userIDs string) {
-	call(callback, operationID, UserForSDK.LongConnMgr().SubscribeUsersStatus, userIDs)
+func (i *Instance) SubscribeUsersStatus(callback open_im_sdk_callback.Base, operationID string, userIDs string) {
+	call(i.mgr, callback, operationID, i.mgr.LongConnMgr().SubscribeUsersStatus, userIDs)
 }
 
 // UnsubscribeUsersStatus Unsubscribe a user's presence.
-func UnsubscribeUsersStatus(callback open_im_sdk_callback.Base, operationID string, userIDs string) {
-	call(callback, operationID, UserForSDK.LongConnMgr().UnsubscribeUsersStatus, userIDs)
+func (i *Instance) UnsubscribeUsersStatus(callback open_im_sdk_callback.Base, operationID string, userIDs string) {
+	call(i.mgr, callback, operationID, i.mgr.LongConnMgr().UnsubscribeUsersStatus, userIDs)
 }
 
 // GetSubscribeUsersStatus Get the online status of subscribers.
-func GetSubscribeUsersStatus(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.LongConnMgr().GetSubscribeUsersStatus)
+func (i *Instance) GetSubscribeUsersStatus(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.LongConnMgr().GetSubscribeUsersStatus)
 }
 
 // GetUserStatus Get the online status of users.
-func GetUserStatus(callback open_im_sdk_callback.Base, operationID string, userIDs string) {
-	call(callback, operationID, UserForSDK.LongConnMgr().SubscribeUsersStatus, userIDs)
+func (i *Instance) GetUserStatus(callback open_im_sdk_callback.Base, operationID string, userIDs string) {
+	call(i.mgr, callback, operationID, i.mgr.LongConnMgr().SubscribeUsersStatus, userIDs)
 }
@@ -16,32 +16,32 @@ package open_im_sdk
 
 import "github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
 
-func GetSpecifiedFriendsInfo(callback open_im_sdk_callback.Base, operationID string, userIDList string, filterBlack bool) {
-	call(callback, operationID, UserForSDK.Relation().GetSpecifiedFriendsInfo, userIDList, filterBlack)
+func (i *Instance) GetSpecifiedFriendsInfo(callback open_im_sdk_callback.Base, operationID string, userIDList string, filterBlack bool) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().GetSpecifiedFriendsInfo, userIDList, filterBlack)
 }
 
-func GetFriendList(callback open_im_sdk_callback.Base, operationID string, filterBlack bool) {
-	call(callback, operationID, UserForSDK.Relation().GetFriendList, filterBlack)
+func (i *Instance) GetFriendList(callback open_im_sdk_callback.Base, operationID string, filterBlack bool) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().GetFriendList, filterBlack)
 }
 
-func GetFriendListPage(callback open_im_sdk_callback.Base, operationID string, offset int32, count int32, filterBlack bool) {
-	call(callback, operationID, UserForSDK.Relation().GetFriendListPage, offset, count, filterBlack)
+func (i *Instance) GetFriendListPage(callback open_im_sdk_callback.Base, operationID string, offset int32, count int32, filterBlack bool) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().GetFriendListPage, offset, count, filterBlack)
 }
 
-func SearchFriends(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
-	call(callback, operationID, UserForSDK.Relation().SearchFriends, searchParam)
+func (i *Instance) SearchFriends(callback open_im_sdk_callback.Base, operationID string, searchParam string) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().SearchFriends, searchParam)
 }
 
-func CheckFriend(callback open_im_sdk_callback.Base, operationID string, userIDList string) {
-	call(callback, operationID, UserForSDK.Relation().CheckFriend, userIDList)
+func (i *Instance) CheckFriend(callback open_im_sdk_callback.Base, operationID string, userIDList string) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().CheckFriend, userIDList)
 }
 
-func AddFriend(callback open_im_sdk_callback.Base, operationID string, userIDReqMsg string) {
-	call(callback, operationID, UserForSDK.Relation().AddFriend, userIDReqMsg)
+func (i *Instance) AddFriend(callback open_im_sdk_callback.Base, operationID string, userIDReqMsg string) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().AddFriend, userIDReqMsg)
 }
 
-func UpdateFriends(callback open_im_sdk_callback.Base, operationID string, req string) {
-	call(callback, operationID, UserForSDK.Relation().UpdateFriends, req)
+func (i *Instance) UpdateFriends(callback open_im_sdk_callback.Base, operationID string, req string) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().UpdateFriends, req)
 }
 
 //func SetFriendRemark(callback open_im_sdk_callback.Base, operationID string, userIDRemark string) {
@@ -51,36 +51,36 @@ func UpdateFriends(callback open_im_sdk_callback.Base, operationID string, req s
 //	call(callback, operationID, UserForSDK.Relation().PinFriends, pinFriendsParams)
 //}
 
-func DeleteFriend(callback open_im_sdk_callback.Base, operationID string, friendUserID string) {
-	call(callback, operationID, UserForSDK.Relation().DeleteFriend, friendUserID)
+func (i *Instance) DeleteFriend(callback open_im_sdk_callback.Base, operationID string, friendUserID string) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().DeleteFriend, friendUserID)
 }
 
-func GetFriendApplicationListAsRecipient(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.Relation().GetFriendApplicationListAsRecipient)
+func (i *Instance) GetFriendApplicationListAsRecipient(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().GetFriendApplicationListAsRecipient)
 }
 
-func GetFriendApplicationListAsApplicant(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.Relation().GetFriendApplicationListAsApplicant)
+func (i *Instance) GetFriendApplicationListAsApplicant(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().GetFriendApplicationListAsApplicant)
 }
 
-func AcceptFriendApplication(callback open_im_sdk_callback.Base, operationID string, userIDHandleMsg string) {
-	call(callback, operationID, UserForSDK.Relation().AcceptFriendApplication, userIDHandleMsg)
+func (i *Instance) AcceptFriendApplication(callback open_im_sdk_callback.Base, operationID string, userIDHandleMsg string) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().AcceptFriendApplication, userIDHandleMsg)
 }
 
-func RefuseFriendApplication(callback open_im_sdk_callback.Base, operationID string, userIDHandleMsg string) {
-	call(callback, operationID, UserForSDK.Relation().RefuseFriendApplication, userIDHandleMsg)
+func (i *Instance) RefuseFriendApplication(callback open_im_sdk_callback.Base, operationID string, userIDHandleMsg string) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().RefuseFriendApplication, userIDHandleMsg)
 }
 
-func AddBlack(callback open_im_sdk_callback.Base, operationID string, blackUserID string, ex string) {
-	call(callback, operationID, UserForSDK.Relation().AddBlack, blackUserID, ex)
+func (i *Instance) AddBlack(callback open_im_sdk_callback.Base, operationID string, blackUserID string, ex string) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().AddBlack, blackUserID, ex)
 }
 
-func GetBlackList(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.Relation().GetBlackList)
+func (i *Instance) GetBlackList(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().GetBlackList)
 }
 
-func RemoveBlack(callback open_im_sdk_callback.Base, operationID string, removeUserID string) {
-	call(callback, operationID, UserForSDK.Relation().RemoveBlack, removeUserID)
+func (i *Instance) RemoveBlack(callback open_im_sdk_callback.Base, operationID string, removeUserID string) {
+	call(i.mgr, callback, operationID, i.mgr.Relation().RemoveBlack, removeUserID)
 }
 
 //func SetFriendsEx(callback open_im_sdk_callback.Base, operationID string, friendIDs string, ex string) {
@@ -20,26 +20,26 @@ import (
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
 )
 
-func UpdateFcmToken(callback open_im_sdk_callback.Base, operationID, fcmToken string, expireTime int64) {
-	call(callback, operationID, UserForSDK.Third().UpdateFcmToken, fcmToken, expireTime)
+func (i *Instance) UpdateFcmToken(callback open_im_sdk_callback.Base, operationID, fcmToken string, expireTime int64) {
+	call(i.mgr, callback, operationID, i.mgr.Third().UpdateFcmToken, fcmToken, expireTime)
 }
 
-func SetAppBadge(callback open_im_sdk_callback.Base, operationID string, appUnreadCount int32) {
-	call(callback, operationID, UserForSDK.Third().SetAppBadge, appUnreadCount)
+func (i *Instance) SetAppBadge(callback open_im_sdk_callback.Base, operationID string, appUnreadCount int32) {
+	call(i.mgr, callback, operationID, i.mgr.Third().SetAppBadge, appUnreadCount)
 }
 
-func UploadLogs(callback open_im_sdk_callback.Base, operationID string, line int, ex string, progress open_im_sdk_callback.UploadLogProgress) {
-	call(callback, operationID, UserForSDK.Third().UploadLogs, line, ex, progress)
+func (i *Instance) UploadLogs(callback open_im_sdk_callback.Base, operationID string, line int, ex string, progress open_im_sdk_callback.UploadLogProgress) {
+	call(i.mgr, callback, operationID, i.mgr.Third().UploadLogs, line, ex, progress)
 }
 
-func Logs(callback open_im_sdk_callback.Base, operationID string, logLevel int, file string, line int, msgs string, err string, keyAndValue string) {
-	if UserForSDK == nil || UserForSDK.Third() == nil {
+func (i *Instance) Logs(callback open_im_sdk_callback.Base, operationID string, logLevel int, file string, line int, msgs string, err string, keyAndValue string) {
+	if i.mgr == nil || i.mgr.Third() == nil {
 		callback.OnError(sdkerrs.SdkInternalError, "sdk not init")
 		return
 	}
-	call(callback, operationID, UserForSDK.Third().Log, logLevel, file, line, msgs, err, keyAndValue)
+	call(i.mgr, callback, operationID, i.mgr.Third().Log, logLevel, file, line, msgs, err, keyAndValue)
 }
 
-func UploadFile(callback open_im_sdk_callback.Base, operationID string, req string, progress open_im_sdk_callback.UploadFileCallback) {
-	call(callback, operationID, UserForSDK.File().UploadFile, req, file.UploadFileCallback(progress))
+func (i *Instance) UploadFile(callback open_im_sdk_callback.Base, operationID string, req string, progress open_im_sdk_callback.UploadFileCallback) {
+	call(i.mgr, callback, operationID, i.mgr.File().UploadFile, req, file.UploadFileCallback(progress))
 }
@@ -18,13 +18,13 @@ import (
 	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk_callback"
 )
 
-func GetUsersInfo(callback open_im_sdk_callback.Base, operationID string, userIDs string) {
-	call(callback, operationID, UserForSDK.User().GetUsersInfo, userIDs)
+func (i *Instance) GetUsersInfo(callback open_im_sdk_callback.Base, operationID string, userIDs string) {
+	call(i.mgr, callback, operationID, i.mgr.User().GetUsersInfo, userIDs)
 }
 
 // SetSelfInfo sets the user's own information.
-func SetSelfInfo(callback open_im_sdk_callback.Base, operationID string, userInfo string) {
-	call(callback, operationID, UserForSDK.User().SetSelfInfo, userInfo)
+func (i *Instance) SetSelfInfo(callback open_im_sdk_callback.Base, operationID string, userInfo string) {
+	call(i.mgr, callback, operationID, i.mgr.User().SetSelfInfo, userInfo)
 }
 
 //// SetSelfInfo sets the user's own information with Ex field.
@@ -33,21 +33,21 @@ func SetSelfInfo(callback open_im_sdk_callback.Base, operationID string, userInf
 //}
 
 // GetSelfUserInfo obtains the user's own information.
-func GetSelfUserInfo(callback open_im_sdk_callback.Base, operationID string) {
-	call(callback, operationID, UserForSDK.User().GetSelfUserInfo)
+func (i *Instance) GetSelfUserInfo(callback open_im_sdk_callback.Base, operationID string) {
+	call(i.mgr, callback, operationID, i.mgr.User().GetSelfUserInfo)
 }
 
 // AddUserCommand add to user's favorite
-func AddUserCommand(callback open_im_sdk_callback.Base, operationID string, Type int32, uuid string, value string) {
-	call(callback, operationID, UserForSDK.User().ProcessUserCommandAdd, Type, uuid, value)
+func (i *Instance) AddUserCommand(callback open_im_sdk_callback.Base, operationID string, Type int32, uuid string, value string) {
+	call(i.mgr, callback, operationID, i.mgr.User().ProcessUserCommandAdd, Type, uuid, value)
 }
 
 // DeleteUserCommand delete from user's favorite
-func DeleteUserCommand(callback open_im_sdk_callback.Base, operationID string, Type int32, uuid string) {
-	call(callback, operationID, UserForSDK.User().ProcessUserCommandDelete, Type, uuid)
+func (i *Instance) DeleteUserCommand(callback open_im_sdk_callback.Base, operationID string, Type int32, uuid string) {
+	call(i.mgr, callback, operationID, i.mgr.User().ProcessUserCommandDelete, Type, uuid)
 }
 
 // GetAllUserCommands get user's favorite
-func GetAllUserCommands(callback open_im_sdk_callback.Base, operationID string, Type int32) {
-	call(callback, operationID, UserForSDK.User().ProcessUserCommandGetAll, Type)
+func (i *Instance) GetAllUserCommands(callback open_im_sdk_callback.Base, operationID string, Type int32) {
+	call(i.mgr, callback, operationID, i.mgr.User().ProcessUserCommandGetAll, Type)
 }
@@ -40,6 +40,7 @@ import (
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/db"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/db_interface"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/memdb"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
 	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
@@ -47,6 +48,7 @@ import (
 	"github.com/openimsdk/protocol/sdkws"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/utils/jsonutil"
 )
 
@@ -97,6 +99,7 @@ type LoginMgr struct {
 	token        string
 	loginUserID  string
 	connListener open_im_sdk_callback.OnConnListener
+	keyProvider  open_im_sdk_callback.DBKeyProvider
 
 	justOnceFlag bool
 
@@ -116,6 +119,8 @@ type LoginMgr struct {
 	cmdWsCh            chan common.Cmd2Value
 	pushMsgAndMaxSeqCh chan common.Cmd2Value
 	loginMgrCh         chan common.Cmd2Value
+	// logoutListenerDone is closed once the logout listener of the session has exited.
+	logoutListenerDone chan struct{}
 
 	ctx       context.Context
 	cancel    context.CancelFunc
@@ -225,10 +230,40 @@ func (u *LoginMgr) SetUserListener(userListener open_im_sdk_callback.OnUserListe
 func (u *LoginMgr) SetCustomBusinessListener(listener open_im_sdk_callback.OnCustomBusinessListener) {
 	u.businessListener = listener
 }
+
+func (u *LoginMgr) SetDBKeyProvider(provider open_im_sdk_callback.DBKeyProvider) {
+	u.keyProvider = provider
+}
+
+// openDataBase opens the local store of userID selected by the Storage config.
+func (u *LoginMgr) openDataBase(ctx context.Context, userID string) (db_interface.DataBase, error) {
+	key := u.dbKey(userID)
+	if u.info.Storage != db.MemoryStorage {
+		return db.NewDataBase(ctx, userID, u.info.DataDir, int(u.info.LogLevel), db.WithKey(key))
+	}
+	if key != "" {
+		return nil, sdkerrs.ErrDBEncryptNotSupport.WrapMsg("memory storage is not encrypted")
+	}
+	return memdb.NewDataBase(ctx, userID)
+}
+
+func (u *LoginMgr) dbKey(userID string) string {
+	if u.keyProvider != nil {
+		if key := u.keyProvider.GetDBKey(userID); key != "" {
+			return key
+		}
+	}
+	return u.info.DBKey
+}
+
 func (u *LoginMgr) GetLoginUserID() string {
 	return u.loginUserID
 }
-func (u *LoginMgr) logoutListener(ctx context.Context) {
+
+// logoutListener waits on the login channel of its session. It exits before logging out, since
+// the logout recreates the channels of the next session and waits for it to exit.
+func (u *LoginMgr) logoutListener(ctx context.Context, loginMgrCh <-chan common.Cmd2Value, done chan<- struct{}) {
+	defer close(done)
 	defer func() {
 		if r := recover(); r != nil {
 			err := fmt.Sprintf("panic: %+v\n%s", r, debug.Stack())
@@ -237,20 +272,18 @@ func (u *LoginMgr) logoutListener(ctx context.Context) {
 		}
 	}()
 
-	for {
-		select {
-		case <-u.loginMgrCh:
-			log.ZDebug(ctx, "logoutListener exit")
+	select {
+	case <-loginMgrCh:
+		log.ZDebug(ctx, "logoutListener exit")
+		go func() {
 			err := u.logout(ctx, true)
 			if err != nil {
 				log.ZError(ctx, "logout error", err)
 			}
-		case <-ctx.Done():
-			log.ZInfo(ctx, "logoutListener done sdk logout.....")
-			return
-		}
+		}()
+	case <-ctx.Done():
+		log.ZInfo(ctx, "logoutListener done sdk logout.....")
 	}
-
 }
 
 func NewLoginMgr() *LoginMgr {
@@ -274,6 +307,9 @@ func (u *LoginMgr) checkSendingMessage(ctx context.Context) {
 		log.ZError(ctx, "GetAllSendingMessages failed", err)
 	}
 	for _, message := range sendingMessages {
+		if _, err := u.db.GetOutboxMessage(ctx, message.ClientMsgID); err == nil {
+			continue // still queued, the outbox sends it once connected
+		}
 		if err := u.handlerSendingMsg(ctx, message); err != nil {
 			log.ZError(ctx, "handlerSendingMsg failed", err, "message", message)
 		}
@@ -315,7 +351,13 @@ func (u *LoginMgr) login(ctx context.Context, userID, token string) error {
 	if u.getLoginStatus(ctx) == Logged {
 		return sdkerrs.ErrLoginRepeat
 	}
+	if err := claimLoginUser(u, u.info.DataDir, userID); err != nil {
+		return err
+	}
 	u.setLoginStatus(Logging)
+	// logs of the instance carry the login user
+	u.ctx = mcontext.WithOpUserIDContext(u.ctx, userID)
+	ctx = mcontext.WithOpUserIDContext(ctx, userID)
 	log.ZDebug(ctx, "login start... ", "userID", userID, "token", token)
 	t1 := time.Now()
 
@@ -324,8 +366,13 @@ func (u *LoginMgr) login(ctx context.Context, userID, token string) error {
 	u.token = token
 	u.loginUserID = userID
 	var err error
-	u.db, err = db.NewDataBase(ctx, userID, u.info.DataDir, int(u.info.LogLevel))
+	u.db, err = u.openDataBase(ctx, userID)
 	if err != nil {
+		u.setLoginStatus(LogoutStatus)
+		releaseLoginUser(u)
+		if _, ok := errs.Unwrap(err).(errs.CodeError); ok {
+			return err
+		}
 		return sdkerrs.ErrSdkInternal.WrapMsg("init database " + err.Error())
 	}
 	u.checkSendingMessage(ctx)
@@ -369,7 +416,11 @@ func (u *LoginMgr) run(ctx context.Context) {
 	u.longConnMgr.Run(ctx)
 	go u.msgSyncer.DoListener(ctx)
 	go common.DoListener(u.ctx, u.conversation)
-	go u.logoutListener(ctx)
+	go u.conversation.RunScheduledMessages(u.ctx)
+	go u.conversation.RunOutbox(u.ctx)
+	go u.conversation.RunMessageDestruct(u.ctx)
+	u.logoutListenerDone = make(chan struct{})
+	go u.logoutListener(ctx, u.loginMgrCh, u.logoutListenerDone)
 }
 
 func (u *LoginMgr) InitSDK(config sdk_struct.IMConfig, listener open_im_sdk_callback.OnConnListener) bool {
@@ -437,6 +488,10 @@ func (u *LoginMgr) logout(ctx context.Context, isTokenValid bool) error {
 	if err != nil {
 		log.ZWarn(ctx, "TriggerCmdLogout db recycle resources failed...", err)
 	}
+	releaseLoginUser(u)
+	if u.logoutListenerDone != nil {
+		<-u.logoutListenerDone
+	}
 	// user object must be rest  when user logout
 	u.initResources()
 	log.ZDebug(ctx, "TriggerCmdLogout client success...",
@@ -23,6 +23,12 @@ type SendMsgCallBack interface {
 	OnProgress(progress int)
 }
 
+// DBKeyProvider supplies the local database key of a user at login, for example from the
+// platform keystore. An empty key falls back to IMConfig.DBKey.
+type DBKeyProvider interface {
+	GetDBKey(userID string) string
+}
+
 type OnConnListener interface {
 	OnConnecting()
 	OnConnectSuccess()
@@ -30,6 +36,9 @@ type OnConnListener interface {
 	OnKickedOffline()
 	OnUserTokenExpired()
 	OnUserTokenInvalid(errMsg string)
+	// OnReconnecting reports a failed connection attempt and the wait in milliseconds before the
+	// next one, -1 once the attempts are exhausted until the network changes.
+	OnReconnecting(attempt int32, delayMs int64)
 }
 
 type OnGroupListener interface {
@@ -65,16 +74,32 @@ type OnConversationListener interface {
 	OnConversationChanged(conversationList string)
 	OnTotalUnreadMessageCountChanged(totalUnreadCount int32)
 	OnConversationUserInputStatusChanged(change string)
+	// OnUnreadMentionCountChanged reports the number of unread messages mentioning the user,
+	// across all conversations.
+	OnUnreadMentionCountChanged(totalUnreadCount int32)
 }
 
 type OnAdvancedMsgListener interface {
 	OnRecvNewMessage(message string)
 	OnRecvC2CReadReceipt(msgReceiptList string)
+	// OnRecvGroupReadReceipt reports the members that newly read group messages requesting receipts.
+	OnRecvGroupReadReceipt(groupMsgReceiptList string)
 	OnNewRecvMessageRevoked(messageRevoked string)
 	OnRecvOfflineNewMessage(message string)
 	OnMsgDeleted(message string)
 	OnRecvOnlineOnlyMessage(message string)
 	OnMsgEdited(message string)
+	OnRecvMessageExtensionsChanged(msgID string, reactionExtensionList string)
+	OnRecvMessageExtensionsDeleted(msgID string, reactionExtensionKeyList string)
+	OnRecvMessageExtensionsAdded(msgID string, reactionExtensionList string)
+	OnScheduledMessageSent(message string)
+	OnScheduledMessageFailed(message string, errCode int32, errMsg string)
+	// OnOutboxMessageStatusChanged reports a message of the outbox, status is one of the
+	// constant Outbox* values and attempts counts its sends so far.
+	OnOutboxMessageStatusChanged(message string, status int32, attempts int32)
+	// OnMsgPinChanged reports a message pinned or unpinned in its conversation, by a member or
+	// because the message was revoked or deleted.
+	OnMsgPinChanged(pinChange string)
 }
 
 type OnUserListener interface {
@@ -149,3 +174,7 @@ type UploadFileCallback interface {
 type UploadLogProgress interface {
 	OnProgress(current int64, size int64)
 }
+
+type ArchiveProgress interface {
+	OnProgress(current int64, size int64)
+}
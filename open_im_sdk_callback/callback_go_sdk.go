@@ -17,8 +17,11 @@ package open_im_sdk_callback
 import (
 	"context"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
 	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
 
+	userPb "github.com/openimsdk/protocol/user"
 	"github.com/openimsdk/tools/log"
 )
 
@@ -34,55 +37,347 @@ type OnFriendshipListenerSdk interface {
 	OnBlackDeleted(blackInfo model_struct.LocalBlack)
 }
 
+// FriendshipListenerSdkProvider is implemented by a string listener wrapping a typed one, the
+// events then reach the typed listener as they are, without a JSON round trip.
+type FriendshipListenerSdkProvider interface {
+	FriendshipListenerSdk() OnFriendshipListenerSdk
+}
+
 type onFriendshipListener struct {
 	onFriendshipListener func() OnFriendshipListener
 }
 
+// sdk returns the typed listener behind the string listener, if any.
+func (o *onFriendshipListener) sdk() OnFriendshipListenerSdk {
+	if provider, ok := o.onFriendshipListener().(FriendshipListenerSdkProvider); ok {
+		return provider.FriendshipListenerSdk()
+	}
+	return nil
+}
+
 func NewOnFriendshipListenerSdk(listener func() OnFriendshipListener) OnFriendshipListenerSdk {
 	return &onFriendshipListener{listener}
 }
 
 func (o *onFriendshipListener) OnFriendApplicationAdded(friendApplication model_struct.LocalFriendRequest) {
 	log.ZDebug(context.Background(), "OnFriendApplicationAdded", "friendApplication", friendApplication)
+	if l := o.sdk(); l != nil {
+		l.OnFriendApplicationAdded(friendApplication)
+		return
+	}
 	o.onFriendshipListener().OnFriendApplicationAdded(utils.StructToJsonString(friendApplication))
 }
 
 func (o *onFriendshipListener) OnFriendApplicationDeleted(friendApplication model_struct.LocalFriendRequest) {
 	log.ZDebug(context.Background(), "OnFriendApplicationDeleted", "friendApplication", friendApplication)
+	if l := o.sdk(); l != nil {
+		l.OnFriendApplicationDeleted(friendApplication)
+		return
+	}
 	o.onFriendshipListener().OnFriendApplicationDeleted(utils.StructToJsonString(friendApplication))
 }
 
 func (o *onFriendshipListener) OnFriendApplicationAccepted(friendApplication model_struct.LocalFriendRequest) {
 	log.ZDebug(context.Background(), "OnFriendApplicationAccepted", "friendApplication", friendApplication)
+	if l := o.sdk(); l != nil {
+		l.OnFriendApplicationAccepted(friendApplication)
+		return
+	}
 	o.onFriendshipListener().OnFriendApplicationAccepted(utils.StructToJsonString(friendApplication))
 }
 
 func (o *onFriendshipListener) OnFriendApplicationRejected(friendApplication model_struct.LocalFriendRequest) {
 	log.ZDebug(context.Background(), "OnFriendApplicationRejected", "friendApplication", friendApplication)
+	if l := o.sdk(); l != nil {
+		l.OnFriendApplicationRejected(friendApplication)
+		return
+	}
 	o.onFriendshipListener().OnFriendApplicationRejected(utils.StructToJsonString(friendApplication))
 }
 
 func (o *onFriendshipListener) OnFriendAdded(friendInfo model_struct.LocalFriend) {
 	log.ZDebug(context.Background(), "OnFriendAdded", "friendInfo", friendInfo)
+	if l := o.sdk(); l != nil {
+		l.OnFriendAdded(friendInfo)
+		return
+	}
 	o.onFriendshipListener().OnFriendAdded(utils.StructToJsonString(friendInfo))
 }
 
 func (o *onFriendshipListener) OnFriendDeleted(friendInfo model_struct.LocalFriend) {
 	log.ZDebug(context.Background(), "OnFriendDeleted", "friendInfo", friendInfo)
+	if l := o.sdk(); l != nil {
+		l.OnFriendDeleted(friendInfo)
+		return
+	}
 	o.onFriendshipListener().OnFriendDeleted(utils.StructToJsonString(friendInfo))
 }
 
 func (o *onFriendshipListener) OnFriendInfoChanged(friendInfo model_struct.LocalFriend) {
 	log.ZDebug(context.Background(), "OnFriendInfoChanged", "friendInfo", friendInfo)
+	if l := o.sdk(); l != nil {
+		l.OnFriendInfoChanged(friendInfo)
+		return
+	}
 	o.onFriendshipListener().OnFriendInfoChanged(utils.StructToJsonString(friendInfo))
 }
 
 func (o *onFriendshipListener) OnBlackAdded(blackInfo model_struct.LocalBlack) {
 	log.ZDebug(context.Background(), "OnBlackAdded", "blackInfo", blackInfo)
+	if l := o.sdk(); l != nil {
+		l.OnBlackAdded(blackInfo)
+		return
+	}
 	o.onFriendshipListener().OnBlackAdded(utils.StructToJsonString(blackInfo))
 }
 
 func (o *onFriendshipListener) OnBlackDeleted(blackInfo model_struct.LocalBlack) {
 	log.ZDebug(context.Background(), "OnBlackDeleted", "blackInfo", blackInfo)
+	if l := o.sdk(); l != nil {
+		l.OnBlackDeleted(blackInfo)
+		return
+	}
 	o.onFriendshipListener().OnBlackDeleted(utils.StructToJsonString(blackInfo))
 }
+
+type OnConversationListenerSdk interface {
+	OnSyncServerStart(reinstalled bool)
+	OnSyncServerFinish(reinstalled bool)
+	OnSyncServerProgress(progress int)
+	OnSyncServerFailed(reinstalled bool)
+	OnNewConversation(conversationList []*model_struct.LocalConversation)
+	OnConversationChanged(conversationList []*model_struct.LocalConversation)
+	OnTotalUnreadMessageCountChanged(totalUnreadCount int32)
+	OnConversationUserInputStatusChanged(change sdk_struct.InputStatesChangedData)
+	OnUnreadMentionCountChanged(totalUnreadCount int32)
+}
+
+type OnAdvancedMsgListenerSdk interface {
+	OnRecvNewMessage(message *sdk_struct.MsgStruct)
+	OnRecvC2CReadReceipt(msgReceiptList []*sdk_struct.MessageReceipt)
+	OnRecvGroupReadReceipt(groupMsgReceiptList []*sdk_struct.MessageReceipt)
+	OnNewRecvMessageRevoked(messageRevoked sdk_struct.MessageRevoked)
+	// OnRecvOfflineNewMessage and OnRecvOnlineOnlyMessage always receive a list,
+	// messages the string listener reports one by one arrive as a list of one.
+	OnRecvOfflineNewMessage(messages []*sdk_struct.MsgStruct)
+	OnMsgDeleted(message model_struct.LocalChatLog)
+	OnRecvOnlineOnlyMessage(messages []*sdk_struct.MsgStruct)
+	OnMsgEdited(message *sdk_struct.MsgStruct)
+	OnRecvMessageExtensionsChanged(msgID string, reactionExtensionList []*sdk_struct.ReactionElem)
+	OnRecvMessageExtensionsDeleted(msgID string, reactionExtensionKeyList []string)
+	OnRecvMessageExtensionsAdded(msgID string, reactionExtensionList []*sdk_struct.ReactionElem)
+	OnScheduledMessageSent(message *sdk_struct.MsgStruct)
+	OnScheduledMessageFailed(message *sdk_struct.MsgStruct, errCode int32, errMsg string)
+	OnOutboxMessageStatusChanged(message *sdk_struct.MsgStruct, status int32, attempts int32)
+	OnMsgPinChanged(pinChange *sdk_params_callback.MessagePinChange)
+}
+
+type OnGroupListenerSdk interface {
+	OnJoinedGroupAdded(groupInfo model_struct.LocalGroup)
+	OnJoinedGroupDeleted(groupInfo model_struct.LocalGroup)
+	OnGroupMemberAdded(groupMemberInfo model_struct.LocalGroupMember)
+	OnGroupMemberDeleted(groupMemberInfo model_struct.LocalGroupMember)
+	OnGroupApplicationAdded(groupApplication model_struct.LocalGroupRequest)
+	OnGroupApplicationDeleted(groupApplication model_struct.LocalGroupRequest)
+	OnGroupInfoChanged(groupInfo model_struct.LocalGroup)
+	OnGroupDismissed(groupInfo model_struct.LocalGroup)
+	OnGroupMemberInfoChanged(groupMemberInfo model_struct.LocalGroupMember)
+	OnGroupApplicationAccepted(groupApplication model_struct.LocalGroupRequest)
+	OnGroupApplicationRejected(groupApplication model_struct.LocalGroupRequest)
+}
+
+// GroupListenerSdkProvider is implemented by a string listener wrapping a typed one, the
+// events then reach the typed listener as they are, without a JSON round trip.
+type GroupListenerSdkProvider interface {
+	GroupListenerSdk() OnGroupListenerSdk
+}
+
+type onGroupListener struct {
+	onGroupListener func() OnGroupListener
+}
+
+// sdk returns the typed listener behind the string listener, if any.
+func (o *onGroupListener) sdk() OnGroupListenerSdk {
+	if provider, ok := o.onGroupListener().(GroupListenerSdkProvider); ok {
+		return provider.GroupListenerSdk()
+	}
+	return nil
+}
+
+func NewOnGroupListenerSdk(listener func() OnGroupListener) OnGroupListenerSdk {
+	return &onGroupListener{listener}
+}
+
+func (o *onGroupListener) OnJoinedGroupAdded(groupInfo model_struct.LocalGroup) {
+	log.ZDebug(context.Background(), "OnJoinedGroupAdded", "groupInfo", groupInfo)
+	if l := o.sdk(); l != nil {
+		l.OnJoinedGroupAdded(groupInfo)
+		return
+	}
+	o.onGroupListener().OnJoinedGroupAdded(utils.StructToJsonString(groupInfo))
+}
+
+func (o *onGroupListener) OnJoinedGroupDeleted(groupInfo model_struct.LocalGroup) {
+	log.ZDebug(context.Background(), "OnJoinedGroupDeleted", "groupInfo", groupInfo)
+	if l := o.sdk(); l != nil {
+		l.OnJoinedGroupDeleted(groupInfo)
+		return
+	}
+	o.onGroupListener().OnJoinedGroupDeleted(utils.StructToJsonString(groupInfo))
+}
+
+func (o *onGroupListener) OnGroupMemberAdded(groupMemberInfo model_struct.LocalGroupMember) {
+	log.ZDebug(context.Background(), "OnGroupMemberAdded", "groupMemberInfo", groupMemberInfo)
+	if l := o.sdk(); l != nil {
+		l.OnGroupMemberAdded(groupMemberInfo)
+		return
+	}
+	o.onGroupListener().OnGroupMemberAdded(utils.StructToJsonString(groupMemberInfo))
+}
+
+func (o *onGroupListener) OnGroupMemberDeleted(groupMemberInfo model_struct.LocalGroupMember) {
+	log.ZDebug(context.Background(), "OnGroupMemberDeleted", "groupMemberInfo", groupMemberInfo)
+	if l := o.sdk(); l != nil {
+		l.OnGroupMemberDeleted(groupMemberInfo)
+		return
+	}
+	o.onGroupListener().OnGroupMemberDeleted(utils.StructToJsonString(groupMemberInfo))
+}
+
+func (o *onGroupListener) OnGroupApplicationAdded(groupApplication model_struct.LocalGroupRequest) {
+	log.ZDebug(context.Background(), "OnGroupApplicationAdded", "groupApplication", groupApplication)
+	if l := o.sdk(); l != nil {
+		l.OnGroupApplicationAdded(groupApplication)
+		return
+	}
+	o.onGroupListener().OnGroupApplicationAdded(utils.StructToJsonString(groupApplication))
+}
+
+func (o *onGroupListener) OnGroupApplicationDeleted(groupApplication model_struct.LocalGroupRequest) {
+	log.ZDebug(context.Background(), "OnGroupApplicationDeleted", "groupApplication", groupApplication)
+	if l := o.sdk(); l != nil {
+		l.OnGroupApplicationDeleted(groupApplication)
+		return
+	}
+	o.onGroupListener().OnGroupApplicationDeleted(utils.StructToJsonString(groupApplication))
+}
+
+func (o *onGroupListener) OnGroupInfoChanged(groupInfo model_struct.LocalGroup) {
+	log.ZDebug(context.Background(), "OnGroupInfoChanged", "groupInfo", groupInfo)
+	if l := o.sdk(); l != nil {
+		l.OnGroupInfoChanged(groupInfo)
+		return
+	}
+	o.onGroupListener().OnGroupInfoChanged(utils.StructToJsonString(groupInfo))
+}
+
+func (o *onGroupListener) OnGroupDismissed(groupInfo model_struct.LocalGroup) {
+	log.ZDebug(context.Background(), "OnGroupDismissed", "groupInfo", groupInfo)
+	if l := o.sdk(); l != nil {
+		l.OnGroupDismissed(groupInfo)
+		return
+	}
+	o.onGroupListener().OnGroupDismissed(utils.StructToJsonString(groupInfo))
+}
+
+func (o *onGroupListener) OnGroupMemberInfoChanged(groupMemberInfo model_struct.LocalGroupMember) {
+	log.ZDebug(context.Background(), "OnGroupMemberInfoChanged", "groupMemberInfo", groupMemberInfo)
+	if l := o.sdk(); l != nil {
+		l.OnGroupMemberInfoChanged(groupMemberInfo)
+		return
+	}
+	o.onGroupListener().OnGroupMemberInfoChanged(utils.StructToJsonString(groupMemberInfo))
+}
+
+func (o *onGroupListener) OnGroupApplicationAccepted(groupApplication model_struct.LocalGroupRequest) {
+	log.ZDebug(context.Background(), "OnGroupApplicationAccepted", "groupApplication", groupApplication)
+	if l := o.sdk(); l != nil {
+		l.OnGroupApplicationAccepted(groupApplication)
+		return
+	}
+	o.onGroupListener().OnGroupApplicationAccepted(utils.StructToJsonString(groupApplication))
+}
+
+func (o *onGroupListener) OnGroupApplicationRejected(groupApplication model_struct.LocalGroupRequest) {
+	log.ZDebug(context.Background(), "OnGroupApplicationRejected", "groupApplication", groupApplication)
+	if l := o.sdk(); l != nil {
+		l.OnGroupApplicationRejected(groupApplication)
+		return
+	}
+	o.onGroupListener().OnGroupApplicationRejected(utils.StructToJsonString(groupApplication))
+}
+
+type OnUserListenerSdk interface {
+	OnSelfInfoUpdated(userInfo model_struct.LocalUser)
+	OnUserStatusChanged(userOnlineStatus *userPb.OnlineStatus)
+	OnUserCommandAdd(userCommand model_struct.LocalUserCommand)
+	OnUserCommandDelete(userCommand model_struct.LocalUserCommand)
+	OnUserCommandUpdate(userCommand model_struct.LocalUserCommand)
+}
+
+// UserListenerSdkProvider is implemented by a string listener wrapping a typed one, the
+// events then reach the typed listener as they are, without a JSON round trip.
+type UserListenerSdkProvider interface {
+	UserListenerSdk() OnUserListenerSdk
+}
+
+type onUserListener struct {
+	onUserListener func() OnUserListener
+}
+
+// sdk returns the typed listener behind the string listener, if any.
+func (o *onUserListener) sdk() OnUserListenerSdk {
+	if provider, ok := o.onUserListener().(UserListenerSdkProvider); ok {
+		return provider.UserListenerSdk()
+	}
+	return nil
+}
+
+func NewOnUserListenerSdk(listener func() OnUserListener) OnUserListenerSdk {
+	return &onUserListener{listener}
+}
+
+func (o *onUserListener) OnSelfInfoUpdated(userInfo model_struct.LocalUser) {
+	log.ZDebug(context.Background(), "OnSelfInfoUpdated", "userInfo", userInfo)
+	if l := o.sdk(); l != nil {
+		l.OnSelfInfoUpdated(userInfo)
+		return
+	}
+	o.onUserListener().OnSelfInfoUpdated(utils.StructToJsonString(userInfo))
+}
+
+func (o *onUserListener) OnUserStatusChanged(userOnlineStatus *userPb.OnlineStatus) {
+	log.ZDebug(context.Background(), "OnUserStatusChanged", "userOnlineStatus", userOnlineStatus)
+	if l := o.sdk(); l != nil {
+		l.OnUserStatusChanged(userOnlineStatus)
+		return
+	}
+	o.onUserListener().OnUserStatusChanged(utils.StructToJsonString(userOnlineStatus))
+}
+
+func (o *onUserListener) OnUserCommandAdd(userCommand model_struct.LocalUserCommand) {
+	log.ZDebug(context.Background(), "OnUserCommandAdd", "userCommand", userCommand)
+	if l := o.sdk(); l != nil {
+		l.OnUserCommandAdd(userCommand)
+		return
+	}
+	o.onUserListener().OnUserCommandAdd(utils.StructToJsonString(userCommand))
+}
+
+func (o *onUserListener) OnUserCommandDelete(userCommand model_struct.LocalUserCommand) {
+	log.ZDebug(context.Background(), "OnUserCommandDelete", "userCommand", userCommand)
+	if l := o.sdk(); l != nil {
+		l.OnUserCommandDelete(userCommand)
+		return
+	}
+	o.onUserListener().OnUserCommandDelete(utils.StructToJsonString(userCommand))
+}
+
+func (o *onUserListener) OnUserCommandUpdate(userCommand model_struct.LocalUserCommand) {
+	log.ZDebug(context.Background(), "OnUserCommandUpdate", "userCommand", userCommand)
+	if l := o.sdk(); l != nil {
+		l.OnUserCommandUpdate(userCommand)
+		return
+	}
+	o.onUserListener().OnUserCommandUpdate(utils.StructToJsonString(userCommand))
+}
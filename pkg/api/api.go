@@ -1,6 +1,7 @@
 package api
 
 import (
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/server_api_params"
 	"github.com/openimsdk/protocol/auth"
 	"github.com/openimsdk/protocol/conversation"
 	"github.com/openimsdk/protocol/group"
@@ -57,6 +58,11 @@ var (
 	SendMsg                          = newApi[msg.SendMsgReq, msg.SendMsgResp]("/msg/send_msg")
 	GetServerTime                    = newApi[msg.GetServerTimeReq, msg.GetServerTimeResp]("/msg/get_server_time")
 	GetStreamMsg                     = newApi[msg.GetStreamMsgReq, msg.GetStreamMsgResp]("/msg/get_stream_msg")
+	EditMsg                          = newApi[server_api_params.EditMsgReq, server_api_params.EditMsgResp]("/msg/edit_msg")
+	AddMsgReaction                   = newApi[server_api_params.MsgReactionReq, server_api_params.MsgReactionResp]("/msg/add_msg_reaction")
+	RemoveMsgReaction                = newApi[server_api_params.MsgReactionReq, server_api_params.MsgReactionResp]("/msg/remove_msg_reaction")
+	PinMsg                           = newApi[server_api_params.MsgPinReq, server_api_params.MsgPinResp]("/msg/pin_msg")
+	UnpinMsg                         = newApi[server_api_params.MsgPinReq, server_api_params.MsgPinResp]("/msg/unpin_msg")
 )
 
 var (
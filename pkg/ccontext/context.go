@@ -50,6 +50,12 @@ type ContextInfo interface {
 	LogLevel() uint32
 	OperationID() string
 	IsExternalExtensions() bool
+	WsCodec() string
+	Compression() string
+	CompressionThreshold() int
+	Transport() string
+	Outbox() sdk_struct.OutboxConfig
+	Reconnect() sdk_struct.ReconnectConfig
 }
 
 func Info(ctx context.Context) ContextInfo {
@@ -126,6 +132,30 @@ func (i *info) IsExternalExtensions() bool {
 	return i.conf.IsExternalExtensions
 }
 
+func (i *info) WsCodec() string {
+	return i.conf.WsCodec
+}
+
+func (i *info) Compression() string {
+	return i.conf.Compression
+}
+
+func (i *info) CompressionThreshold() int {
+	return i.conf.CompressionThreshold
+}
+
+func (i *info) Transport() string {
+	return i.conf.Transport
+}
+
+func (i *info) Outbox() sdk_struct.OutboxConfig {
+	return i.conf.Outbox
+}
+
+func (i *info) Reconnect() sdk_struct.ReconnectConfig {
+	return i.conf.Reconnect
+}
+
 type apiErrCode struct{}
 
 type ApiErrCodeCallback interface {
@@ -124,6 +124,12 @@ const (
 
 	DeleteMsgsNotification = 2102
 
+	MsgEditNotification = 2103
+
+	MsgReactionNotification = 2104
+
+	MsgPinNotification = 2105
+
 	HasReadReceipt = 2200
 
 	NotificationEnd = 5000
@@ -147,6 +153,15 @@ const (
 	MsgStatusHasDeleted  = 4
 	MsgStatusFiltered    = 5
 
+	//OutboxStatus
+	OutboxQueued         = 1
+	OutboxWaitingNetwork = 2
+	OutboxSending        = 3
+	OutboxRetryWaiting   = 4
+	OutboxSent           = 5
+	OutboxFailed         = 6
+	OutboxExpired        = 7
+
 	//OptionsKey
 	IsHistory                  = "history"
 	IsPersistent               = "persistent"
@@ -188,6 +203,7 @@ const (
 	ConChangeDirect                       = 8
 	NewConDirect                          = 9
 	UpdateMsgFaceUrlAndNickName           = 10
+	UnreadMentionCountChanged             = 11
 
 	HasRead = 1
 	NotRead = 0
@@ -232,6 +248,9 @@ const (
 	GroupFilterAdminAndOrdinaryUsers = 4
 	GroupFilterOwnerAndAdmin         = 5
 
+	GroupMessageReaderFilterRead   = 0 // Readers of a group message
+	GroupMessageReaderFilterUnread = 1 // Members that have not read a group message
+
 	GroupResponseAgree  = 1  // Response to group application: agree
 	GroupResponseRefuse = -1 // Response to group application: refuse
 
@@ -248,6 +267,17 @@ const (
 
 )
 
+const (
+	MentionAtMe  = 1 // Mentions inbox: the message mentions the user, with or without all people
+	MentionAtAll = 2 // Mentions inbox: the message mentions all people
+	MentionQuote = 3 // Mentions inbox: the message quotes a message of the user
+)
+
+const (
+	UserCommandConversationLabel       = 1001 // User command type: a conversation label
+	UserCommandConversationLabelMember = 1002 // User command type: a conversation in a label
+)
+
 const (
 	KeywordMatchOr  = 0 // Keyword match mode: match any keyword
 	KeywordMatchAnd = 1 // Keyword match mode: match all keywords
@@ -0,0 +1,196 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"unicode"
+
+	"gorm.io/gorm"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// The search index is an FTS5 table shadowing the searchable text of every chat log table.
+// Its rowid is derived from the conversation and client message IDs, so a message is located
+// in the index without scanning it. The index is only built when SQLite is compiled with FTS5
+// (the sqlite_fts5 build tag), otherwise keyword search falls back to LIKE scans.
+const (
+	chatLogFtsTable        = "local_chat_log_fts"
+	chatLogFtsVersionTable = "local_chat_log_fts_version"
+
+	// chatLogFtsVersion is bumped whenever the index layout or the text segmentation changes.
+	// A different stored version drops and rebuilds the index when the database is opened.
+	chatLogFtsVersion = 1
+
+	chatLogFtsBatchSize = 500
+)
+
+// searchIndexRowID maps a message to its search index rowid.
+func searchIndexRowID(conversationID, clientMsgID string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(conversationID))
+	h.Write([]byte{0})
+	h.Write([]byte(clientMsgID))
+	return int64(h.Sum64() &^ (1 << 63))
+}
+
+func insertSearchIndex(tx *gorm.DB, conversationID string, messages []*model_struct.LocalChatLog) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	rowIDs := make([]int64, 0, len(messages))
+	for _, message := range messages {
+		rowIDs = append(rowIDs, searchIndexRowID(conversationID, message.ClientMsgID))
+	}
+	if err := tx.Exec(fmt.Sprintf("DELETE FROM `%s` WHERE rowid IN ?", chatLogFtsTable), rowIDs).Error; err != nil {
+		return err
+	}
+	for i, message := range messages {
+		text := utils.SearchableText(message.ContentType, message.Content)
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		if err := tx.Exec(fmt.Sprintf("INSERT INTO `%s` (rowid, conversation_id, client_msg_id, content) VALUES (?, ?, ?, ?)", chatLogFtsTable),
+			rowIDs[i], conversationID, message.ClientMsgID, utils.SegmentSearchText(text)).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The helpers below keep the search index in step with chat log writes. They are called with
+// mRWMutex held and only log failures: the index is derived data and must not fail a write.
+
+func (d *DataBase) indexMessages(ctx context.Context, conversationID string, messages []*model_struct.LocalChatLog) {
+	if !d.searchIndex {
+		return
+	}
+	if err := insertSearchIndex(d.conn.WithContext(ctx), conversationID, messages); err != nil {
+		log.ZWarn(ctx, "index messages failed", err, "conversationID", conversationID)
+	}
+}
+
+// reindexMessages reads the messages matching query back from the chat log and indexes them again.
+func (d *DataBase) reindexMessages(ctx context.Context, conversationID string, query string, args ...interface{}) {
+	if !d.searchIndex {
+		return
+	}
+	var messages []*model_struct.LocalChatLog
+	if err := d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).Select("client_msg_id", "content_type", "content").
+		Where(query, args...).Find(&messages).Error; err != nil {
+		log.ZWarn(ctx, "get messages to reindex failed", err, "conversationID", conversationID)
+		return
+	}
+	d.indexMessages(ctx, conversationID, messages)
+}
+
+func (d *DataBase) unindexMessages(ctx context.Context, conversationID string, clientMsgIDs []string) {
+	if !d.searchIndex || len(clientMsgIDs) == 0 {
+		return
+	}
+	rowIDs := make([]int64, 0, len(clientMsgIDs))
+	for _, clientMsgID := range clientMsgIDs {
+		rowIDs = append(rowIDs, searchIndexRowID(conversationID, clientMsgID))
+	}
+	if err := d.conn.WithContext(ctx).Exec(fmt.Sprintf("DELETE FROM `%s` WHERE rowid IN ?", chatLogFtsTable), rowIDs).Error; err != nil {
+		log.ZWarn(ctx, "unindex messages failed", err, "conversationID", conversationID)
+	}
+}
+
+func (d *DataBase) unindexConversation(ctx context.Context, conversationID string) {
+	if !d.searchIndex {
+		return
+	}
+	if err := d.conn.WithContext(ctx).Exec(fmt.Sprintf("DELETE FROM `%s` WHERE conversation_id = ?", chatLogFtsTable), conversationID).Error; err != nil {
+		log.ZWarn(ctx, "unindex conversation failed", err, "conversationID", conversationID)
+	}
+}
+
+// searchMatchQuery turns the CJK keywords into an FTS5 query, each a phrase of its characters,
+// and returns the other keywords to match with LIKE. The index holds CJK text one character a
+// token, so a CJK keyword matches anywhere in the text, but other text is indexed by word and a
+// word only matches from its start: "world" would miss "helloworld".
+func searchMatchQuery(keywordList []string, keywordListMatchType int) (match string, likeKeywords []string) {
+	var phrases []string
+	for _, keyword := range keywordList {
+		tokens := strings.FieldsFunc(utils.SegmentSearchText(keyword), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+		if len(tokens) == 0 || !isCJKTokens(tokens) {
+			likeKeywords = append(likeKeywords, keyword)
+			continue
+		}
+		phrases = append(phrases, `"`+strings.Join(tokens, " ")+`"`)
+	}
+	if keywordListMatchType == constant.KeywordMatchOr {
+		return strings.Join(phrases, " OR "), likeKeywords
+	}
+	return strings.Join(phrases, " AND "), likeKeywords
+}
+
+func isCJKTokens(tokens []string) bool {
+	for _, token := range tokens {
+		for _, r := range token {
+			if !utils.IsCJK(r) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// useSearchIndex reports whether a search is answered by the index. Keywords left to LIKE are
+// ANDed to the match, OR searches mixing them are answered by the scan instead.
+func (d *DataBase) useSearchIndex(match string, likeKeywords []string, keywordListMatchType int) bool {
+	return d.searchIndex && match != "" && (len(likeKeywords) == 0 || keywordListMatchType != constant.KeywordMatchOr)
+}
+
+// searchMessageByIndex returns the messages of a conversation matching an FTS5 query and containing
+// every like keyword, best ranked first. A count of 0 returns every match.
+func (d *DataBase) searchMessageByIndex(ctx context.Context, contentType []int, senderUserIDList []string, match string, likeKeywords []string, conversationID string, startTime, endTime int64, offset, count int) (result []*model_struct.LocalChatLog, err error) {
+	tableName := utils.GetTableName(conversationID)
+	var sql strings.Builder
+	args := []interface{}{match, conversationID, startTime, endTime, constant.MsgStatusSendFailed, contentType}
+	sql.WriteString(fmt.Sprintf("SELECT t.* FROM `%s` JOIN `%s` t ON t.client_msg_id = `%s`.client_msg_id", chatLogFtsTable, tableName, chatLogFtsTable))
+	sql.WriteString(fmt.Sprintf(" WHERE `%s` MATCH ? AND `%s`.conversation_id = ?", chatLogFtsTable, chatLogFtsTable))
+	sql.WriteString(" AND t.send_time BETWEEN ? AND ? AND t.status <= ? AND t.content_type IN ?")
+	for _, keyword := range likeKeywords {
+		sql.WriteString(" AND t.content LIKE ?")
+		args = append(args, "%"+keyword+"%")
+	}
+	if len(senderUserIDList) != 0 {
+		sql.WriteString(" AND t.send_id IN ?")
+		args = append(args, senderUserIDList)
+	}
+	sql.WriteString(fmt.Sprintf(" ORDER BY bm25(`%s`), t.send_time DESC", chatLogFtsTable))
+	if count > 0 {
+		sql.WriteString(" LIMIT ? OFFSET ?")
+		args = append(args, count, offset)
+	}
+	err = errs.WrapMsg(d.conn.WithContext(ctx).Raw(sql.String(), args...).Scan(&result).Error, "SearchMessage by index failed")
+	return result, err
+}
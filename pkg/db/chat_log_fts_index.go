@@ -0,0 +1,99 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js && sqlite_fts5
+// +build !js,sqlite_fts5
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"gorm.io/gorm"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// searchIndexUnavailable is set once SQLite turned out to lack FTS5, so the databases opened
+// afterwards do not try to rebuild the index again.
+var searchIndexUnavailable atomic.Bool
+
+// initSearchIndex enables the search index, rebuilding it from the chat logs when its version
+// is outdated. Any failure leaves the index disabled and search on the LIKE path.
+func (d *DataBase) initSearchIndex(ctx context.Context, tables []string) {
+	if searchIndexUnavailable.Load() {
+		return
+	}
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if err := d.conn.WithContext(ctx).Exec(fmt.Sprintf("CREATE TABLE IF NOT EXISTS `%s` (version INTEGER)", chatLogFtsVersionTable)).Error; err != nil {
+		log.ZWarn(ctx, "create search index version table failed", err)
+		return
+	}
+	var versions []int
+	if err := d.conn.WithContext(ctx).Raw(fmt.Sprintf("SELECT version FROM `%s`", chatLogFtsVersionTable)).Scan(&versions).Error; err != nil {
+		log.ZWarn(ctx, "get search index version failed", err)
+		return
+	}
+	if len(versions) == 1 && versions[0] == chatLogFtsVersion &&
+		d.conn.WithContext(ctx).Exec(fmt.Sprintf("SELECT rowid FROM `%s` LIMIT 0", chatLogFtsTable)).Error == nil {
+		d.searchIndex = true
+		return
+	}
+	log.ZInfo(ctx, "rebuild search index", "versions", versions, "version", chatLogFtsVersion)
+	if err := d.rebuildSearchIndex(ctx, tables); err != nil {
+		if strings.Contains(err.Error(), "no such module: fts5") {
+			searchIndexUnavailable.Store(true)
+		}
+		log.ZWarn(ctx, "search index is unavailable, use like search", err)
+		return
+	}
+	d.searchIndex = true
+}
+
+func (d *DataBase) rebuildSearchIndex(ctx context.Context, tables []string) error {
+	return d.conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`", chatLogFtsTable)).Error; err != nil {
+			return errs.WrapMsg(err, "drop search index failed")
+		}
+		if err := tx.Exec(fmt.Sprintf("CREATE VIRTUAL TABLE `%s` USING fts5(conversation_id UNINDEXED, client_msg_id UNINDEXED, content, tokenize = 'unicode61 remove_diacritics 2')", chatLogFtsTable)).Error; err != nil {
+			return errs.WrapMsg(err, "create search index failed")
+		}
+		for _, table := range tables {
+			if !strings.HasPrefix(table, constant.ChatLogsTableNamePre) {
+				continue
+			}
+			conversationID := strings.TrimPrefix(table, constant.ChatLogsTableNamePre)
+			var messages []*model_struct.LocalChatLog
+			err := tx.Table(table).Select("client_msg_id", "content_type", "content").
+				FindInBatches(&messages, chatLogFtsBatchSize, func(batch *gorm.DB, _ int) error {
+					return insertSearchIndex(batch, conversationID, messages)
+				}).Error
+			if err != nil {
+				return errs.WrapMsg(err, "index chat logs failed", "table", table)
+			}
+		}
+		if err := tx.Exec(fmt.Sprintf("DELETE FROM `%s`", chatLogFtsVersionTable)).Error; err != nil {
+			return errs.Wrap(err)
+		}
+		return errs.Wrap(tx.Exec(fmt.Sprintf("INSERT INTO `%s` (version) VALUES (?)", chatLogFtsVersionTable), chatLogFtsVersion).Error)
+	})
+}
@@ -0,0 +1,30 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js && !sqlite_fts5
+// +build !js,!sqlite_fts5
+
+package db
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/log"
+)
+
+// initSearchIndex leaves the search index disabled, SQLite is built without FTS5 and keyword
+// search uses LIKE scans. Build with the sqlite_fts5 tag to enable it.
+func (d *DataBase) initSearchIndex(ctx context.Context, _ []string) {
+	log.ZDebug(ctx, "sqlite is built without fts5, use like search")
+}
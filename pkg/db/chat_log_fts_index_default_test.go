@@ -0,0 +1,43 @@
+//go:build !js && !sqlite_fts5
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+)
+
+func TestSearchWithoutIndex(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewDataBase(ctx, "searchUser", t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close(ctx)
+	if db.searchIndex {
+		t.Fatal("search index enabled without fts5")
+	}
+	var tables []string
+	if err := db.conn.Raw("SELECT name FROM sqlite_master WHERE name LIKE ?", chatLogFtsTable+"%").Scan(&tables).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 0 {
+		t.Fatalf("search index tables created %v", tables)
+	}
+	conversationID := "si_a_b"
+	if err := db.BatchInsertMessageList(ctx, conversationID, []*model_struct.LocalChatLog{
+		textMessage("m1", 1, "今天天气很好"),
+		textMessage("m2", 2, "Hello OpenIM"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if ids := searchIDs(t, db, conversationID, "天气"); fmt.Sprint(ids) != "[m1]" {
+		t.Fatalf("like search got %v", ids)
+	}
+	if ids := searchIDs(t, db, conversationID, "openim"); fmt.Sprint(ids) != "[m2]" {
+		t.Fatalf("like search got %v", ids)
+	}
+}
@@ -0,0 +1,102 @@
+//go:build !js && sqlite_fts5
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+func TestSearchIndex(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewDataBase(ctx, "searchUser", dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !db.searchIndex {
+		t.Fatal("search index disabled")
+	}
+	conversationID := "si_a_b"
+	if err := db.BatchInsertMessageList(ctx, conversationID, []*model_struct.LocalChatLog{
+		textMessage("m1", 1, "今天天气很好，我们去公园吧"),
+		textMessage("m2", 2, "Hello OpenIM, the weather is nice"),
+		textMessage("m3", 3, "公园 weather weather weather"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if ids := searchIDs(t, db, conversationID, "公园"); fmt.Sprint(ids) != "[m3 m1]" && fmt.Sprint(ids) != "[m1 m3]" {
+		t.Fatalf("cjk search got %v", ids)
+	}
+	if ids := searchIDs(t, db, conversationID, "天气"); fmt.Sprint(ids) != "[m1]" {
+		t.Fatalf("cjk search got %v", ids)
+	}
+	if ids := searchIDs(t, db, conversationID, "weather"); fmt.Sprint(ids) != "[m3 m2]" {
+		t.Fatalf("latin search got %v", ids)
+	}
+	if ids := searchIDs(t, db, conversationID, "openi"); fmt.Sprint(ids) != "[m2]" {
+		t.Fatalf("prefix search got %v", ids)
+	}
+	// latin keywords keep matching inside words, the index only splits them at word boundaries
+	if err := db.InsertMessage(ctx, conversationID, textMessage("m5", 5, "helloworld 公园")); err != nil {
+		t.Fatal(err)
+	}
+	if ids := searchIDs(t, db, conversationID, "world"); fmt.Sprint(ids) != "[m5]" {
+		t.Fatalf("infix search got %v", ids)
+	}
+	if ids := searchIDs(t, db, conversationID, "公园", "eather"); fmt.Sprint(ids) != "[m3]" {
+		t.Fatalf("mixed search got %v", ids)
+	}
+	if err := db.DeleteConversationMsgs(ctx, conversationID, []string{"m5"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.UpdateColumnsMessage(ctx, conversationID, "m2", map[string]interface{}{
+		"content": utils.StructToJsonString(sdk_struct.TextElem{Content: "edited"})}); err != nil {
+		t.Fatal(err)
+	}
+	if ids := searchIDs(t, db, conversationID, "openim"); len(ids) != 0 {
+		t.Fatalf("edited message still found %v", ids)
+	}
+	if err := db.UpdateMessageBySeq(ctx, conversationID, &model_struct.LocalChatLog{Seq: 1, ContentType: constant.RevokeNotification,
+		Content: "{}"}); err != nil {
+		t.Fatal(err)
+	}
+	if ids := searchIDs(t, db, conversationID, "天气"); len(ids) != 0 {
+		t.Fatalf("revoked message still found %v", ids)
+	}
+	if err := db.DeleteConversationMsgs(ctx, conversationID, []string{"m3"}); err != nil {
+		t.Fatal(err)
+	}
+	if ids := searchIDs(t, db, conversationID, "公园"); len(ids) != 0 {
+		t.Fatalf("deleted message still found %v", ids)
+	}
+
+	// an outdated index version is rebuilt from the chat logs when the database is opened again
+	if err := db.InsertMessage(ctx, conversationID, textMessage("m4", 4, "rebuild 索引")); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.conn.Exec(fmt.Sprintf("UPDATE `%s` SET version = 0", chatLogFtsVersionTable)).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.conn.Exec(fmt.Sprintf("DELETE FROM `%s`", chatLogFtsTable)).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	db, err = NewDataBase(ctx, "searchUser", dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close(ctx)
+	if ids := searchIDs(t, db, conversationID, "索引"); fmt.Sprint(ids) != "[m4]" {
+		t.Fatalf("rebuilt index search got %v", ids)
+	}
+}
@@ -0,0 +1,59 @@
+//go:build !js
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+func TestSearchMatchQuery(t *testing.T) {
+	cases := []struct {
+		keywords  []string
+		matchType int
+		want      string
+		like      []string
+	}{
+		{[]string{"hello"}, constant.KeywordMatchOr, ``, []string{"hello"}},
+		{[]string{"你好"}, constant.KeywordMatchOr, `"你 好"`, nil},
+		{[]string{"open im", "世界"}, constant.KeywordMatchAnd, `"世 界"`, []string{"open im"}},
+		{[]string{"你好", "世界！"}, constant.KeywordMatchOr, `"你 好" OR "世 界"`, nil},
+		{[]string{"索引 rebuild", `"*`}, constant.KeywordMatchOr, ``, []string{"索引 rebuild", `"*`}},
+	}
+	for _, c := range cases {
+		match, like := searchMatchQuery(c.keywords, c.matchType)
+		if match != c.want || fmt.Sprint(like) != fmt.Sprint(c.like) {
+			t.Errorf("searchMatchQuery(%q) = %s %q, want %s %q", c.keywords, match, like, c.want, c.like)
+		}
+	}
+}
+
+func textMessage(clientMsgID string, seq int64, text string) *model_struct.LocalChatLog {
+	return &model_struct.LocalChatLog{
+		ClientMsgID: clientMsgID,
+		SendID:      "sendID",
+		ContentType: constant.Text,
+		Content:     utils.StructToJsonString(sdk_struct.TextElem{Content: text}),
+		Status:      constant.MsgStatusSendSuccess,
+		Seq:         seq,
+		SendTime:    1000 + seq,
+	}
+}
+
+func searchIDs(t *testing.T, db *DataBase, conversationID string, keywords ...string) []string {
+	list, err := db.SearchMessageByKeyword(context.Background(), []int{constant.Text}, nil, keywords, constant.KeywordMatchAnd, conversationID, 0, 1<<62, 0, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, v := range list {
+		ids = append(ids, v.ClientMsgID)
+	}
+	return ids
+}
@@ -63,6 +63,7 @@ func (d *DataBase) initChatLog(ctx context.Context, conversationID string) error
                 is_react NUMERIC,
                 is_external_extensions NUMERIC,
                 msg_first_modify_time INTEGER,
+                thread_root_id CHAR(64) DEFAULT '',
                 PRIMARY KEY (client_msg_id)
             );`, tableName)
 
@@ -77,6 +78,9 @@ func (d *DataBase) initChatLog(ctx context.Context, conversationID string) error
 		if result.Error != nil {
 			return errs.WrapMsg(result.Error, "Create index_send_time failed", "table", tableName, "index", "index_send_time_"+conversationID)
 		}
+		if err := createThreadRootIndex(d.conn, tableName); err != nil {
+			return errs.WrapMsg(err, "Create index_thread_root_id failed", "table", tableName)
+		}
 		d.tableChecker.UpdateTable(tableName)
 	}
 	return nil
@@ -93,13 +97,22 @@ func (d *DataBase) UpdateMessage(ctx context.Context, conversationID string, c *
 	if t.RowsAffected == 0 {
 		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update ")
 	}
+	if t.Error == nil && (c.Content != "" || c.ContentType != 0) {
+		d.reindexMessages(ctx, conversationID, "client_msg_id = ?", c.ClientMsgID)
+	}
 	return errs.WrapMsg(t.Error, "UpdateMessage failed")
 }
 
 func (d *DataBase) UpdateMessageBySeq(ctx context.Context, conversationID string, c *model_struct.LocalChatLog) error {
 	d.mRWMutex.Lock()
 	defer d.mRWMutex.Unlock()
-	return errs.WrapMsg(d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).Where("seq=?", c.Seq).Updates(c).Error, "UpdateMessage failed")
+	if err := d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).Where("seq=?", c.Seq).Updates(c).Error; err != nil {
+		return errs.WrapMsg(err, "UpdateMessage failed")
+	}
+	if c.Content != "" || c.ContentType != 0 {
+		d.reindexMessages(ctx, conversationID, "seq = ?", c.Seq)
+	}
+	return nil
 }
 
 func (d *DataBase) BatchInsertMessageList(ctx context.Context, conversationID string, MessageList []*model_struct.LocalChatLog) error {
@@ -114,13 +127,21 @@ func (d *DataBase) BatchInsertMessageList(ctx context.Context, conversationID st
 	}
 	d.mRWMutex.Lock()
 	defer d.mRWMutex.Unlock()
-	return errs.WrapMsg(d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).Create(MessageList).Error, "BatchInsertMessageList failed")
+	if err := d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).Create(MessageList).Error; err != nil {
+		return errs.WrapMsg(err, "BatchInsertMessageList failed")
+	}
+	d.indexMessages(ctx, conversationID, MessageList)
+	return nil
 }
 
 func (d *DataBase) InsertMessage(ctx context.Context, conversationID string, Message *model_struct.LocalChatLog) error {
 	d.mRWMutex.Lock()
 	defer d.mRWMutex.Unlock()
-	return errs.WrapMsg(d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).Create(Message).Error, "InsertMessage failed")
+	if err := d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).Create(Message).Error; err != nil {
+		return errs.WrapMsg(err, "InsertMessage failed")
+	}
+	d.indexMessages(ctx, conversationID, []*model_struct.LocalChatLog{Message})
+	return nil
 }
 func (d *DataBase) GetMessage(ctx context.Context, conversationID string, clientMsgID string) (*model_struct.LocalChatLog, error) {
 	err := d.initChatLog(ctx, conversationID)
@@ -192,24 +213,34 @@ func (d *DataBase) GetMessageList(ctx context.Context, conversationID string, co
 func (d *DataBase) DeleteConversationAllMessages(ctx context.Context, conversationID string) error {
 	d.mRWMutex.Lock()
 	defer d.mRWMutex.Unlock()
+	d.unindexConversation(ctx, conversationID)
 	return errs.WrapMsg(d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).Where("1 = 1").Delete(model_struct.LocalChatLog{}).Error, "DeleteConversationAllMessages failed")
 }
 
 func (d *DataBase) MarkDeleteConversationAllMessages(ctx context.Context, conversationID string) error {
 	d.mRWMutex.Lock()
 	defer d.mRWMutex.Unlock()
+	d.unindexConversation(ctx, conversationID)
 	return errs.WrapMsg(d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).Where("1 = 1").Updates(model_struct.LocalChatLog{Status: constant.MsgStatusHasDeleted}).Error, "DeleteConversationAllMessages failed")
 }
 
 func (d *DataBase) DeleteConversationMsgs(ctx context.Context, conversationID string, msgIDs []string) error {
 	d.mRWMutex.Lock()
 	defer d.mRWMutex.Unlock()
+	d.unindexMessages(ctx, conversationID, msgIDs)
 	return errs.WrapMsg(d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).Where("client_msg_id IN ?", msgIDs).Delete(model_struct.LocalChatLog{}).Error, "DeleteConversationMsgs failed")
 }
 
 func (d *DataBase) DeleteConversationMsgsBySeqs(ctx context.Context, conversationID string, seqs []int64) error {
 	d.mRWMutex.Lock()
 	defer d.mRWMutex.Unlock()
+	if d.searchIndex {
+		var msgIDs []string
+		if err := d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).Where("seq IN ?", seqs).Pluck("client_msg_id", &msgIDs).Error; err != nil {
+			log.ZWarn(ctx, "get messages to unindex failed", err, "conversationID", conversationID)
+		}
+		d.unindexMessages(ctx, conversationID, msgIDs)
+	}
 	return errs.WrapMsg(d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).Where("seq IN ?", seqs).Delete(model_struct.LocalChatLog{}).Error, "DeleteConversationMsgs failed")
 }
 
@@ -235,6 +266,9 @@ func (d *DataBase) SearchMessageByContentType(ctx context.Context, contentType [
 func (d *DataBase) SearchMessageByKeyword(ctx context.Context, contentType []int, senderUserIDList []string, keywordList []string, keywordListMatchType int, conversationID string, startTime, endTime int64, offset, count int) (result []*model_struct.LocalChatLog, err error) {
 	d.mRWMutex.RLock()
 	defer d.mRWMutex.RUnlock()
+	if match, likeKeywords := searchMatchQuery(keywordList, keywordListMatchType); d.useSearchIndex(match, likeKeywords, keywordListMatchType) {
+		return d.searchMessageByIndex(ctx, contentType, senderUserIDList, match, likeKeywords, conversationID, startTime, endTime, offset, count)
+	}
 
 	var condition strings.Builder
 	var subCondition strings.Builder
@@ -286,6 +320,9 @@ func (d *DataBase) SearchMessageByKeyword(ctx context.Context, contentType []int
 func (d *DataBase) SearchMessageByContentTypeAndKeyword(ctx context.Context, contentType []int, conversationID string, senderUserIDList []string, keywordList []string, keywordListMatchType int, startTime, endTime int64) (result []*model_struct.LocalChatLog, err error) {
 	d.mRWMutex.RLock()
 	defer d.mRWMutex.RUnlock()
+	if match, likeKeywords := searchMatchQuery(keywordList, keywordListMatchType); d.useSearchIndex(match, likeKeywords, keywordListMatchType) {
+		return d.searchMessageByIndex(ctx, contentType, senderUserIDList, match, likeKeywords, conversationID, startTime, endTime, 0, 0)
+	}
 
 	var condition strings.Builder
 	var subCondition strings.Builder
@@ -351,6 +388,11 @@ func (d *DataBase) UpdateColumnsMessage(ctx context.Context, conversationID, Cli
 	if t.RowsAffected == 0 {
 		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
 	}
+	_, content := args["content"]
+	_, contentType := args["content_type"]
+	if t.Error == nil && (content || contentType) {
+		d.reindexMessages(ctx, conversationID, "client_msg_id = ?", ClientMsgID)
+	}
 	return errs.WrapMsg(t.Error, "UpdateColumnsConversation failed")
 }
 func (d *DataBase) SearchAllMessageByContentType(ctx context.Context, conversationID string, contentType int) (result []*model_struct.LocalChatLog, err error) {
@@ -0,0 +1,155 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// A reply records the root of its thread in thread_root_id, indexed in every chat log table.
+// Revoked and deleted replies stay in the chat log, so thread queries leave them out.
+const threadReplyCondition = "thread_root_id IN ? AND status < ? AND content_type != ?"
+
+// modelChatLogTable is the table the base migration creates for the LocalChatLog model.
+const modelChatLogTable = "local_chat_logs"
+
+func isChatLogTable(table string) bool {
+	return strings.HasPrefix(table, constant.ChatLogsTableNamePre) || table == modelChatLogTable
+}
+
+func threadRootIndex(table string) string {
+	return "index_thread_root_id_" + strings.TrimPrefix(table, constant.ChatLogsTableNamePre)
+}
+
+func createThreadRootIndex(tx *gorm.DB, table string) error {
+	return tx.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS `%s` ON `%s` (thread_root_id)", threadRootIndex(table), table)).Error
+}
+
+// addThreadRoots adds the thread root to the chat log tables created before threads, and fills
+// it in for the quotes they hold.
+func addThreadRoots(tx *gorm.DB) error {
+	tables, err := tx.Migrator().GetTables()
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if !isChatLogTable(table) {
+			continue
+		}
+		if !tx.Migrator().HasColumn(table, "thread_root_id") {
+			if err := tx.Exec(fmt.Sprintf("ALTER TABLE `%s` ADD COLUMN thread_root_id CHAR(64) DEFAULT ''", table)).Error; err != nil {
+				return errs.WrapMsg(err, "add thread_root_id failed", "table", table)
+			}
+		}
+		if err := createThreadRootIndex(tx, table); err != nil {
+			return errs.WrapMsg(err, "create thread root index failed", "table", table)
+		}
+		var quotes []*model_struct.LocalChatLog
+		if err := tx.Table(table).Select("client_msg_id", "content").Where("content_type = ?", constant.Quote).Find(&quotes).Error; err != nil {
+			return errs.WrapMsg(err, "get quotes failed", "table", table)
+		}
+		for _, quote := range quotes {
+			root := utils.ThreadRootID(constant.Quote, quote.Content)
+			if root == "" {
+				continue
+			}
+			if err := tx.Table(table).Where("client_msg_id = ?", quote.ClientMsgID).Update("thread_root_id", root).Error; err != nil {
+				return errs.WrapMsg(err, "set thread root failed", "table", table)
+			}
+		}
+	}
+	return nil
+}
+
+func dropThreadRoots(tx *gorm.DB) error {
+	tables, err := tx.Migrator().GetTables()
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if !isChatLogTable(table) {
+			continue
+		}
+		if err := tx.Exec(fmt.Sprintf("DROP INDEX IF EXISTS `%s`", threadRootIndex(table))).Error; err != nil {
+			return err
+		}
+		if tx.Migrator().HasColumn(table, "thread_root_id") {
+			if err := tx.Exec(fmt.Sprintf("ALTER TABLE `%s` DROP COLUMN thread_root_id", table)).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (d *DataBase) GetThreadMessages(ctx context.Context, conversationID, rootClientMsgID string, startTime int64, startClientMsgID string, count int) (result []*model_struct.LocalChatLog, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	err = d.conn.WithContext(ctx).Table(utils.GetTableName(conversationID)).
+		Where(threadReplyCondition+" AND (send_time > ? OR (send_time = ? AND client_msg_id > ?))", []string{rootClientMsgID}, constant.MsgStatusHasDeleted, constant.RevokeNotification,
+			startTime, startTime, startClientMsgID).
+		Order("send_time ASC, client_msg_id ASC").Limit(count).Find(&result).Error
+	return result, errs.WrapMsg(err, "GetThreadMessages failed")
+}
+
+func (d *DataBase) GetThreadSummaries(ctx context.Context, conversationID string, rootClientMsgIDs []string) ([]*model_struct.LocalThreadSummary, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if len(rootClientMsgIDs) == 0 {
+		return nil, nil
+	}
+	tableName := utils.GetTableName(conversationID)
+	var counts []struct {
+		ThreadRootID string
+		ReplyCount   int64
+	}
+	err := d.conn.WithContext(ctx).Table(tableName).Select("thread_root_id, COUNT(*) AS reply_count").
+		Where(threadReplyCondition, rootClientMsgIDs, constant.MsgStatusHasDeleted, constant.RevokeNotification).
+		Group("thread_root_id").Scan(&counts).Error
+	if err != nil {
+		return nil, errs.WrapMsg(err, "GetThreadSummaries count failed")
+	}
+	if len(counts) == 0 {
+		return nil, nil
+	}
+	var latest []*model_struct.LocalChatLog
+	err = d.conn.WithContext(ctx).Raw(fmt.Sprintf("SELECT * FROM (SELECT *, ROW_NUMBER() OVER (PARTITION BY thread_root_id ORDER BY send_time DESC) AS thread_rank FROM `%s` WHERE %s) WHERE thread_rank = 1",
+		tableName, threadReplyCondition), rootClientMsgIDs, constant.MsgStatusHasDeleted, constant.RevokeNotification).Scan(&latest).Error
+	if err != nil {
+		return nil, errs.WrapMsg(err, "GetThreadSummaries latest reply failed")
+	}
+	latestMap := make(map[string]*model_struct.LocalChatLog, len(latest))
+	for _, reply := range latest {
+		latestMap[reply.ThreadRootID] = reply
+	}
+	summaries := make([]*model_struct.LocalThreadSummary, 0, len(counts))
+	for _, c := range counts {
+		summaries = append(summaries, &model_struct.LocalThreadSummary{RootClientMsgID: c.ThreadRootID, ReplyCount: c.ReplyCount, LatestReply: latestMap[c.ThreadRootID]})
+	}
+	return summaries, nil
+}
@@ -0,0 +1,23 @@
+//go:build !js
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/db_interface"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/dbtest"
+)
+
+func TestConformance(t *testing.T) {
+	dbtest.Run(t, func(t *testing.T, loginUserID string) db_interface.DataBase {
+		ctx := context.Background()
+		db, err := NewDataBase(ctx, loginUserID, t.TempDir(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close(ctx) })
+		return db
+	})
+}
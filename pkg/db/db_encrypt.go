@@ -0,0 +1,271 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mattn/go-sqlite3"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// Encryption relies on SQLCipher. The sqlite3 driver must be linked against it, e.g. built with
+// the libsqlite3 tag and CGO flags pointing at libsqlcipher; plain SQLite reports
+// ErrDBEncryptNotSupport before the database file is touched.
+
+// sqliteHeader starts every plaintext SQLite database file. Encrypted files look random.
+var sqliteHeader = []byte("SQLite format 3\x00")
+
+// keyConnector opens sqlite connections that are unlocked with key before first use,
+// as SQLCipher requires the key to be set on every new connection.
+type keyConnector struct {
+	dsn string
+	key string
+}
+
+func (k *keyConnector) Connect(_ context.Context) (driver.Conn, error) {
+	return k.Driver().Open(k.dsn)
+}
+
+func (k *keyConnector) Driver() driver.Driver {
+	return &sqlite3.SQLiteDriver{ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+		if k.key == "" {
+			return nil
+		}
+		_, err := conn.Exec("PRAGMA key = "+quoteKey(k.key), nil)
+		return err
+	}}
+}
+
+func openKeyDB(dsn, key string) *sql.DB {
+	return sql.OpenDB(&keyConnector{dsn: dsn, key: key})
+}
+
+func quoteKey(key string) string {
+	return "'" + strings.ReplaceAll(key, "'", "''") + "'"
+}
+
+// cipherSupported reports whether the linked SQLite is SQLCipher.
+func cipherSupported(ctx context.Context) (bool, error) {
+	db := openKeyDB(":memory:", "")
+	defer db.Close()
+	var version string
+	err := db.QueryRowContext(ctx, "PRAGMA cipher_version").Scan(&version)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return version != "", nil
+}
+
+func checkCipherSupported(ctx context.Context) error {
+	ok, err := cipherSupported(ctx)
+	if err != nil {
+		return errs.WrapMsg(err, "check sqlcipher failed")
+	}
+	if !ok {
+		return sdkerrs.ErrDBEncryptNotSupport.WrapMsg("sqlite is not built with sqlcipher")
+	}
+	return nil
+}
+
+// isPlaintextDatabase reports whether path holds an unencrypted SQLite database.
+// A missing or empty file is not plaintext: it is created encrypted.
+func isPlaintextDatabase(path string) (bool, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	header := make([]byte, len(sqliteHeader))
+	if _, err := io.ReadFull(f, header); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return false, nil
+		}
+		return false, err
+	}
+	return bytes.Equal(header, sqliteHeader), nil
+}
+
+// checkDatabaseKey reads the schema, which fails when the key does not match the file.
+func checkDatabaseKey(ctx context.Context, db *sql.DB, encrypted bool) error {
+	var count int
+	if err := db.QueryRowContext(ctx, "SELECT count(*) FROM sqlite_master").Scan(&count); err != nil {
+		if !encrypted {
+			return sdkerrs.ErrDBKey.WrapMsg("database is encrypted, a key is required: " + err.Error())
+		}
+		return sdkerrs.ErrDBKey.WrapMsg("database key is wrong: " + err.Error())
+	}
+	return nil
+}
+
+// exportDatabase copies src unlocked with srcKey into a new dst encrypted with dstKey,
+// an empty dstKey writes plaintext. src is left untouched.
+func exportDatabase(ctx context.Context, src, srcKey, dst, dstKey string) error {
+	if err := os.Remove(dst); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	db := openKeyDB(src, srcKey)
+	defer db.Close()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := checkDatabaseKey(ctx, db, srcKey != ""); err != nil {
+		return err
+	}
+	var userVersion int
+	if err := conn.QueryRowContext(ctx, "PRAGMA user_version").Scan(&userVersion); err != nil {
+		return err
+	}
+	if _, err := conn.ExecContext(ctx, "ATTACH DATABASE ? AS export KEY ?", dst, dstKey); err != nil {
+		return err
+	}
+	if _, err := conn.ExecContext(ctx, "SELECT sqlcipher_export('export')"); err != nil {
+		_, _ = conn.ExecContext(ctx, "DETACH DATABASE export")
+		return err
+	}
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("PRAGMA export.user_version = %d", userVersion)); err != nil {
+		_, _ = conn.ExecContext(ctx, "DETACH DATABASE export")
+		return err
+	}
+	_, err = conn.ExecContext(ctx, "DETACH DATABASE export")
+	return err
+}
+
+// replaceDatabase re-encrypts the database file at path from oldKey to newKey. The copy is
+// written next to it and renamed over the original only once complete, so a failure leaves
+// the original readable with oldKey.
+func replaceDatabase(ctx context.Context, path, oldKey, newKey string) error {
+	tmp := path + ".rekey"
+	if err := exportDatabase(ctx, path, oldKey, tmp, newKey); err != nil {
+		_ = os.Remove(tmp)
+		if sdkerrs.ErrDBKey.Is(err) {
+			return err
+		}
+		return sdkerrs.ErrDBMigrate.WrapMsg(err.Error())
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return sdkerrs.ErrDBMigrate.WrapMsg(err.Error())
+	}
+	return nil
+}
+
+// prepareEncryptedDatabase makes sure the file at path can be opened with key,
+// encrypting an existing plaintext database once.
+func prepareEncryptedDatabase(ctx context.Context, path, key string) error {
+	if err := checkCipherSupported(ctx); err != nil {
+		return err
+	}
+	plaintext, err := isPlaintextDatabase(path)
+	if err != nil {
+		return errs.WrapMsg(err, "read database header failed")
+	}
+	if !plaintext {
+		return nil
+	}
+	log.ZInfo(ctx, "encrypt plaintext database", "path", path)
+	return replaceDatabase(ctx, path, "", key)
+}
+
+// Rekey re-encrypts the open database with key; an empty key decrypts it. The database is
+// exported to a copy while it stays open, and the copy replaces it only once it opens with the
+// new key. Any failure leaves the database open with the previous key.
+func (d *DataBase) Rekey(ctx context.Context, key string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if key == d.key {
+		return nil
+	}
+	if err := checkCipherSupported(ctx); err != nil {
+		return err
+	}
+	tmp := d.dbPath + ".rekey"
+	if err := exportDatabase(ctx, d.dbPath, d.key, tmp, key); err != nil {
+		_ = os.Remove(tmp)
+		if sdkerrs.ErrDBKey.Is(err) {
+			return err
+		}
+		return sdkerrs.ErrDBMigrate.WrapMsg(err.Error())
+	}
+	return d.swapDatabase(ctx, tmp, key)
+}
+
+// swapDatabase replaces the open database with the copy at tmp, unlocked with key. The copy is
+// checked before the database is closed, and the original is put back and reopened when the
+// copy does not open after all.
+func (d *DataBase) swapDatabase(ctx context.Context, tmp, key string) error {
+	defer os.Remove(tmp)
+	copyDB := openKeyDB(tmp, key)
+	err := checkDatabaseKey(ctx, copyDB, key != "")
+	_ = copyDB.Close()
+	if err != nil {
+		return sdkerrs.ErrDBMigrate.WrapMsg("open database copy failed: " + err.Error())
+	}
+	if err := d.closeConn(ctx); err != nil {
+		return sdkerrs.ErrDBMigrate.WrapMsg("close database failed: " + err.Error())
+	}
+	backup := d.dbPath + ".bak"
+	if err := os.Rename(d.dbPath, backup); err != nil {
+		return d.reopen(ctx, sdkerrs.ErrDBMigrate.WrapMsg(err.Error()))
+	}
+	oldKey := d.key
+	if err := os.Rename(tmp, d.dbPath); err != nil {
+		_ = os.Rename(backup, d.dbPath)
+		return d.reopen(ctx, sdkerrs.ErrDBMigrate.WrapMsg(err.Error()))
+	}
+	d.key = key
+	if err := d.openConn(ctx); err != nil {
+		log.ZWarn(ctx, "open rekeyed database failed, restore it", err, "path", d.dbPath)
+		d.key = oldKey
+		if err := os.Rename(backup, d.dbPath); err != nil {
+			return sdkerrs.ErrDBMigrate.WrapMsg("restore database failed: " + err.Error())
+		}
+		return d.reopen(ctx, sdkerrs.ErrDBMigrate.WrapMsg(err.Error()))
+	}
+	if err := os.Remove(backup); err != nil {
+		log.ZWarn(ctx, "remove database backup failed", err, "path", backup)
+	}
+	return nil
+}
+
+// reopen opens the database again with its current key after a failed swap and returns cause.
+func (d *DataBase) reopen(ctx context.Context, cause error) error {
+	if err := d.openConn(ctx); err != nil {
+		return sdkerrs.ErrDBMigrate.WrapMsg("reopen database failed: " + err.Error())
+	}
+	return cause
+}
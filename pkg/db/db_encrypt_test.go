@@ -0,0 +1,191 @@
+//go:build !js
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+)
+
+func dbFile(dir, userID string) string {
+	return filepath.Join(dir, "OpenIM_"+constant.BigVersion+"_"+userID+".db")
+}
+
+func notificationSeq(ctx context.Context, db *DataBase, conversationID string) (int64, error) {
+	seqs, err := db.GetNotificationAllSeqs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, seq := range seqs {
+		if seq.ConversationID == conversationID {
+			return seq.Seq, nil
+		}
+	}
+	return 0, nil
+}
+
+func TestIsPlaintextDatabase(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	path := dbFile(dir, "plainUser")
+	if plaintext, err := isPlaintextDatabase(path); err != nil || plaintext {
+		t.Fatalf("missing file plaintext %v, %v", plaintext, err)
+	}
+	db, err := NewDataBase(ctx, "plainUser", dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if plaintext, err := isPlaintextDatabase(path); err != nil || !plaintext {
+		t.Fatalf("sqlite file plaintext %v, %v", plaintext, err)
+	}
+	garbage := filepath.Join(dir, "garbage.db")
+	if err := os.WriteFile(garbage, bytes.Repeat([]byte{0xa5}, 4096), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if plaintext, err := isPlaintextDatabase(garbage); err != nil || plaintext {
+		t.Fatalf("random file plaintext %v, %v", plaintext, err)
+	}
+}
+
+func TestEncryptNotSupported(t *testing.T) {
+	ctx := context.Background()
+	if ok, err := cipherSupported(ctx); err != nil || ok {
+		t.Skip("sqlite is built with sqlcipher")
+	}
+	dir := t.TempDir()
+	db, err := NewDataBase(ctx, "plainUser", dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	before, err := os.ReadFile(dbFile(dir, "plainUser"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewDataBase(ctx, "plainUser", dir, 0, WithKey("secret")); !sdkerrs.ErrDBEncryptNotSupport.Is(err) {
+		t.Fatalf("open with key: %v, want ErrDBEncryptNotSupport", err)
+	}
+	after, err := os.ReadFile(dbFile(dir, "plainUser"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(before, after) {
+		t.Fatal("plaintext database changed by a failed encryption")
+	}
+}
+
+func TestEncryptedDatabase(t *testing.T) {
+	ctx := context.Background()
+	if ok, err := cipherSupported(ctx); err != nil || !ok {
+		t.Skip("sqlite is built without sqlcipher")
+	}
+	dir := t.TempDir()
+	path := dbFile(dir, "cipherUser")
+
+	// a plaintext database is encrypted in place on the first keyed open
+	db, err := NewDataBase(ctx, "cipherUser", dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SetNotificationSeq(ctx, "n_1", 7); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	db, err = NewDataBase(ctx, "cipherUser", dir, 0, WithKey("first"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plaintext, _ := isPlaintextDatabase(path); plaintext {
+		t.Fatal("database is still plaintext")
+	}
+	if seq, err := notificationSeq(ctx, db, "n_1"); err != nil || seq != 7 {
+		t.Fatalf("seq after migration %d, %v", seq, err)
+	}
+
+	if err := db.Rekey(ctx, "second"); err != nil {
+		t.Fatal(err)
+	}
+	if seq, err := notificationSeq(ctx, db, "n_1"); err != nil || seq != 7 {
+		t.Fatalf("seq after rekey %d, %v", seq, err)
+	}
+	if err := db.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"", "first"} {
+		if _, err := NewDataBase(ctx, "cipherUser", dir, 0, WithKey(key)); !sdkerrs.ErrDBKey.Is(err) {
+			t.Fatalf("open with key %q: %v, want ErrDBKey", key, err)
+		}
+	}
+	db, err = NewDataBase(ctx, "cipherUser", dir, 0, WithKey("second"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seq, err := notificationSeq(ctx, db, "n_1"); err != nil || seq != 7 {
+		t.Fatalf("seq after reopen %d, %v", seq, err)
+	}
+	_ = db.Close(ctx)
+}
+
+func TestRekeySwapDatabase(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	path := dbFile(dir, "swapUser")
+	db, err := NewDataBase(ctx, "swapUser", dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close(ctx)
+	if err := db.SetNotificationSeq(ctx, "n_1", 7); err != nil {
+		t.Fatal(err)
+	}
+	tmp := path + ".rekey"
+	if err := db.conn.Exec("VACUUM INTO ?", tmp).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SetNotificationSeq(ctx, "n_1", 8); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := cipherSupported(ctx); !ok {
+		if err := db.Rekey(ctx, "secret"); !sdkerrs.ErrDBEncryptNotSupport.Is(err) {
+			t.Fatalf("rekey: %v, want ErrDBEncryptNotSupport", err)
+		}
+	}
+
+	// a copy that does not open leaves the database open and untouched
+	garbage := path + ".garbage"
+	if err := os.WriteFile(garbage, bytes.Repeat([]byte{0xa5}, 4096), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.swapDatabase(ctx, garbage, ""); !sdkerrs.ErrDBMigrate.Is(err) {
+		t.Fatalf("swap garbage: %v, want ErrDBMigrate", err)
+	}
+	if seq, err := notificationSeq(ctx, db, "n_1"); err != nil || seq != 8 {
+		t.Fatalf("seq after failed swap %d, %v", seq, err)
+	}
+
+	if err := db.swapDatabase(ctx, tmp, ""); err != nil {
+		t.Fatal(err)
+	}
+	if seq, err := notificationSeq(ctx, db, "n_1"); err != nil || seq != 7 {
+		t.Fatalf("seq after swap %d, %v", seq, err)
+	}
+	for _, p := range []string{tmp, garbage, path + ".bak"} {
+		if _, err := os.Stat(p); !os.IsNotExist(err) {
+			t.Fatalf("%s left behind: %v", p, err)
+		}
+	}
+}
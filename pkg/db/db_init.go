@@ -74,6 +74,10 @@ type DataBase struct {
 	conn         *gorm.DB
 	tableChecker *TableChecker
 	mRWMutex     sync.RWMutex
+	searchIndex  bool
+	dbPath       string
+	key          string
+	logLevel     int
 }
 
 func (d *DataBase) InitDB(ctx context.Context, userID string, dataDir string) error {
@@ -81,6 +85,10 @@ func (d *DataBase) InitDB(ctx context.Context, userID string, dataDir string) er
 }
 
 func (d *DataBase) Close(ctx context.Context) error {
+	return d.closeConn(ctx)
+}
+
+func (d *DataBase) closeConn(ctx context.Context) error {
 	dbConn, err := d.conn.WithContext(ctx).DB()
 	if err != nil {
 		return err
@@ -95,9 +103,9 @@ func (d *DataBase) Close(ctx context.Context) error {
 	return nil
 }
 
-func NewDataBase(ctx context.Context, loginUserID string, dbDir string, logLevel int) (*DataBase, error) {
-	dataBase := &DataBase{loginUserID: loginUserID, dbDir: dbDir}
-	err := dataBase.initDB(ctx, logLevel)
+func NewDataBase(ctx context.Context, loginUserID string, dbDir string, logLevel int, opts ...Option) (*DataBase, error) {
+	dataBase := &DataBase{loginUserID: loginUserID, dbDir: dbDir, key: newOptions(opts).key, logLevel: logLevel}
+	err := dataBase.initDB(ctx)
 	if err != nil {
 		return dataBase, errs.WrapMsg(err, "initDB failed "+dbDir)
 	}
@@ -106,12 +114,12 @@ func NewDataBase(ctx context.Context, loginUserID string, dbDir string, logLevel
 		return dataBase, errs.Wrap(err)
 	}
 	dataBase.tableChecker = NewTableChecker(tables)
+	dataBase.initSearchIndex(ctx, tables)
 
 	return dataBase, nil
 }
 
-func (d *DataBase) initDB(ctx context.Context, logLevel int) error {
-	var zLogLevel logger.LogLevel
+func (d *DataBase) initDB(ctx context.Context) error {
 	if d.loginUserID == "" {
 		return errors.New("no uid")
 	}
@@ -123,95 +131,64 @@ func (d *DataBase) initDB(ctx context.Context, logLevel int) error {
 	if err != nil {
 		return err
 	}
-	log.ZInfo(ctx, "sqlite", "path", dbFileName)
+	log.ZInfo(ctx, "sqlite", "path", dbFileName, "encrypted", d.key != "")
+	d.dbPath = dbFileName
+	if d.key != "" {
+		if err := prepareEncryptedDatabase(ctx, dbFileName, d.key); err != nil {
+			return err
+		}
+	}
+	if err := d.openConn(ctx); err != nil {
+		return err
+	}
+
+	if err := d.migrate(ctx); err != nil {
+		_ = d.closeConn(ctx)
+		return err
+	}
+	return d.versionDataMigrate(ctx)
+}
+
+// openConn opens the database file, unlocking it with the key, and checks the key fits
+// before anything is read or written.
+func (d *DataBase) openConn(ctx context.Context) error {
+	var zLogLevel logger.LogLevel
 	// slowThreshold := 500
 	// sqlLogger := log.NewSqlLogger(logger.LogLevel(sdk_struct.ServerConf.LogLevel), true, time.Duration(slowThreshold)*time.Millisecond)
-	if logLevel > 5 {
+	if d.logLevel > 5 {
 		zLogLevel = logger.Info
 	} else {
 		zLogLevel = logger.Silent
 	}
-	var (
-		db *gorm.DB
-	)
-	db, err = gorm.Open(sqlite.Open(dbFileName), &gorm.Config{Logger: log.NewSqlLogger(zLogLevel, false, time.Millisecond*200)})
-	if err != nil {
-		return errs.WrapMsg(err, "open db failed "+dbFileName)
+	sqlDB := openKeyDB(d.dbPath, d.key)
+	if err := checkDatabaseKey(ctx, sqlDB, d.key != ""); err != nil {
+		_ = sqlDB.Close()
+		return err
 	}
-
-	log.ZDebug(ctx, "open db success", "dbFileName", dbFileName)
-	sqlDB, err := db.DB()
+	db, err := gorm.Open(&sqlite.Dialector{Conn: sqlDB}, &gorm.Config{Logger: log.NewSqlLogger(zLogLevel, false, time.Millisecond*200)})
 	if err != nil {
-		return errs.WrapMsg(err, "get sql db failed")
+		_ = sqlDB.Close()
+		return errs.WrapMsg(err, "open db failed "+d.dbPath)
 	}
+	log.ZDebug(ctx, "open db success", "dbFileName", d.dbPath)
 
 	sqlDB.SetConnMaxLifetime(time.Hour * 1)
 	sqlDB.SetMaxOpenConns(3)
 	sqlDB.SetMaxIdleConns(2)
 	sqlDB.SetConnMaxIdleTime(time.Minute * 10)
 	d.conn = db
-
-	// base
-	if err = db.AutoMigrate(&model_struct.LocalAppSDKVersion{}); err != nil {
-		return err
-	}
-
-	if err = d.versionDataMigrate(ctx); err != nil {
-		return err
-	}
-
-	//if err := db.Table(constant.SuperGroupTableName).AutoMigrate(superGroup); err != nil {
-	//	return err
-	//}
-
 	return nil
 }
 
+// versionDataMigrate records the SDK version that opened the database, keeping the
+// installed flag of the previous one.
 func (d *DataBase) versionDataMigrate(ctx context.Context) error {
 	verModel, err := d.GetAppSDKVersion(ctx)
-	if errs.Unwrap(err) == errs.ErrRecordNotFound {
-		err = d.conn.AutoMigrate(
-			&model_struct.LocalAppSDKVersion{},
-			&model_struct.LocalFriend{},
-			&model_struct.LocalFriendRequest{},
-			&model_struct.LocalGroup{},
-			&model_struct.LocalGroupMember{},
-			&model_struct.LocalGroupRequest{},
-			&model_struct.LocalUser{},
-			&model_struct.LocalBlack{},
-			&model_struct.LocalConversation{},
-			&model_struct.NotificationSeqs{},
-			&model_struct.LocalChatLog{},
-			&model_struct.LocalAdminGroupRequest{},
-			&model_struct.LocalChatLogReactionExtensions{},
-			&model_struct.LocalUpload{},
-			&model_struct.LocalStranger{},
-			&model_struct.LocalSendingMessages{},
-			&model_struct.LocalUserCommand{},
-			&model_struct.LocalVersionSync{},
-		)
-		if err != nil {
-			return err
-		}
-		err = d.SetAppSDKVersion(ctx, &model_struct.LocalAppSDKVersion{Version: version.Version})
-		if err != nil {
-			return err
-		}
-
-		return nil
-	} else if err != nil {
+	if err != nil && errs.Unwrap(err) != errs.ErrRecordNotFound {
 		return err
 	}
-	if verModel.Version != version.Version {
-		switch version.Version {
-		case "3.8.0":
-			d.conn.AutoMigrate(&model_struct.LocalAppSDKVersion{})
-		}
-		err = d.SetAppSDKVersion(ctx, &model_struct.LocalAppSDKVersion{Version: version.Version})
-		if err != nil {
-			return err
-		}
+	if verModel.Version == version.Version {
+		return nil
 	}
-
-	return nil
+	return d.SetAppSDKVersion(ctx, &model_struct.LocalAppSDKVersion{Version: version.Version})
 }
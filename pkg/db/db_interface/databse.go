@@ -79,6 +79,11 @@ type MessageModel interface {
 	GetConversationPeerNormalMsgSeq(ctx context.Context, conversationID string) (int64, error)
 	GetLatestActiveMessage(ctx context.Context, conversationID string, isReverse bool) (result []*model_struct.LocalChatLog, err error)
 	GetLatestValidServerMessage(ctx context.Context, conversationID string, startTime int64, isReverse bool) (*model_struct.LocalChatLog, error)
+	// GetThreadMessages returns the replies to rootClientMsgID after the one sent at startTime
+	// as startClientMsgID, oldest first. Replies sent at the same time are ordered by clientMsgID.
+	GetThreadMessages(ctx context.Context, conversationID, rootClientMsgID string, startTime int64, startClientMsgID string, count int) (result []*model_struct.LocalChatLog, err error)
+	// GetThreadSummaries returns the summaries of the roots among rootClientMsgIDs that have replies.
+	GetThreadSummaries(ctx context.Context, conversationID string, rootClientMsgIDs []string) ([]*model_struct.LocalThreadSummary, error)
 
 	UpdateMsgSenderFaceURLAndSenderNickname(ctx context.Context, conversationID, sendID, faceURL, nickname string) error
 	DeleteConversationAllMessages(ctx context.Context, conversationID string) error
@@ -177,6 +182,53 @@ type S3Model interface {
 	UpdateUpload(ctx context.Context, upload *model_struct.LocalUpload) error
 	DeleteExpireUpload(ctx context.Context) error
 }
+type ScheduledMessageModel interface {
+	InsertScheduledMessage(ctx context.Context, message *model_struct.LocalScheduledMessage) error
+	UpdateScheduledMessage(ctx context.Context, message *model_struct.LocalScheduledMessage) error
+	DeleteScheduledMessage(ctx context.Context, clientMsgID string) error
+	GetScheduledMessage(ctx context.Context, clientMsgID string) (*model_struct.LocalScheduledMessage, error)
+	GetAllScheduledMessages(ctx context.Context) ([]*model_struct.LocalScheduledMessage, error)
+	GetDueScheduledMessages(ctx context.Context, sendAt int64) ([]*model_struct.LocalScheduledMessage, error)
+}
+
+type MessageReactionModel interface {
+	GetMessageReactionExtensions(ctx context.Context, clientMsgIDs []string) ([]*model_struct.LocalChatLogReactionExtensions, error)
+	SetMessageReactionExtension(ctx context.Context, extension *model_struct.LocalChatLogReactionExtensions) error
+}
+
+type OutboxMessageModel interface {
+	InsertOutboxMessage(ctx context.Context, message *model_struct.LocalOutboxMessage) error
+	UpdateOutboxMessage(ctx context.Context, message *model_struct.LocalOutboxMessage) error
+	DeleteOutboxMessage(ctx context.Context, clientMsgID string) error
+	GetOutboxMessage(ctx context.Context, clientMsgID string) (*model_struct.LocalOutboxMessage, error)
+	GetAllOutboxMessages(ctx context.Context) ([]*model_struct.LocalOutboxMessage, error)
+	GetConversationOutboxMessages(ctx context.Context, conversationID string) ([]*model_struct.LocalOutboxMessage, error)
+}
+
+type PinnedMessageModel interface {
+	SetPinnedMessage(ctx context.Context, pinned *model_struct.LocalPinnedMessage) error
+	GetPinnedMessage(ctx context.Context, conversationID, clientMsgID string) (*model_struct.LocalPinnedMessage, error)
+	GetPinnedMessages(ctx context.Context, conversationID string) ([]*model_struct.LocalPinnedMessage, error)
+	DeletePinnedMessages(ctx context.Context, conversationID string, clientMsgIDs []string) error
+	DeleteConversationPinnedMessages(ctx context.Context, conversationID string) error
+}
+
+type MentionModel interface {
+	// InsertMentions records mentions, skipping the ones already recorded.
+	InsertMentions(ctx context.Context, mentions []*model_struct.LocalMention) error
+	GetMention(ctx context.Context, conversationID, clientMsgID string) (*model_struct.LocalMention, error)
+	// GetMentions returns mentions newest first, after the one sent at startTime as startClientMsgID
+	// in startConversationID unless startTime is 0. Mentions sent at the same time are ordered by
+	// conversationID and clientMsgID.
+	GetMentions(ctx context.Context, startTime int64, startConversationID, startClientMsgID string, unreadOnly bool, count int) ([]*model_struct.LocalMention, error)
+	// MarkMentionsRead marks the mentions of a conversation read, all of them when clientMsgIDs
+	// is empty, and returns how many were unread.
+	MarkMentionsRead(ctx context.Context, conversationID string, clientMsgIDs []string) (int64, error)
+	GetUnreadMentionCount(ctx context.Context) (int32, error)
+	DeleteMentions(ctx context.Context, conversationID string, clientMsgIDs []string) error
+	DeleteConversationMentions(ctx context.Context, conversationID string) error
+}
+
 type SendingMessagesModel interface {
 	InsertSendingMessage(ctx context.Context, message *model_struct.LocalSendingMessages) error
 	DeleteSendingMessage(ctx context.Context, conversationID, clientMsgID string) error
@@ -199,6 +251,7 @@ type TableMaster interface {
 type DataBase interface {
 	Close(ctx context.Context) error
 	InitDB(ctx context.Context, userID string, dataDir string) error
+	Rekey(ctx context.Context, key string) error
 	GroupModel
 	MessageModel
 	ConversationModel
@@ -206,6 +259,11 @@ type DataBase interface {
 	FriendModel
 	S3Model
 	SendingMessagesModel
+	ScheduledMessageModel
+	OutboxMessageModel
+	MessageReactionModel
+	PinnedMessageModel
+	MentionModel
 	VersionSyncModel
 	AppSDKVersion
 	TableMaster
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
 	"github.com/openimsdk/openim-sdk-core/v3/wasm/exec"
 	"github.com/openimsdk/openim-sdk-core/v3/wasm/indexdb"
 )
@@ -38,6 +39,11 @@ type IndexDB struct {
 	*indexdb.NotificationSeqs
 	*indexdb.LocalUpload
 	*indexdb.LocalSendingMessages
+	*indexdb.LocalScheduledMessages
+	*indexdb.LocalOutboxMessages
+	*indexdb.LocalChatLogReactionExtensions
+	*indexdb.LocalPinnedMessages
+	*indexdb.LocalMentions
 	*indexdb.LocalUserCommand
 	*indexdb.LocalVersionSync
 	*indexdb.LocalAppSDKVersion
@@ -55,7 +61,15 @@ func (i IndexDB) InitDB(ctx context.Context, userID string, dataDir string) erro
 	return err
 }
 
-func NewDataBase(ctx context.Context, loginUserID string, dbDir string, logLevel int) (*IndexDB, error) {
+// Rekey is not supported by IndexedDB, which has no encryption at rest.
+func (i IndexDB) Rekey(ctx context.Context, key string) error {
+	return sdkerrs.ErrDBEncryptNotSupport.WrapMsg("indexdb does not support encryption")
+}
+
+func NewDataBase(ctx context.Context, loginUserID string, dbDir string, logLevel int, opts ...Option) (*IndexDB, error) {
+	if newOptions(opts).key != "" {
+		return nil, sdkerrs.ErrDBEncryptNotSupport.WrapMsg("indexdb does not support encryption")
+	}
 	i := &IndexDB{
 		LocalUsers:                      indexdb.NewLocalUsers(),
 		LocalConversations:              indexdb.NewLocalConversations(),
@@ -70,6 +84,11 @@ func NewDataBase(ctx context.Context, loginUserID string, dbDir string, logLevel
 		NotificationSeqs:                indexdb.NewNotificationSeqs(),
 		LocalUpload:                     indexdb.NewLocalUpload(),
 		LocalSendingMessages:            indexdb.NewLocalSendingMessages(),
+		LocalScheduledMessages:          indexdb.NewLocalScheduledMessages(),
+		LocalOutboxMessages:             indexdb.NewLocalOutboxMessages(),
+		LocalChatLogReactionExtensions:  indexdb.NewLocalChatLogReactionExtensions(),
+		LocalPinnedMessages:             indexdb.NewLocalPinnedMessages(),
+		LocalMentions:                   indexdb.NewLocalMentions(),
 		LocalUserCommand:                indexdb.NewLocalUserCommand(),
 		LocalVersionSync:                indexdb.NewLocalVersionSync(),
 		LocalAppSDKVersion:              indexdb.NewLocalAppSDKVersion(),
@@ -0,0 +1,592 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dbtest is a conformance suite for db_interface.DataBase, run against every storage
+// backend so that the SDK behaves the same whichever one IMConfig selects.
+package dbtest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/db_interface"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/version"
+)
+
+const loginUserID = "dbtest_user"
+
+// Open returns an empty database of loginUserID that is closed when t ends.
+type Open func(t *testing.T, loginUserID string) db_interface.DataBase
+
+// Run runs the conformance suite, each subtest on a database of its own.
+func Run(t *testing.T, open Open) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, db db_interface.DataBase)
+	}{
+		{"Conversation", testConversation},
+		{"ChatLog", testChatLog},
+		{"SearchMessage", testSearchMessage},
+		{"VersionSync", testVersionSync},
+		{"SendingMessage", testSendingMessage},
+		{"Friend", testFriend},
+		{"GroupMember", testGroupMember},
+		{"LoginUser", testLoginUser},
+		{"Outbox", testOutbox},
+		{"ScheduledMessage", testScheduledMessage},
+		{"AppSDKVersion", testAppSDKVersion},
+		{"NotificationSeq", testNotificationSeq},
+		{"ReactionExtension", testReactionExtension},
+		{"PinnedMessage", testPinnedMessage},
+		{"Thread", testThread},
+		{"Mention", testMention},
+		{"Upload", testUpload},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.fn(t, open(t, loginUserID))
+		})
+	}
+}
+
+func must(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func expect[T any](t *testing.T, what string, got, want T) {
+	t.Helper()
+	if g, w := fmt.Sprint(got), fmt.Sprint(want); g != w {
+		t.Fatalf("%s = %s, want %s", what, g, w)
+	}
+}
+
+// ids maps rows to their keys, sorted when the query does not define an order.
+func ids[V any](rows []*V, key func(*V) string, sorted bool) []string {
+	res := make([]string, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, key(row))
+	}
+	if sorted {
+		slices.Sort(res)
+	}
+	return res
+}
+
+func conversationID(c *model_struct.LocalConversation) string { return c.ConversationID }
+
+func clientMsgID(m *model_struct.LocalChatLog) string { return m.ClientMsgID }
+
+func textMessage(id string, seq int64, sendID, text string) *model_struct.LocalChatLog {
+	return &model_struct.LocalChatLog{
+		ClientMsgID: id,
+		SendID:      sendID,
+		ContentType: constant.Text,
+		Content:     utils.StructToJsonString(sdk_struct.TextElem{Content: text}),
+		Status:      constant.MsgStatusSendSuccess,
+		Seq:         seq,
+		SendTime:    1000 + seq,
+	}
+}
+
+func testConversation(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	must(t, db.InsertConversation(ctx, &model_struct.LocalConversation{ConversationID: "si_a", LatestMsgSendTime: 100}))
+	must(t, db.InsertConversation(ctx, &model_struct.LocalConversation{ConversationID: "si_b", LatestMsgSendTime: 300}))
+	must(t, db.InsertConversation(ctx, &model_struct.LocalConversation{ConversationID: "si_c", LatestMsgSendTime: 200, IsPinned: true}))
+	must(t, db.InsertConversation(ctx, &model_struct.LocalConversation{ConversationID: "si_empty"}))
+	if err := db.InsertConversation(ctx, &model_struct.LocalConversation{ConversationID: "si_a"}); err == nil {
+		t.Fatal("duplicate conversation inserted")
+	}
+
+	list, err := db.GetAllConversationListDB(ctx)
+	must(t, err)
+	expect(t, "conversation order", ids(list, conversationID, false), []string{"si_c", "si_b", "si_a"})
+
+	c, err := db.GetConversation(ctx, "si_empty")
+	must(t, err)
+	expect(t, "default burn duration", c.BurnDuration, 30)
+	if _, err := db.GetConversation(ctx, "si_missing"); err == nil {
+		t.Fatal("missing conversation found")
+	}
+
+	must(t, db.UpdateColumnsConversation(ctx, "si_a", map[string]interface{}{"draft_text": "draft", "draft_text_time": 400}))
+	list, err = db.GetAllConversationListDB(ctx)
+	must(t, err)
+	expect(t, "order after draft", ids(list, conversationID, false), []string{"si_c", "si_a", "si_b"})
+	if err := db.UpdateColumnsConversation(ctx, "si_missing", map[string]interface{}{"draft_text": "draft"}); err == nil {
+		t.Fatal("missing conversation updated")
+	}
+
+	must(t, db.UpdateConversation(ctx, &model_struct.LocalConversation{ConversationID: "si_b", ShowName: "b"}))
+	c, err = db.GetConversation(ctx, "si_b")
+	must(t, err)
+	expect(t, "updated conversation", []any{c.ShowName, c.LatestMsgSendTime}, []any{"b", 300})
+
+	must(t, db.IncrConversationUnreadCount(ctx, "si_a"))
+	must(t, db.IncrConversationUnreadCount(ctx, "si_a"))
+	must(t, db.IncrConversationUnreadCount(ctx, "si_b"))
+	total, err := db.GetTotalUnreadMsgCountDB(ctx)
+	must(t, err)
+	expect(t, "total unread", total, 3)
+	must(t, db.DecrConversationUnreadCount(ctx, "si_a", 5))
+	c, err = db.GetConversation(ctx, "si_a")
+	must(t, err)
+	expect(t, "clamped unread", c.UnreadCount, 0)
+
+	must(t, db.DeleteConversation(ctx, "si_b"))
+	list, err = db.GetAllConversationListDB(ctx)
+	must(t, err)
+	expect(t, "after delete", ids(list, conversationID, false), []string{"si_c", "si_a"})
+}
+
+func testChatLog(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	if err := db.InsertMessage(ctx, "si_x", textMessage("x1", 1, "other", "x")); err == nil {
+		t.Fatal("message inserted before its conversation table exists")
+	}
+	var messages []*model_struct.LocalChatLog
+	for i := int64(1); i <= 5; i++ {
+		messages = append(messages, textMessage(fmt.Sprintf("m%d", i), i, "other", fmt.Sprintf("text %d", i)))
+	}
+	must(t, db.BatchInsertMessageList(ctx, "si_a", messages))
+	must(t, db.BatchInsertMessageList(ctx, "si_b", []*model_struct.LocalChatLog{textMessage("b1", 9, loginUserID, "b")}))
+	if err := db.BatchInsertMessageList(ctx, "si_a", messages[:1]); err == nil {
+		t.Fatal("duplicate message inserted")
+	}
+
+	tables, err := db.GetExistTables(ctx)
+	must(t, err)
+	for _, table := range []string{utils.GetConversationTableName("si_a"), utils.GetConversationTableName("si_b")} {
+		if !slices.Contains(tables, table) {
+			t.Fatalf("table %s missing from %v", table, tables)
+		}
+	}
+
+	if _, err := db.GetMessage(ctx, "si_b", "m1"); err == nil {
+		t.Fatal("message found in another conversation")
+	}
+	msg, err := db.GetMessageBySeq(ctx, "si_a", 3)
+	must(t, err)
+	expect(t, "message by seq", msg.ClientMsgID, "m3")
+
+	list, err := db.GetMessageList(ctx, "si_a", 2, 0, 0, "", false)
+	must(t, err)
+	expect(t, "latest page", ids(list, clientMsgID, false), []string{"m5", "m4"})
+	list, err = db.GetMessageList(ctx, "si_a", 2, list[1].SendTime, list[1].Seq, list[1].ClientMsgID, false)
+	must(t, err)
+	expect(t, "older page", ids(list, clientMsgID, false), []string{"m3", "m2"})
+	list, err = db.GetMessageList(ctx, "si_a", 10, msg.SendTime, msg.Seq, msg.ClientMsgID, true)
+	must(t, err)
+	expect(t, "newer page", ids(list, clientMsgID, false), []string{"m4", "m5"})
+
+	seq, err := db.GetConversationNormalMsgSeq(ctx, "si_a")
+	must(t, err)
+	expect(t, "normal seq", seq, 5)
+
+	must(t, db.UpdateColumnsMessage(ctx, "si_a", "m1", map[string]interface{}{"ex": "edited"}))
+	msg, err = db.GetMessage(ctx, "si_a", "m1")
+	must(t, err)
+	expect(t, "updated ex", msg.Ex, "edited")
+
+	n, err := db.MarkConversationMessageAsReadDB(ctx, "si_a", []string{"m1", "m2"})
+	must(t, err)
+	expect(t, "marked as read", n, 2)
+	msg, err = db.GetMessage(ctx, "si_a", "m2")
+	must(t, err)
+	expect(t, "is read", msg.IsRead, true)
+	n, err = db.MarkConversationMessageAsReadDB(ctx, "si_b", []string{"b1"})
+	must(t, err)
+	expect(t, "own message marked as read", n, 0)
+
+	must(t, db.DeleteConversationMsgs(ctx, "si_a", []string{"m5"}))
+	list, err = db.GetMessageList(ctx, "si_a", 10, 0, 0, "", false)
+	must(t, err)
+	expect(t, "after delete", ids(list, clientMsgID, false), []string{"m4", "m3", "m2", "m1"})
+	list, err = db.GetMessageList(ctx, "si_b", 10, 0, 0, "", false)
+	must(t, err)
+	expect(t, "other conversation", ids(list, clientMsgID, false), []string{"b1"})
+}
+
+func testSearchMessage(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	must(t, db.BatchInsertMessageList(ctx, "si_a", []*model_struct.LocalChatLog{
+		textMessage("m1", 1, "other", "hello world"),
+		textMessage("m2", 2, "other", "goodbye world"),
+		textMessage("m3", 3, "other", "hello again"),
+	}))
+	search := func(matchType int, keywords ...string) []string {
+		t.Helper()
+		list, err := db.SearchMessageByKeyword(ctx, []int{constant.Text}, nil, keywords, matchType, "si_a", 0, 1<<62, 0, 10)
+		must(t, err)
+		return ids(list, clientMsgID, true)
+	}
+	expect(t, "single keyword", search(constant.KeywordMatchOr, "goodbye"), []string{"m2"})
+	expect(t, "or", search(constant.KeywordMatchOr, "goodbye", "again"), []string{"m2", "m3"})
+	expect(t, "and", search(constant.KeywordMatchAnd, "hello", "world"), []string{"m1"})
+	expect(t, "no match", search(constant.KeywordMatchOr, "nothing"), []string{})
+}
+
+func testVersionSync(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	if _, err := db.GetVersionSync(ctx, "local_friends", loginUserID); err == nil {
+		t.Fatal("missing version sync found")
+	}
+	must(t, db.SetVersionSync(ctx, &model_struct.LocalVersionSync{Table: "local_friends", EntityID: loginUserID,
+		VersionID: "v1", Version: 1, UIDList: []string{"a", "b"}}))
+	must(t, db.SetVersionSync(ctx, &model_struct.LocalVersionSync{Table: "local_friends", EntityID: loginUserID, Version: 2}))
+	v, err := db.GetVersionSync(ctx, "local_friends", loginUserID)
+	must(t, err)
+	expect(t, "version sync", []any{v.VersionID, v.Version, v.UIDList}, []any{"v1", 2, []string{"a", "b"}})
+	if _, err := db.GetVersionSync(ctx, "local_friends", "other"); err == nil {
+		t.Fatal("version sync of another entity found")
+	}
+	must(t, db.DeleteVersionSync(ctx, "local_friends", loginUserID))
+	if _, err := db.GetVersionSync(ctx, "local_friends", loginUserID); err == nil {
+		t.Fatal("deleted version sync found")
+	}
+}
+
+func testSendingMessage(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	must(t, db.InsertSendingMessage(ctx, &model_struct.LocalSendingMessages{ConversationID: "si_a", ClientMsgID: "m1"}))
+	must(t, db.InsertSendingMessage(ctx, &model_struct.LocalSendingMessages{ConversationID: "si_b", ClientMsgID: "m1"}))
+	must(t, db.DeleteSendingMessage(ctx, "si_a", "m1"))
+	list, err := db.GetAllSendingMessages(ctx)
+	must(t, err)
+	expect(t, "sending messages", ids(list, func(m *model_struct.LocalSendingMessages) string {
+		return m.ConversationID + "/" + m.ClientMsgID
+	}, true), []string{"si_b/m1"})
+}
+
+func testFriend(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	must(t, db.BatchInsertFriend(ctx, []*model_struct.LocalFriend{
+		{OwnerUserID: loginUserID, FriendUserID: "f1"},
+		{OwnerUserID: loginUserID, FriendUserID: "f2"},
+		{OwnerUserID: "other", FriendUserID: "f3"},
+	}))
+	must(t, db.UpdateFriend(ctx, &model_struct.LocalFriend{OwnerUserID: loginUserID, FriendUserID: "f1", Remark: "remark"}))
+	must(t, db.DeleteFriendDB(ctx, "f2"))
+	friends, err := db.GetAllFriendList(ctx)
+	must(t, err)
+	expect(t, "friends", ids(friends, func(f *model_struct.LocalFriend) string { return f.FriendUserID + ":" + f.Remark }, true),
+		[]string{"f1:remark"})
+
+	must(t, db.InsertBlack(ctx, &model_struct.LocalBlack{OwnerUserID: loginUserID, BlockUserID: "b1"}))
+	must(t, db.InsertBlack(ctx, &model_struct.LocalBlack{OwnerUserID: loginUserID, BlockUserID: "b2"}))
+	must(t, db.DeleteBlack(ctx, "b1"))
+	blacks, err := db.GetBlackListDB(ctx)
+	must(t, err)
+	expect(t, "blacks", ids(blacks, func(b *model_struct.LocalBlack) string { return b.BlockUserID }, true), []string{"b2"})
+
+	must(t, db.InsertFriendRequest(ctx, &model_struct.LocalFriendRequest{FromUserID: "r1", ToUserID: loginUserID, CreateTime: 1}))
+	must(t, db.InsertFriendRequest(ctx, &model_struct.LocalFriendRequest{FromUserID: "r2", ToUserID: loginUserID, CreateTime: 2}))
+	must(t, db.InsertFriendRequest(ctx, &model_struct.LocalFriendRequest{FromUserID: loginUserID, ToUserID: "r3", CreateTime: 3}))
+	requests, err := db.GetRecvFriendApplication(ctx)
+	must(t, err)
+	expect(t, "received requests", ids(requests, func(r *model_struct.LocalFriendRequest) string { return r.FromUserID }, false),
+		[]string{"r2", "r1"})
+}
+
+func testGroupMember(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	must(t, db.InsertGroup(ctx, &model_struct.LocalGroup{GroupID: "g1"}))
+	must(t, db.BatchInsertGroupMember(ctx, []*model_struct.LocalGroupMember{
+		{GroupID: "g1", UserID: "u1", RoleLevel: constant.GroupOrdinaryUsers, JoinTime: 1},
+		{GroupID: "g1", UserID: "u2", RoleLevel: constant.GroupAdmin, JoinTime: 2},
+		{GroupID: "g1", UserID: "u3", RoleLevel: constant.GroupOwner, JoinTime: 3},
+		{GroupID: "g1", UserID: "u4", RoleLevel: constant.GroupOrdinaryUsers, JoinTime: 4},
+		{GroupID: "g2", UserID: "u1", RoleLevel: constant.GroupOwner, JoinTime: 5},
+	}))
+	userID := func(m *model_struct.LocalGroupMember) string { return m.UserID }
+
+	members, err := db.GetGroupMemberListSplit(ctx, "g1", constant.GroupFilterAll, 0, 10)
+	must(t, err)
+	expect(t, "all members", ids(members, userID, false), []string{"u3", "u2", "u1", "u4"})
+	members, err = db.GetGroupMemberListSplit(ctx, "g1", constant.GroupFilterAll, 1, 2)
+	must(t, err)
+	expect(t, "members page", ids(members, userID, false), []string{"u2", "u1"})
+	members, err = db.GetGroupMemberOwnerAndAdminDB(ctx, "g1")
+	must(t, err)
+	expect(t, "owner and admin", ids(members, userID, false), []string{"u3", "u2"})
+	if _, err := db.GetGroupMemberListSplit(ctx, "g1", -1, 0, 10); err == nil {
+		t.Fatal("invalid filter accepted")
+	}
+
+	must(t, db.DeleteGroupMember(ctx, "g1", "u1"))
+	count, err := db.GetGroupMemberCount(ctx, "g1")
+	must(t, err)
+	expect(t, "member count", count, 3)
+}
+
+func testLoginUser(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	if _, err := db.GetLoginUser(ctx, loginUserID); err == nil {
+		t.Fatal("missing login user found")
+	}
+	must(t, db.InsertLoginUser(ctx, &model_struct.LocalUser{UserID: loginUserID, Nickname: "name", FaceURL: "face"}))
+	must(t, db.UpdateLoginUser(ctx, &model_struct.LocalUser{UserID: loginUserID, Nickname: "new name"}))
+	must(t, db.UpdateLoginUserByMap(ctx, &model_struct.LocalUser{UserID: loginUserID}, map[string]interface{}{"face_url": ""}))
+	user, err := db.GetLoginUser(ctx, loginUserID)
+	must(t, err)
+	expect(t, "login user", []string{user.Nickname, user.FaceURL}, []string{"new name", ""})
+}
+
+func testOutbox(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	must(t, db.InsertOutboxMessage(ctx, &model_struct.LocalOutboxMessage{ClientMsgID: "m1", ConversationID: "si_a", OrderID: 2}))
+	must(t, db.InsertOutboxMessage(ctx, &model_struct.LocalOutboxMessage{ClientMsgID: "m2", ConversationID: "si_b", OrderID: 1}))
+	must(t, db.InsertOutboxMessage(ctx, &model_struct.LocalOutboxMessage{ClientMsgID: "m3", ConversationID: "si_a", OrderID: 3, Attempts: 2}))
+	outboxID := func(m *model_struct.LocalOutboxMessage) string { return m.ClientMsgID }
+
+	all, err := db.GetAllOutboxMessages(ctx)
+	must(t, err)
+	expect(t, "outbox order", ids(all, outboxID, false), []string{"m2", "m1", "m3"})
+	list, err := db.GetConversationOutboxMessages(ctx, "si_a")
+	must(t, err)
+	expect(t, "conversation outbox", ids(list, outboxID, false), []string{"m1", "m3"})
+
+	// updates write every column, zero values included
+	must(t, db.UpdateOutboxMessage(ctx, &model_struct.LocalOutboxMessage{ClientMsgID: "m3", ConversationID: "si_a", OrderID: 3}))
+	msg, err := db.GetOutboxMessage(ctx, "m3")
+	must(t, err)
+	expect(t, "attempts", msg.Attempts, 0)
+	if err := db.UpdateOutboxMessage(ctx, &model_struct.LocalOutboxMessage{ClientMsgID: "missing"}); err == nil {
+		t.Fatal("missing outbox message updated")
+	}
+
+	must(t, db.DeleteOutboxMessage(ctx, "m1"))
+	if _, err := db.GetOutboxMessage(ctx, "m1"); err == nil {
+		t.Fatal("deleted outbox message found")
+	}
+}
+
+func testScheduledMessage(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	must(t, db.InsertScheduledMessage(ctx, &model_struct.LocalScheduledMessage{ClientMsgID: "m1", SendAt: 300}))
+	must(t, db.InsertScheduledMessage(ctx, &model_struct.LocalScheduledMessage{ClientMsgID: "m2", SendAt: 100}))
+	must(t, db.InsertScheduledMessage(ctx, &model_struct.LocalScheduledMessage{ClientMsgID: "m3", SendAt: 200}))
+	scheduledID := func(m *model_struct.LocalScheduledMessage) string { return m.ClientMsgID }
+
+	all, err := db.GetAllScheduledMessages(ctx)
+	must(t, err)
+	expect(t, "scheduled order", ids(all, scheduledID, false), []string{"m2", "m3", "m1"})
+	due, err := db.GetDueScheduledMessages(ctx, 200)
+	must(t, err)
+	expect(t, "due", ids(due, scheduledID, false), []string{"m2", "m3"})
+
+	must(t, db.UpdateScheduledMessage(ctx, &model_struct.LocalScheduledMessage{ClientMsgID: "m1", SendAt: 50, Attempts: 1}))
+	msg, err := db.GetScheduledMessage(ctx, "m1")
+	must(t, err)
+	expect(t, "rescheduled", []int64{msg.SendAt, int64(msg.Attempts)}, []int64{50, 1})
+
+	must(t, db.DeleteScheduledMessage(ctx, "m2"))
+	if _, err := db.GetScheduledMessage(ctx, "m2"); err == nil {
+		t.Fatal("deleted scheduled message found")
+	}
+}
+
+func testAppSDKVersion(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	v, err := db.GetAppSDKVersion(ctx)
+	must(t, err)
+	expect(t, "initial app sdk version", v.Version, version.Version)
+	must(t, db.SetAppSDKVersion(ctx, &model_struct.LocalAppSDKVersion{Version: "v2", Installed: true}))
+	v, err = db.GetAppSDKVersion(ctx)
+	must(t, err)
+	expect(t, "app sdk version", v.Version, "v2")
+}
+
+func testNotificationSeq(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	must(t, db.BatchInsertNotificationSeq(ctx, []*model_struct.NotificationSeqs{{ConversationID: "n_a", Seq: 1}}))
+	must(t, db.SetNotificationSeq(ctx, "n_a", 5))
+	must(t, db.SetNotificationSeq(ctx, "n_b", 2))
+	seqs, err := db.GetNotificationAllSeqs(ctx)
+	must(t, err)
+	expect(t, "notification seqs", ids(seqs, func(s *model_struct.NotificationSeqs) string {
+		return fmt.Sprintf("%s:%d", s.ConversationID, s.Seq)
+	}, true), []string{"n_a:5", "n_b:2"})
+}
+
+func testReactionExtension(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	must(t, db.SetMessageReactionExtension(ctx, &model_struct.LocalChatLogReactionExtensions{ClientMsgID: "m1", LocalReactionExtensions: []byte("a")}))
+	must(t, db.SetMessageReactionExtension(ctx, &model_struct.LocalChatLogReactionExtensions{ClientMsgID: "m2", LocalReactionExtensions: []byte("b")}))
+	must(t, db.SetMessageReactionExtension(ctx, &model_struct.LocalChatLogReactionExtensions{ClientMsgID: "m1", LocalReactionExtensions: []byte("c")}))
+	list, err := db.GetMessageReactionExtensions(ctx, []string{"m1", "m3"})
+	must(t, err)
+	expect(t, "reactions", ids(list, func(r *model_struct.LocalChatLogReactionExtensions) string {
+		return r.ClientMsgID + ":" + string(r.LocalReactionExtensions)
+	}, true), []string{"m1:c"})
+}
+
+func testPinnedMessage(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	must(t, db.SetPinnedMessage(ctx, &model_struct.LocalPinnedMessage{ConversationID: "si_a", ClientMsgID: "m1", IsPinned: true, OperateTime: 10}))
+	must(t, db.SetPinnedMessage(ctx, &model_struct.LocalPinnedMessage{ConversationID: "si_a", ClientMsgID: "m2", IsPinned: true, OperateTime: 30}))
+	must(t, db.SetPinnedMessage(ctx, &model_struct.LocalPinnedMessage{ConversationID: "si_a", ClientMsgID: "m3", IsPinned: true, OperateTime: 20}))
+	must(t, db.SetPinnedMessage(ctx, &model_struct.LocalPinnedMessage{ConversationID: "si_b", ClientMsgID: "m1", IsPinned: true, OperateTime: 40}))
+	pinnedID := func(p *model_struct.LocalPinnedMessage) string { return p.ClientMsgID }
+
+	list, err := db.GetPinnedMessages(ctx, "si_a")
+	must(t, err)
+	expect(t, "pinned order", ids(list, pinnedID, false), []string{"m2", "m3", "m1"})
+
+	// unpinning keeps the row, with every column written
+	must(t, db.SetPinnedMessage(ctx, &model_struct.LocalPinnedMessage{ConversationID: "si_a", ClientMsgID: "m2", OperateTime: 50}))
+	pinned, err := db.GetPinnedMessage(ctx, "si_a", "m2")
+	must(t, err)
+	expect(t, "unpinned", pinned.IsPinned, false)
+	list, err = db.GetPinnedMessages(ctx, "si_a")
+	must(t, err)
+	expect(t, "pinned after unpin", ids(list, pinnedID, false), []string{"m3", "m1"})
+
+	must(t, db.DeletePinnedMessages(ctx, "si_a", []string{"m1", "m2"}))
+	if _, err := db.GetPinnedMessage(ctx, "si_a", "m2"); err == nil {
+		t.Fatal("deleted pinned message found")
+	}
+	must(t, db.DeleteConversationPinnedMessages(ctx, "si_a"))
+	list, err = db.GetPinnedMessages(ctx, "si_a")
+	must(t, err)
+	expect(t, "pinned after conversation delete", len(list), 0)
+	list, err = db.GetPinnedMessages(ctx, "si_b")
+	must(t, err)
+	expect(t, "other conversation", ids(list, pinnedID, false), []string{"m1"})
+}
+
+func testThread(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	reply := func(id string, seq int64, root string) *model_struct.LocalChatLog {
+		m := textMessage(id, seq, "other", id)
+		m.ThreadRootID = root
+		return m
+	}
+	revoked := reply("r4", 4, "root1")
+	revoked.ContentType = constant.RevokeNotification
+	deleted := reply("r5", 5, "root1")
+	deleted.Status = constant.MsgStatusHasDeleted
+	// replies sent in the same millisecond as r3
+	sameTime1, sameTime2 := reply("r3b", 8, "root1"), reply("r3a", 9, "root1")
+	sameTime1.SendTime, sameTime2.SendTime = 1003, 1003
+	must(t, db.BatchInsertMessageList(ctx, "si_a", []*model_struct.LocalChatLog{
+		textMessage("root1", 1, "other", "root"),
+		reply("r2", 2, "root1"),
+		reply("r3", 3, "root1"),
+		revoked,
+		deleted,
+		reply("r6", 6, "root2"),
+		reply("r7", 7, "root1"),
+		sameTime1,
+		sameTime2,
+	}))
+
+	list, err := db.GetThreadMessages(ctx, "si_a", "root1", 0, "", 2)
+	must(t, err)
+	expect(t, "first thread page", ids(list, clientMsgID, false), []string{"r2", "r3"})
+	list, err = db.GetThreadMessages(ctx, "si_a", "root1", list[1].SendTime, list[1].ClientMsgID, 1)
+	must(t, err)
+	expect(t, "same time thread page", ids(list, clientMsgID, false), []string{"r3a"})
+	list, err = db.GetThreadMessages(ctx, "si_a", "root1", list[0].SendTime, list[0].ClientMsgID, 10)
+	must(t, err)
+	expect(t, "next thread page", ids(list, clientMsgID, false), []string{"r3b", "r7"})
+
+	summaries, err := db.GetThreadSummaries(ctx, "si_a", []string{"root1", "root2", "r2"})
+	must(t, err)
+	got := make(map[string]string)
+	for _, s := range summaries {
+		got[s.RootClientMsgID] = fmt.Sprint(s.ReplyCount, " ", s.LatestReply.ClientMsgID)
+	}
+	expect(t, "thread summaries", got, map[string]string{"root1": "5 r7", "root2": "1 r6"})
+	summaries, err = db.GetThreadSummaries(ctx, "si_a", nil)
+	must(t, err)
+	expect(t, "no roots", len(summaries), 0)
+}
+
+func testMention(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	mention := func(conversationID, clientMsgID string, sendTime int64) *model_struct.LocalMention {
+		return &model_struct.LocalMention{ConversationID: conversationID, ClientMsgID: clientMsgID, MentionType: constant.MentionAtMe, SendTime: sendTime}
+	}
+	must(t, db.InsertMentions(ctx, []*model_struct.LocalMention{mention("si_a", "m1", 10), mention("si_a", "m2", 30), mention("si_b", "m1", 20)}))
+	// recorded mentions are skipped, the rest are inserted
+	must(t, db.InsertMentions(ctx, []*model_struct.LocalMention{mention("si_a", "m1", 10), mention("si_b", "m3", 40)}))
+	must(t, db.InsertMentions(ctx, nil))
+	mentionID := func(m *model_struct.LocalMention) string { return m.ConversationID + "/" + m.ClientMsgID }
+
+	list, err := db.GetMentions(ctx, 0, "", "", false, 3)
+	must(t, err)
+	expect(t, "latest mentions", ids(list, mentionID, false), []string{"si_b/m3", "si_a/m2", "si_b/m1"})
+	list, err = db.GetMentions(ctx, list[2].SendTime, list[2].ConversationID, list[2].ClientMsgID, false, 3)
+	must(t, err)
+	expect(t, "older mentions", ids(list, mentionID, false), []string{"si_a/m1"})
+
+	// mentions sent in the same millisecond are paged one by one
+	must(t, db.InsertMentions(ctx, []*model_struct.LocalMention{mention("si_c", "m5", 20), mention("si_c", "m4", 20), mention("si_a", "m6", 20)}))
+	list, err = db.GetMentions(ctx, 30, "si_a", "m2", false, 2)
+	must(t, err)
+	expect(t, "same time mentions", ids(list, mentionID, false), []string{"si_c/m5", "si_c/m4"})
+	list, err = db.GetMentions(ctx, list[1].SendTime, list[1].ConversationID, list[1].ClientMsgID, false, 2)
+	must(t, err)
+	expect(t, "next same time mentions", ids(list, mentionID, false), []string{"si_b/m1", "si_a/m6"})
+	must(t, db.DeleteMentions(ctx, "si_c", []string{"m4", "m5"}))
+	must(t, db.DeleteMentions(ctx, "si_a", []string{"m6"}))
+
+	n, err := db.MarkMentionsRead(ctx, "si_a", []string{"m1"})
+	must(t, err)
+	expect(t, "marked read", n, 1)
+	n, err = db.MarkMentionsRead(ctx, "si_a", nil)
+	must(t, err)
+	expect(t, "conversation marked read", n, 1)
+	count, err := db.GetUnreadMentionCount(ctx)
+	must(t, err)
+	expect(t, "unread mentions", count, 2)
+	list, err = db.GetMentions(ctx, 0, "", "", true, 10)
+	must(t, err)
+	expect(t, "unread only", ids(list, mentionID, false), []string{"si_b/m3", "si_b/m1"})
+	m, err := db.GetMention(ctx, "si_a", "m2")
+	must(t, err)
+	expect(t, "read", m.IsRead, true)
+
+	must(t, db.DeleteMentions(ctx, "si_b", []string{"m1"}))
+	if _, err := db.GetMention(ctx, "si_b", "m1"); err == nil {
+		t.Fatal("deleted mention found")
+	}
+	must(t, db.DeleteConversationMentions(ctx, "si_a"))
+	list, err = db.GetMentions(ctx, 0, "", "", false, 10)
+	must(t, err)
+	expect(t, "after delete", ids(list, mentionID, false), []string{"si_b/m3"})
+}
+
+func testUpload(t *testing.T, db db_interface.DataBase) {
+	ctx := context.Background()
+	must(t, db.InsertUpload(ctx, &model_struct.LocalUpload{PartHash: "h1", UploadID: "u1"}))
+	must(t, db.UpdateUpload(ctx, &model_struct.LocalUpload{PartHash: "h1", UploadID: "u2"}))
+	upload, err := db.GetUpload(ctx, "h1")
+	must(t, err)
+	expect(t, "upload id", upload.UploadID, "u2")
+	must(t, db.DeleteUpload(ctx, "h1"))
+	if _, err := db.GetUpload(ctx, "h1"); err == nil {
+		t.Fatal("deleted upload found")
+	}
+}
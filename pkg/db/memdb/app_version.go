@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) GetAppSDKVersion(ctx context.Context) (*model_struct.LocalAppSDKVersion, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if d.appSDKVersion == nil {
+		return &model_struct.LocalAppSDKVersion{}, errs.Wrap(errs.ErrRecordNotFound)
+	}
+	appVersion := *d.appSDKVersion
+	return &appVersion, nil
+}
+
+func (d *DataBase) SetAppSDKVersion(ctx context.Context, appVersion *model_struct.LocalAppSDKVersion) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if d.appSDKVersion == nil {
+		v := *appVersion
+		d.appSDKVersion = &v
+		return nil
+	}
+	updateRow(d.appSDKVersion, appVersion, false)
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+	"gorm.io/gorm"
+)
+
+func (d *DataBase) GetBlackListDB(ctx context.Context) ([]*model_struct.LocalBlack, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.blacks.find(nil), nil
+}
+
+func (d *DataBase) GetBlackListUserID(ctx context.Context) (blackListUid []string, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	for _, black := range d.blacks.find(nil) {
+		blackListUid = append(blackListUid, black.BlockUserID)
+	}
+	return blackListUid, nil
+}
+
+func (d *DataBase) GetBlackInfoByBlockUserID(ctx context.Context, blockUserID string) (*model_struct.LocalBlack, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if black, ok := d.blacks.get(pair{d.loginUserID, blockUserID}); ok {
+		return black, nil
+	}
+	return &model_struct.LocalBlack{}, errs.WrapMsg(errRecordNotFound, "GetBlackInfoByBlockUserID failed")
+}
+
+func (d *DataBase) GetBlackInfoList(ctx context.Context, blockUserIDList []string) ([]*model_struct.LocalBlack, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.blacks.find(func(v *model_struct.LocalBlack) bool { return in(v.BlockUserID, blockUserIDList) }), nil
+}
+
+func (d *DataBase) InsertBlack(ctx context.Context, black *model_struct.LocalBlack) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.blacks.insert(black), "InsertBlack failed")
+}
+
+func (d *DataBase) UpdateBlack(ctx context.Context, black *model_struct.LocalBlack) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if black.OwnerUserID == "" && black.BlockUserID == "" {
+		return errs.WrapMsg(gorm.ErrMissingWhereClause, "UpdateBlack failed")
+	}
+	if d.blacks.update(func(v *model_struct.LocalBlack) bool {
+		return (black.OwnerUserID == "" || v.OwnerUserID == black.OwnerUserID) && (black.BlockUserID == "" || v.BlockUserID == black.BlockUserID)
+	}, func(v *model_struct.LocalBlack) { updateRow(v, black, false) }) == 0 {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) DeleteBlack(ctx context.Context, blockUserID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.blacks.deleteKey(pair{d.loginUserID, blockUserID})
+	return nil
+}
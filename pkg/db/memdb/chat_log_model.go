@@ -0,0 +1,493 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/tools/errs"
+	"gorm.io/gorm"
+)
+
+func (d *DataBase) UpdateMessage(ctx context.Context, conversationID string, c *model_struct.LocalChatLog) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return errs.WrapMsg(err, "UpdateMessage failed")
+	}
+	if c.ClientMsgID == "" {
+		return errs.WrapMsg(gorm.ErrMissingWhereClause, "UpdateMessage failed")
+	}
+	rows := t.update(func(v *model_struct.LocalChatLog) bool { return v.ClientMsgID == c.ClientMsgID },
+		func(v *model_struct.LocalChatLog) { updateRow(v, c, false) })
+	if rows == 0 {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update ")
+	}
+	return nil
+}
+
+func (d *DataBase) UpdateMessageBySeq(ctx context.Context, conversationID string, c *model_struct.LocalChatLog) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return errs.WrapMsg(err, "UpdateMessage failed")
+	}
+	t.update(func(v *model_struct.LocalChatLog) bool {
+		return v.Seq == c.Seq && (c.ClientMsgID == "" || v.ClientMsgID == c.ClientMsgID)
+	}, func(v *model_struct.LocalChatLog) { updateRow(v, c, false) })
+	return nil
+}
+
+func (d *DataBase) BatchInsertMessageList(ctx context.Context, conversationID string, MessageList []*model_struct.LocalChatLog) error {
+	t := d.initChatLog(conversationID)
+	if MessageList == nil {
+		return nil
+	}
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(t.insert(MessageList...), "BatchInsertMessageList failed")
+}
+
+func (d *DataBase) InsertMessage(ctx context.Context, conversationID string, Message *model_struct.LocalChatLog) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return errs.WrapMsg(err, "InsertMessage failed")
+	}
+	return errs.WrapMsg(t.insert(Message), "InsertMessage failed")
+}
+
+func (d *DataBase) GetMessage(ctx context.Context, conversationID string, clientMsgID string) (*model_struct.LocalChatLog, error) {
+	t := d.initChatLog(conversationID)
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if c, ok := t.get(clientMsgID); ok {
+		return c, nil
+	}
+	return &model_struct.LocalChatLog{}, errs.WrapMsg(errRecordNotFound, "GetMessage failed")
+}
+
+func (d *DataBase) GetMessageBySeq(ctx context.Context, conversationID string, seq int64) (*model_struct.LocalChatLog, error) {
+	t := d.initChatLog(conversationID)
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if c, ok := t.take(func(v *model_struct.LocalChatLog) bool { return v.Seq == seq }); ok {
+		return c, nil
+	}
+	return &model_struct.LocalChatLog{}, errs.WrapMsg(errRecordNotFound, "GetMessage failed")
+}
+
+func (d *DataBase) UpdateMessageTimeAndStatus(ctx context.Context, conversationID, clientMsgID string, serverMsgID string, sendTime int64, status int32) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return errs.WrapMsg(err, "UpdateMessageStatusBySourceID failed")
+	}
+	c := &model_struct.LocalChatLog{Status: status, SendTime: sendTime, ServerMsgID: serverMsgID}
+	t.update(func(v *model_struct.LocalChatLog) bool { return v.ClientMsgID == clientMsgID && v.Seq == 0 },
+		func(v *model_struct.LocalChatLog) { updateRow(v, c, false) })
+	return nil
+}
+
+func (d *DataBase) GetMessageList(ctx context.Context, conversationID string, count int, startTime, startSeq int64, startClientMsgID string, isReverse bool) (result []*model_struct.LocalChatLog, err error) {
+	t := d.initChatLog(conversationID)
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	// past reports whether a lies beyond b in the direction the list is paged
+	past := func(a, b int64) bool { return a > b }
+	if !isReverse {
+		past = func(a, b int64) bool { return a < b }
+	}
+	var where func(v *model_struct.LocalChatLog) bool
+	if startTime > 0 {
+		where = func(v *model_struct.LocalChatLog) bool {
+			return past(v.SendTime, startTime) ||
+				(v.SendTime == startTime && (past(v.Seq, startSeq) || (v.Seq == 0 && v.ClientMsgID != startClientMsgID)))
+		}
+	}
+	result = orderBy(t.find(where), func(a, b *model_struct.LocalChatLog) bool {
+		if a.SendTime != b.SendTime {
+			return past(b.SendTime, a.SendTime)
+		}
+		return past(b.Seq, a.Seq)
+	})
+	return limit(result, 0, count), nil
+}
+
+func (d *DataBase) DeleteConversationAllMessages(ctx context.Context, conversationID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return errs.WrapMsg(err, "DeleteConversationAllMessages failed")
+	}
+	t.delete(nil)
+	return nil
+}
+
+func (d *DataBase) MarkDeleteConversationAllMessages(ctx context.Context, conversationID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return errs.WrapMsg(err, "DeleteConversationAllMessages failed")
+	}
+	t.update(nil, func(v *model_struct.LocalChatLog) { v.Status = constant.MsgStatusHasDeleted })
+	return nil
+}
+
+func (d *DataBase) DeleteConversationMsgs(ctx context.Context, conversationID string, msgIDs []string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return errs.WrapMsg(err, "DeleteConversationMsgs failed")
+	}
+	t.delete(func(v *model_struct.LocalChatLog) bool { return in(v.ClientMsgID, msgIDs) })
+	return nil
+}
+
+func (d *DataBase) DeleteConversationMsgsBySeqs(ctx context.Context, conversationID string, seqs []int64) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return errs.WrapMsg(err, "DeleteConversationMsgs failed")
+	}
+	t.delete(func(v *model_struct.LocalChatLog) bool { return in(v.Seq, seqs) })
+	return nil
+}
+
+// searchMessages returns the messages of a conversation sent between startTime and endTime that
+// were not deleted, newest first.
+func (d *DataBase) searchMessages(conversationID string, contentType []int, startTime, endTime int64, where func(v *model_struct.LocalChatLog) bool) ([]*model_struct.LocalChatLog, error) {
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "SearchMessage failed")
+	}
+	result := t.find(func(v *model_struct.LocalChatLog) bool {
+		return v.SendTime >= startTime && v.SendTime <= endTime && v.Status <= constant.MsgStatusSendFailed &&
+			in(int(v.ContentType), contentType) && where(v)
+	})
+	return orderBy(result, func(a, b *model_struct.LocalChatLog) bool { return a.SendTime > b.SendTime }), nil
+}
+
+// matchKeywords matches content against every keyword, or any of them for KeywordMatchOr.
+func matchKeywords(content string, keywordList []string, keywordListMatchType int) bool {
+	for _, keyword := range keywordList {
+		matched := like(content, keyword)
+		if keywordListMatchType == constant.KeywordMatchOr && matched {
+			return true
+		}
+		if keywordListMatchType != constant.KeywordMatchOr && !matched {
+			return false
+		}
+	}
+	return keywordListMatchType != constant.KeywordMatchOr
+}
+
+func (d *DataBase) SearchMessageByContentType(ctx context.Context, contentType []int, senderUserIDList []string, conversationID string, startTime, endTime int64, offset, count int) (result []*model_struct.LocalChatLog, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	result, err = d.searchMessages(conversationID, contentType, startTime, endTime, func(v *model_struct.LocalChatLog) bool {
+		return len(senderUserIDList) == 0 || in(v.SendID, senderUserIDList)
+	})
+	return limit(result, offset, count), err
+}
+
+func (d *DataBase) SearchMessageByKeyword(ctx context.Context, contentType []int, senderUserIDList []string, keywordList []string, keywordListMatchType int, conversationID string, startTime, endTime int64, offset, count int) (result []*model_struct.LocalChatLog, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if len(keywordList) == 0 {
+		return nil, errs.WrapMsg(errors.New("keyword list is empty"), "SearchMessage failed")
+	}
+	result, err = d.searchMessages(conversationID, contentType, startTime, endTime, func(v *model_struct.LocalChatLog) bool {
+		return matchKeywords(v.Content, keywordList, keywordListMatchType) && (senderUserIDList == nil || in(v.SendID, senderUserIDList))
+	})
+	return limit(result, offset, count), err
+}
+
+// SearchMessageByContentTypeAndKeyword searches for messages in the database that match specified content types and keywords within a given time range.
+func (d *DataBase) SearchMessageByContentTypeAndKeyword(ctx context.Context, contentType []int, conversationID string, senderUserIDList []string, keywordList []string, keywordListMatchType int, startTime, endTime int64) (result []*model_struct.LocalChatLog, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if len(keywordList) == 0 {
+		return nil, errs.WrapMsg(errors.New("keyword list is empty"), "SearchMessage failed")
+	}
+	return d.searchMessages(conversationID, contentType, startTime, endTime, func(v *model_struct.LocalChatLog) bool {
+		return matchKeywords(v.Content, keywordList, keywordListMatchType) && (senderUserIDList == nil || in(v.SendID, senderUserIDList))
+	})
+}
+
+func (d *DataBase) UpdateMsgSenderFaceURLAndSenderNickname(ctx context.Context, conversationID, sendID, faceURL, nickname string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return errs.WrapMsg(err, utils.GetSelfFuncName()+" failed")
+	}
+	t.update(func(v *model_struct.LocalChatLog) bool { return v.SendID == sendID }, func(v *model_struct.LocalChatLog) {
+		v.SenderFaceURL = faceURL
+		v.SenderNickname = nickname
+	})
+	return nil
+}
+
+func (d *DataBase) UpdateColumnsMessage(ctx context.Context, conversationID, ClientMsgID string, args map[string]interface{}) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return errs.WrapMsg(err, "UpdateColumnsConversation failed")
+	}
+	if err := checkColumns[model_struct.LocalChatLog](args); err != nil {
+		return errs.WrapMsg(err, "UpdateColumnsConversation failed")
+	}
+	var setErr error
+	rows := t.update(func(v *model_struct.LocalChatLog) bool { return v.ClientMsgID == ClientMsgID }, func(v *model_struct.LocalChatLog) {
+		setErr = updateColumns(v, args)
+	})
+	if rows == 0 {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return errs.WrapMsg(setErr, "UpdateColumnsConversation failed")
+}
+
+func (d *DataBase) SearchAllMessageByContentType(ctx context.Context, conversationID string, contentType int) (result []*model_struct.LocalChatLog, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return nil, err
+	}
+	return t.find(func(v *model_struct.LocalChatLog) bool { return int(v.ContentType) == contentType }), nil
+}
+
+func (d *DataBase) GetUnreadMessage(ctx context.Context, conversationID string) (msgs []*model_struct.LocalChatLog, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "GetMessageList failed")
+	}
+	return t.find(func(v *model_struct.LocalChatLog) bool { return v.SendID != d.loginUserID && !v.IsRead }), nil
+}
+
+func (d *DataBase) MarkConversationMessageAsReadBySeqs(ctx context.Context, conversationID string, seqs []int64) (rowsAffected int64, err error) {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return 0, errs.WrapMsg(err, "UpdateMessageStatusBySourceID failed")
+	}
+	rowsAffected = t.update(func(v *model_struct.LocalChatLog) bool { return in(v.Seq, seqs) && v.SendID != d.loginUserID },
+		func(v *model_struct.LocalChatLog) { v.IsRead = true })
+	if rowsAffected == 0 {
+		return 0, errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return rowsAffected, nil
+}
+
+func (d *DataBase) MarkConversationMessageAsReadDB(ctx context.Context, conversationID string, msgIDs []string) (rowsAffected int64, err error) {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return 0, errs.WrapMsg(err, "MarkConversationMessageAsReadDB failed")
+	}
+	rowsAffected = t.update(func(v *model_struct.LocalChatLog) bool { return in(v.ClientMsgID, msgIDs) && v.SendID != d.loginUserID },
+		func(v *model_struct.LocalChatLog) {
+			var attachedInfo sdk_struct.AttachedInfoElem
+			utils.JsonStringToStruct(v.AttachedInfo, &attachedInfo)
+			attachedInfo.HasReadTime = utils.GetCurrentTimestampByMill()
+			v.IsRead = true
+			v.AttachedInfo = utils.StructToJsonString(attachedInfo)
+		})
+	return rowsAffected, nil
+}
+
+func (d *DataBase) MarkConversationAllMessageAsRead(ctx context.Context, conversationID string) (rowsAffected int64, err error) {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return 0, errs.WrapMsg(err, "UpdateMessageStatusBySourceID failed")
+	}
+	rowsAffected = t.update(func(v *model_struct.LocalChatLog) bool { return v.SendID != d.loginUserID && !v.IsRead },
+		func(v *model_struct.LocalChatLog) { v.IsRead = true })
+	if rowsAffected == 0 {
+		return 0, errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return rowsAffected, nil
+}
+
+func (d *DataBase) GetMessagesByClientMsgIDs(ctx context.Context, conversationID string, msgIDs []string) (msgs []*model_struct.LocalChatLog, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "GetMessagesByClientMsgIDs error")
+	}
+	msgs = t.find(func(v *model_struct.LocalChatLog) bool { return in(v.ClientMsgID, msgIDs) })
+	return orderBy(msgs, func(a, b *model_struct.LocalChatLog) bool { return a.SendTime > b.SendTime }), nil
+}
+
+func (d *DataBase) GetMessagesBySeqs(ctx context.Context, conversationID string, seqs []int64) (msgs []*model_struct.LocalChatLog, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "GetMessagesBySeqs error")
+	}
+	msgs = t.find(func(v *model_struct.LocalChatLog) bool { return in(v.Seq, seqs) })
+	return orderBy(msgs, func(a, b *model_struct.LocalChatLog) bool { return a.SendTime > b.SendTime }), nil
+}
+
+// maxSeq returns the largest seq of the messages matching where, or 0 without any.
+func maxSeq(t *table[string, model_struct.LocalChatLog], where func(v *model_struct.LocalChatLog) bool) int64 {
+	var seq int64
+	for _, v := range t.find(where) {
+		if v.Seq > seq {
+			seq = v.Seq
+		}
+	}
+	return seq
+}
+
+func (d *DataBase) GetConversationNormalMsgSeq(ctx context.Context, conversationID string) (int64, error) {
+	t := d.initChatLog(conversationID)
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return maxSeq(t, nil), nil
+}
+
+func (d *DataBase) CheckConversationNormalMsgSeq(ctx context.Context, conversationID string) (int64, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return 0, nil
+	}
+	return maxSeq(t, nil), nil
+}
+
+func (d *DataBase) GetConversationPeerNormalMsgSeq(ctx context.Context, conversationID string) (int64, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return 0, errs.WrapMsg(err, "GetConversationPeerNormalMsgSeq")
+	}
+	return maxSeq(t, func(v *model_struct.LocalChatLog) bool { return v.SendID != d.loginUserID }), nil
+}
+
+func (d *DataBase) GetLatestActiveMessage(ctx context.Context, conversationID string, isReverse bool) (result []*model_struct.LocalChatLog, err error) {
+	t := d.initChatLog(conversationID)
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	// only get status < 4(NotHasDeleted) Msg
+	result = t.find(func(v *model_struct.LocalChatLog) bool { return v.Status < constant.MsgStatusHasDeleted })
+	result = orderBy(result, func(a, b *model_struct.LocalChatLog) bool {
+		if isReverse {
+			return a.SendTime < b.SendTime
+		}
+		return a.SendTime > b.SendTime
+	})
+	return limit(result, 0, 1), nil
+}
+
+func (d *DataBase) GetLatestValidServerMessage(ctx context.Context, conversationID string, startTime int64, isReverse bool) (*model_struct.LocalChatLog, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "GetLatestValidServerMessage failed")
+	}
+	result := t.find(func(v *model_struct.LocalChatLog) bool {
+		if v.Seq == 0 {
+			return false
+		}
+		if isReverse {
+			return v.SendTime < startTime
+		}
+		return v.SendTime > startTime
+	})
+	result = orderBy(result, func(a, b *model_struct.LocalChatLog) bool {
+		if isReverse {
+			return a.SendTime > b.SendTime
+		}
+		return a.SendTime < b.SendTime
+	})
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result[0], nil
+}
+
+// isThreadReply reports whether v is a live reply to one of rootClientMsgIDs.
+func isThreadReply(v *model_struct.LocalChatLog, rootClientMsgIDs []string) bool {
+	return v.Status < constant.MsgStatusHasDeleted && v.ContentType != constant.RevokeNotification && in(v.ThreadRootID, rootClientMsgIDs)
+}
+
+func (d *DataBase) GetThreadMessages(ctx context.Context, conversationID, rootClientMsgID string, startTime int64, startClientMsgID string, count int) (result []*model_struct.LocalChatLog, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "GetThreadMessages failed")
+	}
+	result = t.find(func(v *model_struct.LocalChatLog) bool {
+		return isThreadReply(v, []string{rootClientMsgID}) && (v.SendTime > startTime || v.SendTime == startTime && v.ClientMsgID > startClientMsgID)
+	})
+	return limit(orderBy(result, func(a, b *model_struct.LocalChatLog) bool {
+		return a.SendTime < b.SendTime || a.SendTime == b.SendTime && a.ClientMsgID < b.ClientMsgID
+	}), 0, count), nil
+}
+
+func (d *DataBase) GetThreadSummaries(ctx context.Context, conversationID string, rootClientMsgIDs []string) ([]*model_struct.LocalThreadSummary, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if len(rootClientMsgIDs) == 0 {
+		return nil, nil
+	}
+	t, err := d.chatLog(conversationID, false)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "GetThreadSummaries failed")
+	}
+	var summaries []*model_struct.LocalThreadSummary
+	index := make(map[string]*model_struct.LocalThreadSummary)
+	for _, v := range t.find(func(v *model_struct.LocalChatLog) bool { return isThreadReply(v, rootClientMsgIDs) }) {
+		summary, ok := index[v.ThreadRootID]
+		if !ok {
+			summary = &model_struct.LocalThreadSummary{RootClientMsgID: v.ThreadRootID}
+			index[v.ThreadRootID] = summary
+			summaries = append(summaries, summary)
+		}
+		summary.ReplyCount++
+		if summary.LatestReply == nil || v.SendTime > summary.LatestReply.SendTime {
+			summary.LatestReply = v
+		}
+	}
+	return summaries, nil
+}
@@ -0,0 +1,143 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+var columnCache sync.Map // reflect.Type -> map[string][]int
+
+// columns maps the gorm column names of a model struct to its field indexes, flattening
+// embedded structs the way gorm does.
+func columns(t reflect.Type) map[string][]int {
+	if cols, ok := columnCache.Load(t); ok {
+		return cols.(map[string][]int)
+	}
+	cols := make(map[string][]int)
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			fieldIndex := append(append([]int{}, index...), i)
+			name := columnName(field.Tag.Get("gorm"))
+			if name == "" && field.Anonymous && field.Type.Kind() == reflect.Struct {
+				walk(field.Type, fieldIndex)
+				continue
+			}
+			if name != "" {
+				cols[name] = fieldIndex
+			}
+		}
+	}
+	walk(t, nil)
+	columnCache.Store(t, cols)
+	return cols
+}
+
+func columnName(tag string) string {
+	for _, part := range strings.Split(tag, ";") {
+		if name, ok := strings.CutPrefix(part, "column:"); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// updateRow copies src into dst like gorm's Updates with a struct: only the non-zero fields,
+// or every field when all is set as with Select("*").
+func updateRow[V any](dst, src *V, all bool) {
+	copyFields(reflect.ValueOf(dst).Elem(), reflect.ValueOf(src).Elem(), all)
+}
+
+func copyFields(dst, src reflect.Value, all bool) {
+	for i := 0; i < src.NumField(); i++ {
+		field := src.Type().Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && columnName(field.Tag.Get("gorm")) == "" {
+			copyFields(dst.Field(i), src.Field(i), all)
+			continue
+		}
+		if all || !src.Field(i).IsZero() {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+}
+
+// updateColumns sets the columns of row named in args like gorm's Updates with a map.
+func updateColumns[V any](row *V, args map[string]interface{}) error {
+	v := reflect.ValueOf(row).Elem()
+	cols := columns(v.Type())
+	for name, value := range args {
+		index, ok := cols[name]
+		if !ok {
+			return errs.New("no such column", "column", name)
+		}
+		if err := setValue(v.FieldByIndex(index), value); err != nil {
+			return errs.WrapMsg(err, "column", name)
+		}
+	}
+	return nil
+}
+
+// checkColumns reports an unknown column before any row is touched.
+func checkColumns[V any](args map[string]interface{}) error {
+	cols := columns(reflect.TypeOf((*V)(nil)).Elem())
+	for name := range args {
+		if _, ok := cols[name]; !ok {
+			return errs.New("no such column", "column", name)
+		}
+	}
+	return nil
+}
+
+func setValue(field reflect.Value, value interface{}) error {
+	if value == nil {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+	v := reflect.ValueOf(value)
+	switch {
+	case field.Kind() == reflect.Bool && isNumber(v.Kind()):
+		field.SetBool(!v.IsZero())
+	case isNumber(field.Kind()) && v.Kind() == reflect.Bool:
+		if v.Bool() {
+			field.Set(reflect.ValueOf(1).Convert(field.Type()))
+		} else {
+			field.Set(reflect.Zero(field.Type()))
+		}
+	case isNumber(field.Kind()) && isNumber(v.Kind()),
+		field.Kind() == v.Kind() && v.Type().ConvertibleTo(field.Type()),
+		field.Kind() == reflect.String && v.Type() == reflect.TypeOf([]byte(nil)),
+		field.Type() == reflect.TypeOf([]byte(nil)) && v.Kind() == reflect.String:
+		field.Set(v.Convert(field.Type()))
+	default:
+		return errs.New("unsupported value type", "field", field.Type().String(), "value", v.Type().String())
+	}
+	return nil
+}
+
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,410 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"gorm.io/gorm"
+)
+
+// conversationDefaults fills in the column defaults of local_conversations for zero fields.
+func conversationDefaults(c *model_struct.LocalConversation) *model_struct.LocalConversation {
+	v := *c
+	if v.BurnDuration == 0 {
+		v.BurnDuration = 30
+	}
+	if v.MsgDestructTime == 0 {
+		v.MsgDestructTime = 604800
+	}
+	return &v
+}
+
+// conversationOrder sorts pinned conversations first, then by their latest message or draft.
+func conversationOrder(list []*model_struct.LocalConversation) []*model_struct.LocalConversation {
+	activeTime := func(c *model_struct.LocalConversation) int64 {
+		return max(c.LatestMsgSendTime, c.DraftTextTime)
+	}
+	return orderBy(list, func(a, b *model_struct.LocalConversation) bool {
+		if a.IsPinned != b.IsPinned {
+			return a.IsPinned
+		}
+		return activeTime(a) > activeTime(b)
+	})
+}
+
+func conversationIDs(list []*model_struct.LocalConversation) []string {
+	var ids []string
+	for _, c := range list {
+		ids = append(ids, c.ConversationID)
+	}
+	return ids
+}
+
+func (d *DataBase) GetConversationByUserID(ctx context.Context, userID string) (*model_struct.LocalConversation, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if c, ok := d.conversations.take(func(v *model_struct.LocalConversation) bool { return v.UserID == userID }); ok {
+		return c, nil
+	}
+	return &model_struct.LocalConversation{}, nil
+}
+
+func (d *DataBase) GetAllConversationListDB(ctx context.Context) ([]*model_struct.LocalConversation, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return conversationOrder(d.conversations.find(func(v *model_struct.LocalConversation) bool { return v.LatestMsgSendTime > 0 })), nil
+}
+
+func (d *DataBase) FindAllUnreadConversationConversationID(ctx context.Context) ([]string, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return conversationIDs(d.conversations.find(func(v *model_struct.LocalConversation) bool { return v.UnreadCount > 0 })), nil
+}
+
+func (d *DataBase) GetHiddenConversationList(ctx context.Context) ([]*model_struct.LocalConversation, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.conversations.find(func(v *model_struct.LocalConversation) bool { return v.LatestMsgSendTime == 0 }), nil
+}
+
+func (d *DataBase) GetAllConversations(ctx context.Context) ([]*model_struct.LocalConversation, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.conversations.find(nil), nil
+}
+
+func (d *DataBase) GetAllConversationIDList(ctx context.Context) (result []string, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return conversationIDs(d.conversations.find(nil)), nil
+}
+
+func (d *DataBase) GetAllSingleConversationIDList(ctx context.Context) (result []string, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return conversationIDs(d.conversations.find(func(v *model_struct.LocalConversation) bool {
+		return v.ConversationType == constant.SingleChatType
+	})), nil
+}
+
+func (d *DataBase) GetConversationListSplitDB(ctx context.Context, offset, count int) ([]*model_struct.LocalConversation, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	list := conversationOrder(d.conversations.find(func(v *model_struct.LocalConversation) bool { return v.LatestMsgSendTime > 0 }))
+	return limit(list, offset, count), nil
+}
+
+func (d *DataBase) BatchInsertConversationList(ctx context.Context, conversationList []*model_struct.LocalConversation) error {
+	if len(conversationList) == 0 {
+		return nil
+	}
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	list := make([]*model_struct.LocalConversation, 0, len(conversationList))
+	for _, c := range conversationList {
+		list = append(list, conversationDefaults(c))
+	}
+	return errs.WrapMsg(d.conversations.insert(list...), "BatchInsertConversationList failed")
+}
+
+func (d *DataBase) UpdateOrCreateConversations(ctx context.Context, conversationList []*model_struct.LocalConversation) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	var notExistConversations []*model_struct.LocalConversation
+	var existConversations []*model_struct.LocalConversation
+	for _, v := range conversationList {
+		if _, ok := d.conversations.get(v.ConversationID); ok {
+			existConversations = append(existConversations, v)
+		} else {
+			notExistConversations = append(notExistConversations, conversationDefaults(v))
+		}
+	}
+	if len(notExistConversations) > 0 {
+		if err := d.conversations.insert(notExistConversations...); err != nil {
+			return err
+		}
+	}
+	for _, v := range existConversations {
+		d.updateConversation(v.ConversationID, func(c *model_struct.LocalConversation) { c.UnreadCount = v.UnreadCount })
+	}
+	return nil
+}
+
+func (d *DataBase) InsertConversation(ctx context.Context, conversationList *model_struct.LocalConversation) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.conversations.insert(conversationDefaults(conversationList)), "InsertConversation failed")
+}
+
+func (d *DataBase) DeleteConversation(ctx context.Context, conversationID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.conversations.deleteKey(conversationID)
+	return nil
+}
+
+func (d *DataBase) DeleteAllConversation(ctx context.Context) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.conversations.delete(nil)
+	return nil
+}
+
+func (d *DataBase) GetConversation(ctx context.Context, conversationID string) (*model_struct.LocalConversation, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if c, ok := d.conversations.get(conversationID); ok {
+		return c, nil
+	}
+	return &model_struct.LocalConversation{}, errs.WrapMsg(errRecordNotFound, "GetConversation failed, conversationID: "+conversationID)
+}
+
+// updateConversation applies set to one conversation and reports whether it exists.
+func (d *DataBase) updateConversation(conversationID string, set func(c *model_struct.LocalConversation)) bool {
+	return d.conversations.updateKey(conversationID, set)
+}
+
+func (d *DataBase) UpdateConversation(ctx context.Context, c *model_struct.LocalConversation) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if c.ConversationID == "" {
+		return errs.WrapMsg(gorm.ErrMissingWhereClause, "UpdateConversation failed")
+	}
+	if !d.updateConversation(c.ConversationID, func(v *model_struct.LocalConversation) { updateRow(v, c, false) }) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) UpdateConversationForSync(ctx context.Context, c *model_struct.LocalConversation) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.updateConversation(c.ConversationID, func(v *model_struct.LocalConversation) {
+		v.RecvMsgOpt = c.RecvMsgOpt
+		v.IsPinned = c.IsPinned
+		v.IsPrivateChat = c.IsPrivateChat
+		v.GroupAtType = c.GroupAtType
+		v.IsNotInGroup = c.IsNotInGroup
+		v.UpdateUnreadCountTime = c.UpdateUnreadCountTime
+		v.Ex = c.Ex
+		v.AttachedInfo = c.AttachedInfo
+		v.BurnDuration = c.BurnDuration
+		v.MsgDestructTime = c.MsgDestructTime
+		v.IsMsgDestruct = c.IsMsgDestruct
+	}) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) BatchUpdateConversationList(ctx context.Context, conversationList []*model_struct.LocalConversation) error {
+	for _, v := range conversationList {
+		err := d.UpdateConversation(ctx, v)
+		if err != nil {
+			return errs.WrapMsg(err, "BatchUpdateConversationList failed")
+		}
+	}
+	return nil
+}
+
+func (d *DataBase) ConversationIfExists(ctx context.Context, conversationID string) (bool, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	_, ok := d.conversations.get(conversationID)
+	return ok, nil
+}
+
+// Reset the conversation is equivalent to deleting the conversation,
+// and the GetAllConversation or GetConversationListSplit interface will no longer be obtained.
+func (d *DataBase) ResetConversation(ctx context.Context, conversationID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.updateConversation(conversationID, resetConversation) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+// ResetAllConversation Reset ALL conversation is equivalent to deleting the conversation,
+// and the GetAllConversation or GetConversationListSplit interface will no longer be obtained.
+func (d *DataBase) ResetAllConversation(ctx context.Context) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if d.conversations.update(nil, resetConversation) == 0 {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func resetConversation(c *model_struct.LocalConversation) {
+	c.UnreadCount = 0
+	c.LatestMsg = ""
+	c.LatestMsgSendTime = 0
+	c.DraftText = ""
+	c.DraftTextTime = 0
+}
+
+// Clear the conversation, which is used to delete the conversation history message and clear the conversation at the same time.
+// The GetAllConversation or GetConversationListSplit interface can still be obtained,
+// but there is no latest message.
+func (d *DataBase) ClearConversation(ctx context.Context, conversationID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.updateConversation(conversationID, func(c *model_struct.LocalConversation) {
+		c.UnreadCount = 0
+		c.LatestMsg = ""
+		c.DraftText = ""
+		c.DraftTextTime = 0
+	}) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) SetConversationDraftDB(ctx context.Context, conversationID, draftText string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	nowTime := utils.GetCurrentTimestampByMill()
+	if !d.updateConversation(conversationID, func(c *model_struct.LocalConversation) {
+		c.DraftText = draftText
+		c.DraftTextTime = nowTime
+		if c.LatestMsgSendTime == 0 {
+			c.LatestMsgSendTime = nowTime
+		}
+	}) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) RemoveConversationDraft(ctx context.Context, conversationID, draftText string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.updateConversation(conversationID, func(c *model_struct.LocalConversation) {
+		c.DraftText = draftText
+		c.DraftTextTime = 0
+	}) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) UnPinConversation(ctx context.Context, conversationID string, isPinned int) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.updateConversation(conversationID, func(c *model_struct.LocalConversation) {
+		c.IsPinned = isPinned != 0
+		if c.DraftText == "" {
+			c.DraftTextTime = 0
+		}
+	}) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) UpdateColumnsConversation(ctx context.Context, conversationID string, args map[string]interface{}) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if err := checkColumns[model_struct.LocalConversation](args); err != nil {
+		return errs.WrapMsg(err, "UpdateColumnsConversation failed")
+	}
+	var err error
+	if !d.updateConversation(conversationID, func(c *model_struct.LocalConversation) { err = updateColumns(c, args) }) {
+		return errs.WrapMsg(errs.ErrRecordNotFound, "no update")
+	}
+	return errs.WrapMsg(err, "UpdateColumnsConversation failed")
+}
+
+func (d *DataBase) UpdateAllConversation(ctx context.Context, conversation *model_struct.LocalConversation) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if conversation.ConversationID != "" {
+		return errs.WrapMsg(errors.New("not update all conversation"), "UpdateAllConversation failed")
+	}
+	if d.conversations.update(nil, func(c *model_struct.LocalConversation) {
+		id := c.ConversationID
+		updateRow(c, conversation, false)
+		c.ConversationID = id
+	}) == 0 {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) IncrConversationUnreadCount(ctx context.Context, conversationID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.updateConversation(conversationID, func(c *model_struct.LocalConversation) { c.UnreadCount++ }) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) GetTotalUnreadMsgCountDB(ctx context.Context) (totalUnreadCount int32, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	for _, c := range d.conversations.find(func(v *model_struct.LocalConversation) bool {
+		return v.RecvMsgOpt < constant.ReceiveNotNotifyMessage && v.LatestMsgSendTime > 0
+	}) {
+		totalUnreadCount += c.UnreadCount
+	}
+	return totalUnreadCount, nil
+}
+
+func (d *DataBase) SetMultipleConversationRecvMsgOpt(ctx context.Context, conversationIDList []string, opt int) (err error) {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if d.conversations.update(func(c *model_struct.LocalConversation) bool { return in(c.ConversationID, conversationIDList) },
+		func(c *model_struct.LocalConversation) { c.RecvMsgOpt = int32(opt) }) == 0 {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) GetMultipleConversationDB(ctx context.Context, conversationIDList []string) (result []*model_struct.LocalConversation, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.conversations.find(func(c *model_struct.LocalConversation) bool { return in(c.ConversationID, conversationIDList) }), nil
+}
+
+func (d *DataBase) DecrConversationUnreadCount(ctx context.Context, conversationID string, count int64) (err error) {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.updateConversation(conversationID, func(c *model_struct.LocalConversation) {
+		c.UnreadCount -= int32(count)
+		if c.UnreadCount < 0 {
+			log.ZWarn(ctx, "decr unread count < 0", nil, "conversationID", conversationID, "count", count)
+			c.UnreadCount = 0
+		}
+	}) {
+		return errs.WrapMsg(errors.New("get conversation err"), "")
+	}
+	return nil
+}
+
+func (d *DataBase) SearchConversations(ctx context.Context, searchParam string) ([]*model_struct.LocalConversation, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	list := d.conversations.find(func(c *model_struct.LocalConversation) bool { return like(c.ShowName, searchParam) })
+	return orderBy(list, func(a, b *model_struct.LocalConversation) bool { return a.LatestMsgSendTime > b.LatestMsgSendTime }), nil
+}
@@ -0,0 +1,169 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package memdb is an in-memory implementation of db_interface.DataBase with the semantics of the
+// SQLite database in pkg/db, for tests and stateless clients that keep nothing on disk.
+package memdb
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/db_interface"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/version"
+	"github.com/openimsdk/tools/errs"
+	"gorm.io/gorm"
+)
+
+// errRecordNotFound is what the SQLite database wraps for a missing row outside the methods that
+// return errs.ErrRecordNotFound.
+var errRecordNotFound = gorm.ErrRecordNotFound
+
+type pair struct {
+	a, b string
+}
+
+type userCommandKey struct {
+	userID string
+	typ    int32
+	uuid   string
+}
+
+var _ db_interface.DataBase = (*DataBase)(nil)
+
+type DataBase struct {
+	loginUserID string
+	mRWMutex    sync.RWMutex
+
+	// tables lists the table names in creation order, for GetExistTables.
+	tables []string
+
+	appSDKVersion          *model_struct.LocalAppSDKVersion
+	friends                *table[pair, model_struct.LocalFriend]
+	friendRequests         *table[pair, model_struct.LocalFriendRequest]
+	groups                 *table[string, model_struct.LocalGroup]
+	groupMembers           *table[pair, model_struct.LocalGroupMember]
+	groupRequests          *table[pair, model_struct.LocalGroupRequest]
+	adminGroupRequests     *table[pair, model_struct.LocalAdminGroupRequest]
+	users                  *table[string, model_struct.LocalUser]
+	blacks                 *table[pair, model_struct.LocalBlack]
+	conversations          *table[string, model_struct.LocalConversation]
+	notificationSeqs       *table[string, model_struct.NotificationSeqs]
+	conversationUnreadMsgs *table[pair, model_struct.LocalConversationUnreadMessage]
+	reactionExtensions     *table[string, model_struct.LocalChatLogReactionExtensions]
+	uploads                *table[string, model_struct.LocalUpload]
+	sendingMessages        *table[pair, model_struct.LocalSendingMessages]
+	scheduledMessages      *table[string, model_struct.LocalScheduledMessage]
+	outboxMessages         *table[string, model_struct.LocalOutboxMessage]
+	pinnedMessages         *table[pair, model_struct.LocalPinnedMessage]
+	mentions               *table[pair, model_struct.LocalMention]
+	userCommands           *table[userCommandKey, model_struct.LocalUserCommand]
+	versionSyncs           *table[pair, model_struct.LocalVersionSync]
+	chatLogs               map[string]*table[string, model_struct.LocalChatLog]
+}
+
+// NewDataBase returns an empty in-memory database for loginUserID.
+func NewDataBase(ctx context.Context, loginUserID string) (*DataBase, error) {
+	d := &DataBase{}
+	if err := d.InitDB(ctx, loginUserID, ""); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// InitDB resets the database to the state of a first login of userID, dataDir is unused.
+func (d *DataBase) InitDB(ctx context.Context, userID string, dataDir string) error {
+	if userID == "" {
+		return errs.Wrap(errors.New("no uid"))
+	}
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.loginUserID = userID
+	d.appSDKVersion = &model_struct.LocalAppSDKVersion{Version: version.Version}
+	d.friends = newTable("local_friends", func(v *model_struct.LocalFriend) pair { return pair{v.OwnerUserID, v.FriendUserID} })
+	d.friendRequests = newTable("local_friend_requests", func(v *model_struct.LocalFriendRequest) pair { return pair{v.FromUserID, v.ToUserID} })
+	d.groups = newTable("local_groups", func(v *model_struct.LocalGroup) string { return v.GroupID })
+	d.groupMembers = newTable("local_group_members", func(v *model_struct.LocalGroupMember) pair { return pair{v.GroupID, v.UserID} })
+	d.groupRequests = newTable("local_group_requests", func(v *model_struct.LocalGroupRequest) pair { return pair{v.GroupID, v.UserID} })
+	d.users = newTable("local_users", func(v *model_struct.LocalUser) string { return v.UserID })
+	d.blacks = newTable("local_blacks", func(v *model_struct.LocalBlack) pair { return pair{v.OwnerUserID, v.BlockUserID} })
+	d.conversations = newTable("local_conversations", func(v *model_struct.LocalConversation) string { return v.ConversationID })
+	d.notificationSeqs = newTable("local_notification_seqs", func(v *model_struct.NotificationSeqs) string { return v.ConversationID })
+	d.conversationUnreadMsgs = newTable("local_conversation_unread_messages", func(v *model_struct.LocalConversationUnreadMessage) pair {
+		return pair{v.ConversationID, v.ClientMsgID}
+	})
+	d.adminGroupRequests = newTable("local_admin_group_requests", func(v *model_struct.LocalAdminGroupRequest) pair { return pair{v.GroupID, v.UserID} })
+	d.reactionExtensions = newTable("local_chat_log_reaction_extensions", func(v *model_struct.LocalChatLogReactionExtensions) string { return v.ClientMsgID })
+	d.uploads = newTable("local_uploads", func(v *model_struct.LocalUpload) string { return v.PartHash })
+	d.sendingMessages = newTable("local_sending_messages", func(v *model_struct.LocalSendingMessages) pair { return pair{v.ConversationID, v.ClientMsgID} })
+	d.scheduledMessages = newTable("local_scheduled_messages", func(v *model_struct.LocalScheduledMessage) string { return v.ClientMsgID })
+	d.outboxMessages = newTable("local_outbox_messages", func(v *model_struct.LocalOutboxMessage) string { return v.ClientMsgID })
+	d.pinnedMessages = newTable("local_pinned_messages", func(v *model_struct.LocalPinnedMessage) pair { return pair{v.ConversationID, v.ClientMsgID} })
+	d.mentions = newTable("local_mentions", func(v *model_struct.LocalMention) pair { return pair{v.ConversationID, v.ClientMsgID} })
+	d.userCommands = newTable("local_user_command", func(v *model_struct.LocalUserCommand) userCommandKey { return userCommandKey{v.UserID, v.Type, v.Uuid} })
+	d.versionSyncs = newTable("local_sync_version", func(v *model_struct.LocalVersionSync) pair { return pair{v.Table, v.EntityID} })
+	d.chatLogs = make(map[string]*table[string, model_struct.LocalChatLog])
+	d.tables = []string{
+		"local_app_sdk_version", d.friends.name, d.friendRequests.name, d.groups.name, d.groupMembers.name,
+		d.groupRequests.name, d.users.name, d.blacks.name, d.conversations.name, d.notificationSeqs.name,
+		d.conversationUnreadMsgs.name, d.adminGroupRequests.name, d.reactionExtensions.name, d.uploads.name,
+		d.sendingMessages.name, d.scheduledMessages.name, d.outboxMessages.name, d.pinnedMessages.name,
+		d.mentions.name, d.userCommands.name, d.versionSyncs.name,
+	}
+	return nil
+}
+
+// Close does nothing, the data goes away with the DataBase.
+func (d *DataBase) Close(ctx context.Context) error {
+	return nil
+}
+
+// Rekey is not supported by the memory database, which keeps nothing at rest.
+func (d *DataBase) Rekey(ctx context.Context, key string) error {
+	return sdkerrs.ErrDBEncryptNotSupport.WrapMsg("memory database does not support encryption")
+}
+
+func (d *DataBase) GetExistTables(ctx context.Context) ([]string, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return append([]string(nil), d.tables...), nil
+}
+
+// chatLog returns the chat log table of a conversation, creating it when create is set. A missing
+// table is an error, as it is for the SQLite queries that do not create it first.
+func (d *DataBase) chatLog(conversationID string, create bool) (*table[string, model_struct.LocalChatLog], error) {
+	name := utils.GetTableName(conversationID)
+	if t, ok := d.chatLogs[name]; ok {
+		return t, nil
+	}
+	if !create {
+		return nil, errs.New("no such table", "table", name)
+	}
+	t := newTable(name, func(v *model_struct.LocalChatLog) string { return v.ClientMsgID })
+	d.chatLogs[name] = t
+	d.tables = append(d.tables, name)
+	return t, nil
+}
+
+// initChatLog creates the chat log table of a conversation if it does not exist yet.
+func (d *DataBase) initChatLog(conversationID string) *table[string, model_struct.LocalChatLog] {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t, _ := d.chatLog(conversationID, true)
+	return t
+}
@@ -0,0 +1,125 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) InsertFriend(ctx context.Context, friend *model_struct.LocalFriend) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.friends.insert(friend), "InsertFriend failed")
+}
+
+func (d *DataBase) DeleteFriendDB(ctx context.Context, friendUserID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.friends.deleteKey(pair{d.loginUserID, friendUserID})
+	return nil
+}
+
+func (d *DataBase) GetFriendListCount(ctx context.Context) (int64, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return int64(d.friends.len()), nil
+}
+
+func (d *DataBase) UpdateFriend(ctx context.Context, friend *model_struct.LocalFriend) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.friends.updateKey(pair{friend.OwnerUserID, friend.FriendUserID}, func(v *model_struct.LocalFriend) { updateRow(v, friend, true) }) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) GetAllFriendList(ctx context.Context) ([]*model_struct.LocalFriend, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.friends.find(func(v *model_struct.LocalFriend) bool { return v.OwnerUserID == d.loginUserID }), nil
+}
+
+func (d *DataBase) GetPageFriendList(ctx context.Context, offset, count int) ([]*model_struct.LocalFriend, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	friendList := d.friends.find(func(v *model_struct.LocalFriend) bool { return v.OwnerUserID == d.loginUserID })
+	friendList = orderBy(friendList, func(a, b *model_struct.LocalFriend) bool { return a.Nickname < b.Nickname })
+	return limit(friendList, offset, count), nil
+}
+
+func (d *DataBase) BatchInsertFriend(ctx context.Context, friendList []*model_struct.LocalFriend) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if friendList == nil {
+		return errs.New("nil").Wrap()
+	}
+	return errs.WrapMsg(d.friends.insert(friendList...), "BatchInsertFriendList failed")
+}
+
+func (d *DataBase) DeleteAllFriend(ctx context.Context) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.friends.delete(nil)
+	return nil
+}
+
+func (d *DataBase) SearchFriendList(ctx context.Context, keyword string, isSearchUserID, isSearchNickname, isSearchRemark bool) ([]*model_struct.LocalFriend, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	friendList := d.friends.find(func(v *model_struct.LocalFriend) bool {
+		if !isSearchUserID && !isSearchNickname && !isSearchRemark {
+			return true
+		}
+		return (isSearchUserID && like(v.FriendUserID, keyword)) ||
+			(isSearchNickname && like(v.Nickname, keyword)) ||
+			(isSearchRemark && like(v.Remark, keyword))
+	})
+	return orderBy(friendList, func(a, b *model_struct.LocalFriend) bool { return a.CreateTime > b.CreateTime }), nil
+}
+
+func (d *DataBase) GetFriendInfoByFriendUserID(ctx context.Context, FriendUserID string) (*model_struct.LocalFriend, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if friend, ok := d.friends.get(pair{d.loginUserID, FriendUserID}); ok {
+		return friend, nil
+	}
+	return &model_struct.LocalFriend{}, errs.WrapMsg(errRecordNotFound, "GetFriendInfoByFriendUserID failed")
+}
+
+func (d *DataBase) GetFriendInfoList(ctx context.Context, friendUserIDList []string) ([]*model_struct.LocalFriend, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.friends.find(func(v *model_struct.LocalFriend) bool { return in(v.FriendUserID, friendUserIDList) }), nil
+}
+
+func (d *DataBase) UpdateColumnsFriend(ctx context.Context, friendIDs []string, args map[string]interface{}) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if err := checkColumns[model_struct.LocalFriend](args); err != nil {
+		return errs.WrapMsg(err, "UpdateColumnsFriend failed")
+	}
+	var err error
+	d.friends.update(func(v *model_struct.LocalFriend) bool { return in(v.FriendUserID, friendIDs) }, func(v *model_struct.LocalFriend) {
+		if setErr := updateColumns(v, args); setErr != nil {
+			err = setErr
+		}
+	})
+	return errs.WrapMsg(err, "UpdateColumnsFriend failed")
+}
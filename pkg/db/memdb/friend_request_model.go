@@ -0,0 +1,81 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) InsertFriendRequest(ctx context.Context, friendRequest *model_struct.LocalFriendRequest) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.friendRequests.insert(friendRequest), "InsertFriendRequest failed")
+}
+
+func (d *DataBase) DeleteFriendRequestBothUserID(ctx context.Context, fromUserID, toUserID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.friendRequests.deleteKey(pair{fromUserID, toUserID})
+	return nil
+}
+
+func (d *DataBase) UpdateFriendRequest(ctx context.Context, friendRequest *model_struct.LocalFriendRequest) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.friendRequests.updateKey(pair{friendRequest.FromUserID, friendRequest.ToUserID}, func(v *model_struct.LocalFriendRequest) {
+		updateRow(v, friendRequest, true)
+	}) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+// friendRequestsByCreateTime returns the friend requests matching where, newest first.
+func (d *DataBase) friendRequestsByCreateTime(where func(v *model_struct.LocalFriendRequest) bool) []*model_struct.LocalFriendRequest {
+	return orderBy(d.friendRequests.find(where), func(a, b *model_struct.LocalFriendRequest) bool { return a.CreateTime > b.CreateTime })
+}
+
+func (d *DataBase) GetRecvFriendApplication(ctx context.Context) ([]*model_struct.LocalFriendRequest, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.friendRequestsByCreateTime(func(v *model_struct.LocalFriendRequest) bool { return v.ToUserID == d.loginUserID }), nil
+}
+
+func (d *DataBase) GetSendFriendApplication(ctx context.Context) ([]*model_struct.LocalFriendRequest, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.friendRequestsByCreateTime(func(v *model_struct.LocalFriendRequest) bool { return v.FromUserID == d.loginUserID }), nil
+}
+
+func (d *DataBase) GetFriendApplicationByBothID(ctx context.Context, fromUserID, toUserID string) (*model_struct.LocalFriendRequest, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if friendRequest, ok := d.friendRequests.get(pair{fromUserID, toUserID}); ok {
+		return friendRequest, nil
+	}
+	return &model_struct.LocalFriendRequest{}, errs.WrapMsg(errRecordNotFound, "GetFriendApplicationByBothID failed")
+}
+
+func (d *DataBase) GetBothFriendReq(ctx context.Context, fromUserID, toUserID string) (friendRequests []*model_struct.LocalFriendRequest, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.friendRequests.find(func(v *model_struct.LocalFriendRequest) bool {
+		return (v.FromUserID == fromUserID && v.ToUserID == toUserID) || (v.FromUserID == toUserID && v.ToUserID == fromUserID)
+	}), nil
+}
@@ -0,0 +1,177 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+// roleLevels returns the role levels a group member filter selects, nil for every member.
+func roleLevels(filter int32) ([]int32, bool) {
+	switch filter {
+	case constant.GroupFilterAll:
+		return nil, true
+	case constant.GroupFilterOwner:
+		return []int32{constant.GroupOwner}, true
+	case constant.GroupFilterAdmin:
+		return []int32{constant.GroupAdmin}, true
+	case constant.GroupFilterOrdinaryUsers:
+		return []int32{constant.GroupOrdinaryUsers}, true
+	case constant.GroupFilterAdminAndOrdinaryUsers:
+		return []int32{constant.GroupAdmin, constant.GroupOrdinaryUsers}, true
+	case constant.GroupFilterOwnerAndAdmin:
+		return []int32{constant.GroupOwner, constant.GroupAdmin}, true
+	default:
+		return nil, false
+	}
+}
+
+// byRoleAndJoinTime sorts members by role level, highest first, then by join time.
+func byRoleAndJoinTime(a, b *model_struct.LocalGroupMember) bool {
+	if a.RoleLevel != b.RoleLevel {
+		return a.RoleLevel > b.RoleLevel
+	}
+	return a.JoinTime < b.JoinTime
+}
+
+func (d *DataBase) GetGroupMemberInfoByGroupIDUserID(ctx context.Context, groupID, userID string) (*model_struct.LocalGroupMember, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if groupMember, ok := d.groupMembers.get(pair{groupID, userID}); ok {
+		return groupMember, nil
+	}
+	return &model_struct.LocalGroupMember{}, errs.WrapMsg(errRecordNotFound, "GetGroupMemberInfoByGroupIDUserID failed")
+}
+
+func (d *DataBase) GetGroupMemberCount(ctx context.Context, groupID string) (int32, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return int32(d.groupMembers.count(func(v *model_struct.LocalGroupMember) bool { return v.GroupID == groupID })), nil
+}
+
+func (d *DataBase) GetGroupSomeMemberInfo(ctx context.Context, groupID string, userIDList []string) ([]*model_struct.LocalGroupMember, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.groupMembers.find(func(v *model_struct.LocalGroupMember) bool { return v.GroupID == groupID && in(v.UserID, userIDList) }), nil
+}
+
+func (d *DataBase) GetGroupMemberListByGroupID(ctx context.Context, groupID string) ([]*model_struct.LocalGroupMember, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.groupMembers.find(func(v *model_struct.LocalGroupMember) bool { return v.GroupID == groupID }), nil
+}
+
+func (d *DataBase) GetGroupMemberListByUserIDs(ctx context.Context, groupID string, filter int32, userIDs []string) ([]*model_struct.LocalGroupMember, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	levels, ok := roleLevels(filter)
+	if !ok {
+		return nil, errs.New("filter args failed.", "filter", filter).Wrap()
+	}
+	return d.groupMembers.find(func(v *model_struct.LocalGroupMember) bool {
+		return v.GroupID == groupID && (levels == nil || in(v.RoleLevel, levels)) && in(v.UserID, userIDs)
+	}), nil
+}
+
+func (d *DataBase) GetGroupMemberListSplit(ctx context.Context, groupID string, filter int32, offset, count int) ([]*model_struct.LocalGroupMember, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	levels, ok := roleLevels(filter)
+	if !ok {
+		return nil, errs.New("filter args failed", "filter", filter).Wrap()
+	}
+	groupMemberList := d.groupMembers.find(func(v *model_struct.LocalGroupMember) bool {
+		return v.GroupID == groupID && (levels == nil || in(v.RoleLevel, levels))
+	})
+	// the owner filter has a single role and no order
+	if filter != constant.GroupFilterOwner {
+		groupMemberList = orderBy(groupMemberList, byRoleAndJoinTime)
+	}
+	return limit(groupMemberList, offset, count), nil
+}
+
+func (d *DataBase) GetGroupMemberOwnerAndAdminDB(ctx context.Context, groupID string) ([]*model_struct.LocalGroupMember, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	groupMemberList := d.groupMembers.find(func(v *model_struct.LocalGroupMember) bool {
+		return v.GroupID == groupID && (v.RoleLevel == constant.GroupOwner || v.RoleLevel == constant.GroupAdmin)
+	})
+	return orderBy(groupMemberList, func(a, b *model_struct.LocalGroupMember) bool { return a.JoinTime > b.JoinTime }), nil
+}
+
+func (d *DataBase) GetGroupMemberListSplitByJoinTimeFilter(ctx context.Context, groupID string, offset, count int, joinTimeBegin, joinTimeEnd int64, userIDList []string) ([]*model_struct.LocalGroupMember, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	groupMemberList := d.groupMembers.find(func(v *model_struct.LocalGroupMember) bool {
+		return v.GroupID == groupID && v.JoinTime >= joinTimeBegin && v.JoinTime <= joinTimeEnd && !in(v.UserID, userIDList)
+	})
+	groupMemberList = orderBy(groupMemberList, func(a, b *model_struct.LocalGroupMember) bool { return a.JoinTime > b.JoinTime })
+	return limit(groupMemberList, offset, count), nil
+}
+
+func (d *DataBase) InsertGroupMember(ctx context.Context, groupMember *model_struct.LocalGroupMember) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.groupMembers.insert(groupMember), "")
+}
+
+func (d *DataBase) BatchInsertGroupMember(ctx context.Context, groupMemberList []*model_struct.LocalGroupMember) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.groupMembers.insert(groupMemberList...), "BatchInsertGroupMember failed")
+}
+
+func (d *DataBase) DeleteGroupMember(ctx context.Context, groupID, userID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.groupMembers.deleteKey(pair{groupID, userID})
+	return nil
+}
+
+func (d *DataBase) DeleteGroupAllMembers(ctx context.Context, groupID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.groupMembers.delete(func(v *model_struct.LocalGroupMember) bool { return v.GroupID == groupID })
+	return nil
+}
+
+func (d *DataBase) UpdateGroupMember(ctx context.Context, groupMember *model_struct.LocalGroupMember) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.groupMembers.updateKey(pair{groupMember.GroupID, groupMember.UserID}, func(v *model_struct.LocalGroupMember) {
+		updateRow(v, groupMember, true)
+	}) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) SearchGroupMembersDB(ctx context.Context, keyword string, groupID string, isSearchMemberNickname, isSearchUserID bool, offset, count int) (result []*model_struct.LocalGroupMember, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if !isSearchMemberNickname && !isSearchUserID {
+		return nil, errors.New("args failed")
+	}
+	result = d.groupMembers.find(func(v *model_struct.LocalGroupMember) bool {
+		matched := (isSearchUserID && like(v.UserID, keyword)) || (isSearchMemberNickname && like(v.Nickname, keyword))
+		return matched && (groupID == "" || v.GroupID == groupID)
+	})
+	return limit(orderBy(result, byRoleAndJoinTime), offset, count), nil
+}
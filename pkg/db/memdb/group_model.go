@@ -0,0 +1,91 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) InsertGroup(ctx context.Context, groupInfo *model_struct.LocalGroup) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.groups.insert(groupInfo), "InsertGroup failed")
+}
+
+func (d *DataBase) DeleteGroup(ctx context.Context, groupID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.groups.deleteKey(groupID)
+	return nil
+}
+
+func (d *DataBase) UpdateGroup(ctx context.Context, groupInfo *model_struct.LocalGroup) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.groups.updateKey(groupInfo.GroupID, func(v *model_struct.LocalGroup) { updateRow(v, groupInfo, true) }) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) BatchInsertGroup(ctx context.Context, groupList []*model_struct.LocalGroup) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.groups.insert(groupList...), "BatchInsertGroup failed")
+}
+
+func (d *DataBase) DeleteAllGroup(ctx context.Context) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.groups.delete(nil)
+	return nil
+}
+
+func (d *DataBase) GetJoinedGroupListDB(ctx context.Context) ([]*model_struct.LocalGroup, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.groups.find(nil), nil
+}
+
+func (d *DataBase) GetGroups(ctx context.Context, groupIDs []string) ([]*model_struct.LocalGroup, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.groups.find(func(v *model_struct.LocalGroup) bool { return in(v.GroupID, groupIDs) }), nil
+}
+
+func (d *DataBase) GetGroupInfoByGroupID(ctx context.Context, groupID string) (*model_struct.LocalGroup, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if g, ok := d.groups.get(groupID); ok {
+		return g, nil
+	}
+	return &model_struct.LocalGroup{}, errs.WrapMsg(errRecordNotFound, "GetGroupList failed")
+}
+
+func (d *DataBase) GetAllGroupInfoByGroupIDOrGroupName(ctx context.Context, keyword string, isSearchGroupID bool, isSearchGroupName bool) ([]*model_struct.LocalGroup, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	groupList := d.groups.find(func(v *model_struct.LocalGroup) bool {
+		if !isSearchGroupID {
+			return like(v.GroupName, keyword)
+		}
+		return like(v.GroupID, keyword) || (isSearchGroupName && like(v.GroupName, keyword))
+	})
+	return orderBy(groupList, func(a, b *model_struct.LocalGroup) bool { return a.CreateTime > b.CreateTime }), nil
+}
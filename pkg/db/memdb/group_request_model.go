@@ -0,0 +1,83 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) InsertGroupRequest(ctx context.Context, groupRequest *model_struct.LocalGroupRequest) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.groupRequests.insert(groupRequest), "InsertGroupRequest failed")
+}
+
+func (d *DataBase) DeleteGroupRequest(ctx context.Context, groupID, userID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.groupRequests.deleteKey(pair{groupID, userID})
+	return nil
+}
+
+func (d *DataBase) UpdateGroupRequest(ctx context.Context, groupRequest *model_struct.LocalGroupRequest) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.groupRequests.updateKey(pair{groupRequest.GroupID, groupRequest.UserID}, func(v *model_struct.LocalGroupRequest) {
+		updateRow(v, groupRequest, true)
+	}) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) GetSendGroupApplication(ctx context.Context) ([]*model_struct.LocalGroupRequest, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return orderBy(d.groupRequests.find(nil), func(a, b *model_struct.LocalGroupRequest) bool { return a.CreateTime > b.CreateTime }), nil
+}
+
+func (d *DataBase) InsertAdminGroupRequest(ctx context.Context, groupRequest *model_struct.LocalAdminGroupRequest) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.adminGroupRequests.insert(groupRequest), "InsertAdminGroupRequest failed")
+}
+
+func (d *DataBase) DeleteAdminGroupRequest(ctx context.Context, groupID, userID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.adminGroupRequests.deleteKey(pair{groupID, userID})
+	return nil
+}
+
+func (d *DataBase) UpdateAdminGroupRequest(ctx context.Context, groupRequest *model_struct.LocalAdminGroupRequest) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.adminGroupRequests.updateKey(pair{groupRequest.GroupID, groupRequest.UserID}, func(v *model_struct.LocalAdminGroupRequest) {
+		updateRow(v, groupRequest, true)
+	}) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) GetAdminGroupApplication(ctx context.Context) ([]*model_struct.LocalAdminGroupRequest, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return orderBy(d.adminGroupRequests.find(nil), func(a, b *model_struct.LocalAdminGroupRequest) bool { return a.CreateTime > b.CreateTime }), nil
+}
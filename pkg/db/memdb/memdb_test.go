@@ -0,0 +1,21 @@
+//go:build !js
+
+package memdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/db_interface"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/dbtest"
+)
+
+func TestConformance(t *testing.T) {
+	dbtest.Run(t, func(t *testing.T, loginUserID string) db_interface.DataBase {
+		db, err := NewDataBase(context.Background(), loginUserID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return db
+	})
+}
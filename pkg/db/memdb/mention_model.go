@@ -0,0 +1,96 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) InsertMentions(ctx context.Context, mentions []*model_struct.LocalMention) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	for _, mention := range mentions {
+		if _, ok := d.mentions.get(pair{mention.ConversationID, mention.ClientMsgID}); ok {
+			continue
+		}
+		if err := d.mentions.insert(mention); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *DataBase) GetMention(ctx context.Context, conversationID, clientMsgID string) (*model_struct.LocalMention, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if mention, ok := d.mentions.get(pair{conversationID, clientMsgID}); ok {
+		return mention, nil
+	}
+	return nil, errs.ErrRecordNotFound.Wrap()
+}
+
+func (d *DataBase) GetMentions(ctx context.Context, startTime int64, startConversationID, startClientMsgID string, unreadOnly bool, count int) ([]*model_struct.LocalMention, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	start := &model_struct.LocalMention{ConversationID: startConversationID, ClientMsgID: startClientMsgID, SendTime: startTime}
+	rows := d.mentions.find(func(v *model_struct.LocalMention) bool {
+		return (startTime == 0 || mentionAfter(v, start)) && !(unreadOnly && v.IsRead)
+	})
+	return limit(orderBy(rows, func(a, b *model_struct.LocalMention) bool { return mentionAfter(b, a) }), 0, count), nil
+}
+
+// mentionAfter reports whether a comes after b in the inbox, which lists the latest mentions first.
+func mentionAfter(a, b *model_struct.LocalMention) bool {
+	if a.SendTime != b.SendTime {
+		return a.SendTime < b.SendTime
+	}
+	if a.ConversationID != b.ConversationID {
+		return a.ConversationID < b.ConversationID
+	}
+	return a.ClientMsgID < b.ClientMsgID
+}
+
+func (d *DataBase) MarkMentionsRead(ctx context.Context, conversationID string, clientMsgIDs []string) (int64, error) {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return d.mentions.update(func(v *model_struct.LocalMention) bool {
+		return v.ConversationID == conversationID && !v.IsRead && (len(clientMsgIDs) == 0 || in(v.ClientMsgID, clientMsgIDs))
+	}, func(v *model_struct.LocalMention) { v.IsRead = true }), nil
+}
+
+func (d *DataBase) GetUnreadMentionCount(ctx context.Context) (int32, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return int32(d.mentions.count(func(v *model_struct.LocalMention) bool { return !v.IsRead })), nil
+}
+
+func (d *DataBase) DeleteMentions(ctx context.Context, conversationID string, clientMsgIDs []string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.mentions.delete(func(v *model_struct.LocalMention) bool {
+		return v.ConversationID == conversationID && in(v.ClientMsgID, clientMsgIDs)
+	})
+	return nil
+}
+
+func (d *DataBase) DeleteConversationMentions(ctx context.Context, conversationID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.mentions.delete(func(v *model_struct.LocalMention) bool { return v.ConversationID == conversationID })
+	return nil
+}
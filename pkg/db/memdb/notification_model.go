@@ -0,0 +1,60 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) SetNotificationSeq(ctx context.Context, conversationID string, seq int64) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if d.notificationSeqs.updateKey(conversationID, func(v *model_struct.NotificationSeqs) { v.Seq = seq }) {
+		return nil
+	}
+	return errs.WrapMsg(d.notificationSeqs.insert(&model_struct.NotificationSeqs{ConversationID: conversationID, Seq: seq}), "Create failed")
+}
+
+func (d *DataBase) BatchInsertNotificationSeq(ctx context.Context, notificationSeqs []*model_struct.NotificationSeqs) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.notificationSeqs.insert(notificationSeqs...), "BatchInsertNotificationSeq failed")
+}
+
+func (d *DataBase) GetNotificationAllSeqs(ctx context.Context) ([]*model_struct.NotificationSeqs, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.notificationSeqs.find(nil), nil
+}
+
+func (d *DataBase) BatchInsertConversationUnreadMessageList(ctx context.Context, messageList []*model_struct.LocalConversationUnreadMessage) error {
+	if messageList == nil {
+		return nil
+	}
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.conversationUnreadMsgs.insert(messageList...), "BatchInsertConversationUnreadMessageList failed")
+}
+
+func (d *DataBase) DeleteConversationUnreadMessageList(ctx context.Context, conversationID string, sendTime int64) int64 {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return d.conversationUnreadMsgs.delete(func(v *model_struct.LocalConversationUnreadMessage) bool {
+		return v.ConversationID == conversationID && v.SendTime <= sendTime
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) InsertOutboxMessage(ctx context.Context, message *model_struct.LocalOutboxMessage) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.outboxMessages.insert(message), "InsertOutboxMessage failed")
+}
+
+func (d *DataBase) UpdateOutboxMessage(ctx context.Context, message *model_struct.LocalOutboxMessage) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.outboxMessages.updateKey(message.ClientMsgID, func(v *model_struct.LocalOutboxMessage) { updateRow(v, message, true) }) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) DeleteOutboxMessage(ctx context.Context, clientMsgID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.outboxMessages.deleteKey(clientMsgID)
+	return nil
+}
+
+func (d *DataBase) GetOutboxMessage(ctx context.Context, clientMsgID string) (*model_struct.LocalOutboxMessage, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if message, ok := d.outboxMessages.get(clientMsgID); ok {
+		return message, nil
+	}
+	return nil, errs.ErrRecordNotFound.Wrap()
+}
+
+// outboxMessagesByOrder returns the outbox messages matching where in the order they were queued.
+func (d *DataBase) outboxMessagesByOrder(where func(v *model_struct.LocalOutboxMessage) bool) []*model_struct.LocalOutboxMessage {
+	return orderBy(d.outboxMessages.find(where), func(a, b *model_struct.LocalOutboxMessage) bool { return a.OrderID < b.OrderID })
+}
+
+func (d *DataBase) GetAllOutboxMessages(ctx context.Context) (messages []*model_struct.LocalOutboxMessage, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.outboxMessagesByOrder(nil), nil
+}
+
+func (d *DataBase) GetConversationOutboxMessages(ctx context.Context, conversationID string) (messages []*model_struct.LocalOutboxMessage, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.outboxMessagesByOrder(func(v *model_struct.LocalOutboxMessage) bool { return v.ConversationID == conversationID }), nil
+}
@@ -0,0 +1,63 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) SetPinnedMessage(ctx context.Context, pinned *model_struct.LocalPinnedMessage) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if d.pinnedMessages.updateKey(pair{pinned.ConversationID, pinned.ClientMsgID}, func(v *model_struct.LocalPinnedMessage) { updateRow(v, pinned, true) }) {
+		return nil
+	}
+	return errs.WrapMsg(d.pinnedMessages.insert(pinned), "Create failed")
+}
+
+func (d *DataBase) GetPinnedMessage(ctx context.Context, conversationID, clientMsgID string) (*model_struct.LocalPinnedMessage, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if pinned, ok := d.pinnedMessages.get(pair{conversationID, clientMsgID}); ok {
+		return pinned, nil
+	}
+	return nil, errs.ErrRecordNotFound.Wrap()
+}
+
+func (d *DataBase) GetPinnedMessages(ctx context.Context, conversationID string) ([]*model_struct.LocalPinnedMessage, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return orderBy(d.pinnedMessages.find(func(v *model_struct.LocalPinnedMessage) bool { return v.ConversationID == conversationID && v.IsPinned }),
+		func(a, b *model_struct.LocalPinnedMessage) bool { return a.OperateTime > b.OperateTime }), nil
+}
+
+func (d *DataBase) DeletePinnedMessages(ctx context.Context, conversationID string, clientMsgIDs []string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.pinnedMessages.delete(func(v *model_struct.LocalPinnedMessage) bool {
+		return v.ConversationID == conversationID && in(v.ClientMsgID, clientMsgIDs)
+	})
+	return nil
+}
+
+func (d *DataBase) DeleteConversationPinnedMessages(ctx context.Context, conversationID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.pinnedMessages.delete(func(v *model_struct.LocalPinnedMessage) bool { return v.ConversationID == conversationID })
+	return nil
+}
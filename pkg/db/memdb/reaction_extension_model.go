@@ -0,0 +1,48 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) GetMessageReactionExtensions(ctx context.Context, clientMsgIDs []string) (result []*model_struct.LocalChatLogReactionExtensions, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	result = d.reactionExtensions.find(func(v *model_struct.LocalChatLogReactionExtensions) bool { return in(v.ClientMsgID, clientMsgIDs) })
+	for _, extension := range result {
+		extension.LocalReactionExtensions = bytes.Clone(extension.LocalReactionExtensions)
+	}
+	return result, nil
+}
+
+func (d *DataBase) SetMessageReactionExtension(ctx context.Context, extension *model_struct.LocalChatLogReactionExtensions) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	reactionExtensions := bytes.Clone(extension.LocalReactionExtensions)
+	if d.reactionExtensions.updateKey(extension.ClientMsgID, func(v *model_struct.LocalChatLogReactionExtensions) {
+		v.LocalReactionExtensions = reactionExtensions
+	}) {
+		return nil
+	}
+	return errs.WrapMsg(d.reactionExtensions.insert(&model_struct.LocalChatLogReactionExtensions{
+		ClientMsgID:             extension.ClientMsgID,
+		LocalReactionExtensions: reactionExtensions,
+	}), "Create failed")
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) InsertScheduledMessage(ctx context.Context, message *model_struct.LocalScheduledMessage) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.scheduledMessages.insert(message), "InsertScheduledMessage failed")
+}
+
+func (d *DataBase) UpdateScheduledMessage(ctx context.Context, message *model_struct.LocalScheduledMessage) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.scheduledMessages.updateKey(message.ClientMsgID, func(v *model_struct.LocalScheduledMessage) { updateRow(v, message, true) }) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) DeleteScheduledMessage(ctx context.Context, clientMsgID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.scheduledMessages.deleteKey(clientMsgID)
+	return nil
+}
+
+func (d *DataBase) GetScheduledMessage(ctx context.Context, clientMsgID string) (*model_struct.LocalScheduledMessage, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if message, ok := d.scheduledMessages.get(clientMsgID); ok {
+		return message, nil
+	}
+	return nil, errs.ErrRecordNotFound.Wrap()
+}
+
+// scheduledMessagesBySendAt returns the scheduled messages matching where, earliest first.
+func (d *DataBase) scheduledMessagesBySendAt(where func(v *model_struct.LocalScheduledMessage) bool) []*model_struct.LocalScheduledMessage {
+	return orderBy(d.scheduledMessages.find(where), func(a, b *model_struct.LocalScheduledMessage) bool { return a.SendAt < b.SendAt })
+}
+
+func (d *DataBase) GetAllScheduledMessages(ctx context.Context) (messages []*model_struct.LocalScheduledMessage, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.scheduledMessagesBySendAt(nil), nil
+}
+
+func (d *DataBase) GetDueScheduledMessages(ctx context.Context, sendAt int64) (messages []*model_struct.LocalScheduledMessage, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.scheduledMessagesBySendAt(func(v *model_struct.LocalScheduledMessage) bool { return v.SendAt <= sendAt }), nil
+}
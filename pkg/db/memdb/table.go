@@ -0,0 +1,197 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+	"gorm.io/gorm"
+)
+
+// table keeps rows in insertion order, the order SQLite returns them in when a query has no ORDER BY.
+type table[K comparable, V any] struct {
+	name string
+	key  func(*V) K
+	keys []K
+	rows map[K]*V
+}
+
+func newTable[K comparable, V any](name string, key func(*V) K) *table[K, V] {
+	return &table[K, V]{name: name, key: key, rows: make(map[K]*V)}
+}
+
+func (t *table[K, V]) len() int {
+	return len(t.keys)
+}
+
+// insert adds copies of values, failing without adding any of them if a primary key is taken.
+func (t *table[K, V]) insert(values ...*V) error {
+	if len(values) == 0 {
+		return gorm.ErrEmptySlice
+	}
+	seen := make(map[K]struct{}, len(values))
+	for _, v := range values {
+		k := t.key(v)
+		if _, ok := t.rows[k]; ok {
+			return errs.New("UNIQUE constraint failed", "table", t.name)
+		}
+		if _, ok := seen[k]; ok {
+			return errs.New("UNIQUE constraint failed", "table", t.name)
+		}
+		seen[k] = struct{}{}
+	}
+	for _, v := range values {
+		row := *v
+		k := t.key(&row)
+		t.keys = append(t.keys, k)
+		t.rows[k] = &row
+	}
+	return nil
+}
+
+func (t *table[K, V]) get(k K) (*V, bool) {
+	row, ok := t.rows[k]
+	if !ok {
+		return nil, false
+	}
+	v := *row
+	return &v, true
+}
+
+// take returns a copy of the first row matching where.
+func (t *table[K, V]) take(where func(*V) bool) (*V, bool) {
+	for _, k := range t.keys {
+		if row := t.rows[k]; where == nil || where(row) {
+			v := *row
+			return &v, true
+		}
+	}
+	return nil, false
+}
+
+// find returns copies of the rows matching where, a nil where matches every row.
+func (t *table[K, V]) find(where func(*V) bool) []*V {
+	var res []*V
+	for _, k := range t.keys {
+		if row := t.rows[k]; where == nil || where(row) {
+			v := *row
+			res = append(res, &v)
+		}
+	}
+	return res
+}
+
+func (t *table[K, V]) count(where func(*V) bool) int64 {
+	var n int64
+	for _, k := range t.keys {
+		if where == nil || where(t.rows[k]) {
+			n++
+		}
+	}
+	return n
+}
+
+// update applies set to the stored rows matching where and returns how many matched.
+func (t *table[K, V]) update(where func(*V) bool, set func(*V)) int64 {
+	var n int64
+	for _, k := range t.keys {
+		if row := t.rows[k]; where == nil || where(row) {
+			set(row)
+			n++
+		}
+	}
+	return n
+}
+
+// updateKey applies set to the stored row with key k and reports whether it exists.
+func (t *table[K, V]) updateKey(k K, set func(*V)) bool {
+	row, ok := t.rows[k]
+	if ok {
+		set(row)
+	}
+	return ok
+}
+
+// delete removes the rows matching where and returns how many were removed.
+func (t *table[K, V]) delete(where func(*V) bool) int64 {
+	keys := t.keys[:0]
+	var n int64
+	for _, k := range t.keys {
+		if where == nil || where(t.rows[k]) {
+			delete(t.rows, k)
+			n++
+			continue
+		}
+		keys = append(keys, k)
+	}
+	t.keys = keys
+	return n
+}
+
+func (t *table[K, V]) deleteKey(k K) int64 {
+	if _, ok := t.rows[k]; !ok {
+		return 0
+	}
+	return t.delete(func(v *V) bool { return t.key(v) == k })
+}
+
+// orderBy sorts rows stably, so rows that compare equal keep their insertion order.
+func orderBy[V any](rows []*V, less func(a, b *V) bool) []*V {
+	sort.SliceStable(rows, func(i, j int) bool { return less(rows[i], rows[j]) })
+	return rows
+}
+
+// limit pages rows the way OFFSET and LIMIT do, a negative count means no limit.
+func limit[V any](rows []*V, offset, count int) []*V {
+	if offset > 0 {
+		if offset >= len(rows) {
+			return nil
+		}
+		rows = rows[offset:]
+	}
+	if count >= 0 && count < len(rows) {
+		rows = rows[:count]
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return rows
+}
+
+// like matches the way SQLite's LIKE '%keyword%' does, ignoring ASCII case.
+func like(s, keyword string) bool {
+	return strings.Contains(asciiLower(s), asciiLower(keyword))
+}
+
+func asciiLower(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if 'A' <= c && c <= 'Z' {
+			b[i] = c + 'a' - 'A'
+		}
+	}
+	return string(b)
+}
+
+func in[T comparable](v T, list []T) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) GetUpload(ctx context.Context, partHash string) (*model_struct.LocalUpload, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if upload, ok := d.uploads.get(partHash); ok {
+		return upload, nil
+	}
+	return nil, errs.Wrap(errRecordNotFound)
+}
+
+func (d *DataBase) InsertUpload(ctx context.Context, upload *model_struct.LocalUpload) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.Wrap(d.uploads.insert(upload))
+}
+
+func (d *DataBase) UpdateUpload(ctx context.Context, upload *model_struct.LocalUpload) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.uploads.updateKey(upload.PartHash, func(v *model_struct.LocalUpload) { updateRow(v, upload, false) })
+	return nil
+}
+
+func (d *DataBase) DeleteUpload(ctx context.Context, partHash string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.uploads.deleteKey(partHash)
+	return nil
+}
+
+func (d *DataBase) DeleteExpireUpload(ctx context.Context) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	now := time.Now().UnixMilli()
+	d.uploads.delete(func(v *model_struct.LocalUpload) bool { return v.ExpireTime <= now })
+	return nil
+}
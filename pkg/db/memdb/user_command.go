@@ -0,0 +1,57 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+// ProcessUserCommandAdd adds a new user command to the database.
+func (d *DataBase) ProcessUserCommandAdd(ctx context.Context, command *model_struct.LocalUserCommand) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.userCommands.insert(command), "ProcessUserCommandAdd failed")
+}
+
+// ProcessUserCommandUpdate updates an existing user command in the database.
+func (d *DataBase) ProcessUserCommandUpdate(ctx context.Context, command *model_struct.LocalUserCommand) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.userCommands.updateKey(userCommandKey{command.UserID, command.Type, command.Uuid}, func(v *model_struct.LocalUserCommand) {
+		updateRow(v, command, true)
+	}) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+// ProcessUserCommandDelete deletes a user command from the database.
+func (d *DataBase) ProcessUserCommandDelete(ctx context.Context, command *model_struct.LocalUserCommand) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.userCommands.delete(func(v *model_struct.LocalUserCommand) bool { return v.Type == command.Type && v.Uuid == command.Uuid })
+	return nil
+}
+
+// ProcessUserCommandGetAll retrieves user commands from the database.
+func (d *DataBase) ProcessUserCommandGetAll(ctx context.Context) ([]*model_struct.LocalUserCommand, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return d.userCommands.find(nil), nil
+}
@@ -0,0 +1,60 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) GetLoginUser(ctx context.Context, userID string) (*model_struct.LocalUser, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	if user, ok := d.users.get(userID); ok {
+		return user, nil
+	}
+	return nil, errs.ErrRecordNotFound.Wrap()
+}
+
+func (d *DataBase) UpdateLoginUser(ctx context.Context, user *model_struct.LocalUser) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if !d.users.updateKey(user.UserID, func(v *model_struct.LocalUser) { updateRow(v, user, true) }) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return nil
+}
+
+func (d *DataBase) UpdateLoginUserByMap(ctx context.Context, user *model_struct.LocalUser, args map[string]interface{}) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if err := checkColumns[model_struct.LocalUser](args); err != nil {
+		return errs.WrapMsg(err, "UpdateColumnsConversation failed")
+	}
+	var err error
+	if !d.users.updateKey(user.UserID, func(v *model_struct.LocalUser) { err = updateColumns(v, args) }) {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return errs.WrapMsg(err, "UpdateColumnsConversation failed")
+}
+
+func (d *DataBase) InsertLoginUser(ctx context.Context, user *model_struct.LocalUser) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.users.insert(user), "InsertLoginUser failed")
+}
@@ -0,0 +1,52 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"slices"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) GetVersionSync(ctx context.Context, tableName, entityID string) (*model_struct.LocalVersionSync, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	res, ok := d.versionSyncs.get(pair{tableName, entityID})
+	if !ok {
+		return &model_struct.LocalVersionSync{}, errs.ErrRecordNotFound.Wrap()
+	}
+	res.UIDList = slices.Clone(res.UIDList)
+	return res, nil
+}
+
+func (d *DataBase) SetVersionSync(ctx context.Context, lv *model_struct.LocalVersionSync) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	version := *lv
+	version.UIDList = slices.Clone(lv.UIDList)
+	if d.versionSyncs.updateKey(pair{lv.Table, lv.EntityID}, func(v *model_struct.LocalVersionSync) { updateRow(v, &version, false) }) {
+		return nil
+	}
+	return errs.Wrap(d.versionSyncs.insert(&version))
+}
+
+func (d *DataBase) DeleteVersionSync(ctx context.Context, tableName, entityID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	d.versionSyncs.deleteKey(pair{tableName, entityID})
+	return nil
+}
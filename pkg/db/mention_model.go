@@ -0,0 +1,96 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) InsertMentions(ctx context.Context, mentions []*model_struct.LocalMention) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	if len(mentions) == 0 {
+		return nil
+	}
+	return errs.WrapMsg(d.conn.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(mentions).Error, "InsertMentions failed")
+}
+
+func (d *DataBase) GetMention(ctx context.Context, conversationID, clientMsgID string) (*model_struct.LocalMention, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	var mention model_struct.LocalMention
+	err := d.conn.WithContext(ctx).Where("conversation_id = ? AND client_msg_id = ?", conversationID, clientMsgID).Take(&mention).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errs.ErrRecordNotFound.Wrap()
+	}
+	return &mention, errs.WrapMsg(err, "GetMention failed")
+}
+
+func (d *DataBase) GetMentions(ctx context.Context, startTime int64, startConversationID, startClientMsgID string, unreadOnly bool, count int) (result []*model_struct.LocalMention, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	db := d.conn.WithContext(ctx)
+	if startTime > 0 {
+		db = db.Where("send_time < ? OR (send_time = ? AND (conversation_id < ? OR (conversation_id = ? AND client_msg_id < ?)))",
+			startTime, startTime, startConversationID, startConversationID, startClientMsgID)
+	}
+	if unreadOnly {
+		db = db.Where("is_read = ?", false)
+	}
+	return result, errs.WrapMsg(db.Order("send_time DESC, conversation_id DESC, client_msg_id DESC").Limit(count).Find(&result).Error, "GetMentions failed")
+}
+
+func (d *DataBase) MarkMentionsRead(ctx context.Context, conversationID string, clientMsgIDs []string) (int64, error) {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	db := d.conn.WithContext(ctx).Model(&model_struct.LocalMention{}).Where("conversation_id = ? AND is_read = ?", conversationID, false)
+	if len(clientMsgIDs) > 0 {
+		db = db.Where("client_msg_id IN ?", clientMsgIDs)
+	}
+	t := db.Update("is_read", true)
+	return t.RowsAffected, errs.WrapMsg(t.Error, "MarkMentionsRead failed")
+}
+
+func (d *DataBase) GetUnreadMentionCount(ctx context.Context) (int32, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	var count int64
+	err := d.conn.WithContext(ctx).Model(&model_struct.LocalMention{}).Where("is_read = ?", false).Count(&count).Error
+	return int32(count), errs.WrapMsg(err, "GetUnreadMentionCount failed")
+}
+
+func (d *DataBase) DeleteMentions(ctx context.Context, conversationID string, clientMsgIDs []string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.conn.WithContext(ctx).Where("conversation_id = ? AND client_msg_id IN ?", conversationID, clientMsgIDs).
+		Delete(&model_struct.LocalMention{}).Error, "DeleteMentions failed")
+}
+
+func (d *DataBase) DeleteConversationMentions(ctx context.Context, conversationID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.conn.WithContext(ctx).Where("conversation_id = ?", conversationID).
+		Delete(&model_struct.LocalMention{}).Error, "DeleteConversationMentions failed")
+}
@@ -0,0 +1,244 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// The local schema is evolved by numbered migrations. A migration runs in a transaction together
+// with its record in the migration table, so it is applied exactly once and one that fails is
+// retried on the next open. A database recording a migration this SDK doesn't know was written by
+// a newer SDK and is refused, rather than read or written with a schema it doesn't match.
+const migrationTable = "local_migrations"
+
+type migration struct {
+	version int
+	name    string
+	up      func(tx *gorm.DB) error
+	// down reverts up, nil when the migration is not reversible.
+	down func(tx *gorm.DB) error
+}
+
+// migrations are ordered by version. A released migration must never change, the schema is
+// evolved by appending a new one with the next version.
+var migrations = []migration{
+	{
+		version: 1,
+		name:    "base tables",
+		up:      createBaseTables,
+	},
+	{
+		version: 2,
+		name:    "scheduled and outbox messages",
+		up: func(tx *gorm.DB) error {
+			return createTables(tx, sendQueueTables)
+		},
+		down: func(tx *gorm.DB) error {
+			return dropTables(tx, sendQueueTables)
+		},
+	},
+	{
+		version: 3,
+		name:    "pinned messages",
+		up: func(tx *gorm.DB) error {
+			return createTables(tx, pinnedMessageTables)
+		},
+		down: func(tx *gorm.DB) error {
+			return dropTables(tx, pinnedMessageTables)
+		},
+	},
+	{
+		version: 4,
+		name:    "message thread roots",
+		up:      addThreadRoots,
+		down:    dropThreadRoots,
+	},
+	{
+		version: 5,
+		name:    "mentions",
+		up: func(tx *gorm.DB) error {
+			return createTables(tx, mentionTables)
+		},
+		down: func(tx *gorm.DB) error {
+			return dropTables(tx, mentionTables)
+		},
+	},
+}
+
+// sendQueueTables are created by migration 2.
+var sendQueueTables = []baseTable{
+	{
+		name: "local_scheduled_messages",
+		columns: []string{
+			"`client_msg_id` char(64)",
+			"`recv_id` char(64)",
+			"`group_id` char(64)",
+			"`send_at` integer",
+			"`message` text",
+			"`offline_push_info` varchar(1024)",
+			"`attempts` integer",
+			"`create_time` integer",
+			"`ex` varchar(1024)",
+		},
+		primaryKey: "`client_msg_id`",
+		indexes: []string{
+			"CREATE INDEX IF NOT EXISTS `index_send_at` ON `local_scheduled_messages`(`send_at`)",
+		},
+	},
+	{
+		name: "local_outbox_messages",
+		columns: []string{
+			"`client_msg_id` char(64)",
+			"`conversation_id` char(128)",
+			"`recv_id` char(64)",
+			"`group_id` char(64)",
+			"`order_id` integer",
+			"`message` text",
+			"`offline_push_info` varchar(1024)",
+			"`attempts` integer",
+			"`next_attempt_time` integer",
+			"`create_time` integer",
+			"`ex` varchar(1024)",
+		},
+		primaryKey: "`client_msg_id`",
+		indexes: []string{
+			"CREATE INDEX IF NOT EXISTS `index_outbox_order_id` ON `local_outbox_messages`(`order_id`)",
+			"CREATE INDEX IF NOT EXISTS `index_outbox_conversation_id` ON `local_outbox_messages`(`conversation_id`)",
+		},
+	},
+}
+
+// pinnedMessageTables are created by migration 3.
+var pinnedMessageTables = []baseTable{
+	{
+		name: "local_pinned_messages",
+		columns: []string{
+			"`conversation_id` char(128)",
+			"`client_msg_id` char(64)",
+			"`seq` integer",
+			"`is_pinned` numeric",
+			"`operator_user_id` char(64)",
+			"`operate_time` integer",
+		},
+		primaryKey: "`conversation_id`,`client_msg_id`",
+	},
+}
+
+// mentionTables are created by migration 5.
+var mentionTables = []baseTable{
+	{
+		name: "local_mentions",
+		columns: []string{
+			"`conversation_id` char(128)",
+			"`client_msg_id` char(64)",
+			"`mention_type` integer",
+			"`send_id` char(64)",
+			"`send_time` integer",
+			"`is_read` numeric",
+		},
+		primaryKey: "`conversation_id`,`client_msg_id`",
+		indexes: []string{
+			"CREATE INDEX IF NOT EXISTS `index_mention_send_time` ON `local_mentions`(`send_time`)",
+		},
+	},
+}
+
+type localMigration struct {
+	Version     int    `gorm:"column:version;primary_key"`
+	Name        string `gorm:"column:name;type:varchar(255)"`
+	AppliedTime int64  `gorm:"column:applied_time"`
+}
+
+func (localMigration) TableName() string {
+	return migrationTable
+}
+
+// migrate applies the migrations the database is missing, in order.
+func (d *DataBase) migrate(ctx context.Context) error {
+	if err := d.conn.WithContext(ctx).AutoMigrate(&localMigration{}); err != nil {
+		return errs.WrapMsg(err, "create migration table failed")
+	}
+	applied, err := d.migrationVersion(ctx)
+	if err != nil {
+		return err
+	}
+	latest := migrations[len(migrations)-1].version
+	if applied > latest {
+		return sdkerrs.ErrDBVersion.WrapMsg("database was written by a newer sdk", "migration", applied, "latest", latest)
+	}
+	for _, m := range migrations {
+		if m.version <= applied {
+			continue
+		}
+		log.ZInfo(ctx, "migrate database", "version", m.version, "name", m.name)
+		err := d.conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := m.up(tx); err != nil {
+				return err
+			}
+			return tx.Create(&localMigration{Version: m.version, Name: m.name, AppliedTime: time.Now().UnixMilli()}).Error
+		})
+		if err != nil {
+			return errs.WrapMsg(err, "migrate database failed", "version", m.version, "name", m.name)
+		}
+	}
+	return nil
+}
+
+// rollback reverts the migrations applied after version, newest first.
+func (d *DataBase) rollback(ctx context.Context, version int) error {
+	applied, err := d.migrationVersion(ctx)
+	if err != nil {
+		return err
+	}
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if m.version <= version || m.version > applied {
+			continue
+		}
+		if m.down == nil {
+			return errs.New("migration is not reversible", "version", m.version, "name", m.name).Wrap()
+		}
+		log.ZInfo(ctx, "rollback database", "version", m.version, "name", m.name)
+		err := d.conn.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := m.down(tx); err != nil {
+				return err
+			}
+			return tx.Delete(&localMigration{Version: m.version}).Error
+		})
+		if err != nil {
+			return errs.WrapMsg(err, "rollback database failed", "version", m.version, "name", m.name)
+		}
+	}
+	return nil
+}
+
+// migrationVersion returns the version of the last applied migration, 0 for none.
+func (d *DataBase) migrationVersion(ctx context.Context) (int, error) {
+	var version int
+	err := d.conn.WithContext(ctx).Model(&localMigration{}).Select("COALESCE(MAX(version), 0)").Scan(&version).Error
+	return version, errs.WrapMsg(err, "get migration version failed")
+}
@@ -0,0 +1,409 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// baseTable is a table as the models defined it when the migration creating it was introduced.
+// It is spelled out rather than derived from the models, so that a migration creates the same
+// tables however the models change later.
+type baseTable struct {
+	name       string
+	columns    []string
+	primaryKey string
+	indexes    []string
+}
+
+func (t baseTable) createSQL() string {
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS `%s` (%s,PRIMARY KEY (%s))", t.name, strings.Join(t.columns, ","), t.primaryKey)
+}
+
+var baseTables = []baseTable{
+	{
+		name: "local_app_sdk_version",
+		columns: []string{
+			"`version` varchar(255)",
+			"`installed` numeric",
+		},
+		primaryKey: "`version`",
+	},
+	{
+		name: "local_friends",
+		columns: []string{
+			"`owner_user_id` varchar(64)",
+			"`friend_user_id` varchar(64)",
+			"`remark` varchar(255)",
+			"`create_time` integer",
+			"`add_source` integer",
+			"`operator_user_id` varchar(64)",
+			"`name` varchar(255)",
+			"`face_url` varchar(255)",
+			"`ex` varchar(1024)",
+			"`attached_info` varchar(1024)",
+			"`is_pinned` numeric",
+		},
+		primaryKey: "`owner_user_id`,`friend_user_id`",
+	},
+	{
+		name: "local_friend_requests",
+		columns: []string{
+			"`from_user_id` varchar(64)",
+			"`from_nickname` varchar(255)",
+			"`from_face_url` varchar(255)",
+			"`to_user_id` varchar(64)",
+			"`to_nickname` varchar(255)",
+			"`to_face_url` varchar(255)",
+			"`handle_result` integer",
+			"`req_msg` varchar(255)",
+			"`create_time` integer",
+			"`handler_user_id` varchar(64)",
+			"`handle_msg` varchar(255)",
+			"`handle_time` integer",
+			"`ex` varchar(1024)",
+			"`attached_info` varchar(1024)",
+		},
+		primaryKey: "`from_user_id`,`to_user_id`",
+	},
+	{
+		name: "local_groups",
+		columns: []string{
+			"`group_id` varchar(64)",
+			"`name` text",
+			"`notification` varchar(255)",
+			"`introduction` varchar(255)",
+			"`face_url` varchar(255)",
+			"`create_time` integer",
+			"`status` integer",
+			"`creator_user_id` varchar(64)",
+			"`group_type` integer",
+			"`owner_user_id` varchar(64)",
+			"`member_count` integer",
+			"`ex` varchar(1024)",
+			"`attached_info` varchar(1024)",
+			"`need_verification` integer",
+			"`look_member_info` integer",
+			"`apply_member_friend` integer",
+			"`notification_update_time` integer",
+			"`notification_user_id` text",
+		},
+		primaryKey: "`group_id`",
+	},
+	{
+		name: "local_group_members",
+		columns: []string{
+			"`group_id` varchar(64)",
+			"`user_id` varchar(64)",
+			"`nickname` varchar(255)",
+			"`user_group_face_url` varchar(255)",
+			"`role_level` integer",
+			"`join_time` integer",
+			"`join_source` integer",
+			"`inviter_user_id` text",
+			"`mute_end_time` integer DEFAULT 0",
+			"`operator_user_id` varchar(64)",
+			"`ex` varchar(1024)",
+			"`attached_info` varchar(1024)",
+		},
+		primaryKey: "`group_id`,`user_id`",
+		indexes: []string{
+			"CREATE INDEX IF NOT EXISTS `index_join_time` ON `local_group_members`(`join_time`)",
+			"CREATE INDEX IF NOT EXISTS `index_role_level` ON `local_group_members`(`role_level`)",
+		},
+	},
+	{
+		name: "local_group_requests",
+		columns: []string{
+			"`group_id` varchar(64)",
+			"`group_name` text",
+			"`notification` varchar(255)",
+			"`introduction` varchar(255)",
+			"`face_url` varchar(255)",
+			"`create_time` integer",
+			"`status` integer",
+			"`creator_user_id` varchar(64)",
+			"`group_type` integer",
+			"`owner_user_id` varchar(64)",
+			"`member_count` integer",
+			"`user_id` varchar(64)",
+			"`nickname` varchar(255)",
+			"`user_face_url` varchar(255)",
+			"`handle_result` integer",
+			"`req_msg` varchar(255)",
+			"`handle_msg` varchar(255)",
+			"`req_time` integer",
+			"`handle_user_id` varchar(64)",
+			"`handle_time` integer",
+			"`ex` varchar(1024)",
+			"`attached_info` varchar(1024)",
+			"`join_source` integer",
+			"`inviter_user_id` text",
+		},
+		primaryKey: "`group_id`,`user_id`",
+	},
+	{
+		name: "local_users",
+		columns: []string{
+			"`user_id` varchar(64)",
+			"`name` varchar(255)",
+			"`face_url` varchar(255)",
+			"`create_time` integer",
+			"`app_manger_level` integer",
+			"`ex` varchar(1024)",
+			"`attached_info` varchar(1024)",
+			"`global_recv_msg_opt` integer",
+		},
+		primaryKey: "`user_id`",
+	},
+	{
+		name: "local_blacks",
+		columns: []string{
+			"`owner_user_id` varchar(64)",
+			"`block_user_id` varchar(64)",
+			"`nickname` varchar(255)",
+			"`face_url` varchar(255)",
+			"`create_time` integer",
+			"`add_source` integer",
+			"`operator_user_id` varchar(64)",
+			"`ex` varchar(1024)",
+			"`attached_info` varchar(1024)",
+		},
+		primaryKey: "`owner_user_id`,`block_user_id`",
+	},
+	{
+		name: "local_conversations",
+		columns: []string{
+			"`conversation_id` char(128)",
+			"`conversation_type` integer",
+			"`user_id` char(64)",
+			"`group_id` char(128)",
+			"`show_name` varchar(255)",
+			"`face_url` varchar(255)",
+			"`recv_msg_opt` integer",
+			"`unread_count` integer",
+			"`group_at_type` integer",
+			"`latest_msg` varchar(1000)",
+			"`latest_msg_send_time` integer",
+			"`draft_text` text",
+			"`draft_text_time` integer",
+			"`is_pinned` numeric",
+			"`is_private_chat` numeric",
+			"`burn_duration` integer DEFAULT 30",
+			"`is_not_in_group` numeric",
+			"`update_unread_count_time` integer",
+			"`attached_info` varchar(1024)",
+			"`ex` varchar(1024)",
+			"`max_seq` integer",
+			"`min_seq` integer",
+			"`msg_destruct_time` integer DEFAULT 604800",
+			"`is_msg_destruct` numeric DEFAULT false",
+		},
+		primaryKey: "`conversation_id`",
+		indexes: []string{
+			"CREATE INDEX IF NOT EXISTS `index_latest_msg_send_time` ON `local_conversations`(`latest_msg_send_time`)",
+		},
+	},
+	{
+		name: "local_notification_seqs",
+		columns: []string{
+			"`conversation_id` char(128)",
+			"`seq` integer",
+		},
+		primaryKey: "`conversation_id`",
+	},
+	{
+		name: "local_chat_logs",
+		columns: []string{
+			"`client_msg_id` char(64)",
+			"`server_msg_id` char(64)",
+			"`send_id` char(64)",
+			"`recv_id` char(64)",
+			"`sender_platform_id` integer",
+			"`sender_nick_name` varchar(255)",
+			"`sender_face_url` varchar(255)",
+			"`session_type` integer",
+			"`msg_from` integer",
+			"`content_type` integer",
+			"`content` varchar(1000)",
+			"`is_read` numeric",
+			"`status` integer",
+			"`seq` integer DEFAULT 0",
+			"`send_time` integer",
+			"`create_time` integer",
+			"`attached_info` varchar(1024)",
+			"`ex` varchar(1024)",
+			"`local_ex` varchar(1024)",
+		},
+		primaryKey: "`client_msg_id`",
+		indexes: []string{
+			"CREATE INDEX IF NOT EXISTS `index_recv_id` ON `local_chat_logs`(`recv_id`)",
+			"CREATE INDEX IF NOT EXISTS `index_send_time` ON `local_chat_logs`(`send_time`)",
+			"CREATE INDEX IF NOT EXISTS `index_seq` ON `local_chat_logs`(`seq`)",
+			"CREATE INDEX IF NOT EXISTS `content_type_alone` ON `local_chat_logs`(`content_type`)",
+		},
+	},
+	{
+		name: "local_admin_group_requests",
+		columns: []string{
+			"`group_id` varchar(64)",
+			"`group_name` text",
+			"`notification` varchar(255)",
+			"`introduction` varchar(255)",
+			"`face_url` varchar(255)",
+			"`create_time` integer",
+			"`status` integer",
+			"`creator_user_id` varchar(64)",
+			"`group_type` integer",
+			"`owner_user_id` varchar(64)",
+			"`member_count` integer",
+			"`user_id` varchar(64)",
+			"`nickname` varchar(255)",
+			"`user_face_url` varchar(255)",
+			"`handle_result` integer",
+			"`req_msg` varchar(255)",
+			"`handle_msg` varchar(255)",
+			"`req_time` integer",
+			"`handle_user_id` varchar(64)",
+			"`handle_time` integer",
+			"`ex` varchar(1024)",
+			"`attached_info` varchar(1024)",
+			"`join_source` integer",
+			"`inviter_user_id` text",
+		},
+		primaryKey: "`group_id`,`user_id`",
+	},
+	{
+		name: "local_chat_log_reaction_extensions",
+		columns: []string{
+			"`client_msg_id` char(64)",
+			"`local_reaction_extensions` blob",
+		},
+		primaryKey: "`client_msg_id`",
+	},
+	{
+		name: "local_uploads",
+		columns: []string{
+			"`part_hash` text",
+			"`upload_id` varchar(1000)",
+			"`upload_info` varchar(2000)",
+			"`expire_time` integer",
+			"`create_time` integer",
+		},
+		primaryKey: "`part_hash`",
+	},
+	{
+		name: "local_stranger",
+		columns: []string{
+			"`user_id` varchar(64)",
+			"`name` varchar(255)",
+			"`face_url` varchar(255)",
+			"`create_time` integer",
+			"`app_manger_level` integer",
+			"`ex` varchar(1024)",
+			"`attached_info` varchar(1024)",
+			"`global_recv_msg_opt` integer",
+		},
+		primaryKey: "`user_id`",
+	},
+	{
+		name: "local_sending_messages",
+		columns: []string{
+			"`conversation_id` char(128)",
+			"`client_msg_id` char(64)",
+			"`ex` varchar(1024)",
+		},
+		primaryKey: "`conversation_id`,`client_msg_id`",
+	},
+	{
+		name: "local_user_command",
+		columns: []string{
+			"`user_id` char(128)",
+			"`type` integer",
+			"`uuid` varchar(255)",
+			"`create_time` integer",
+			"`value` varchar(255)",
+			"`ex` varchar(1024)",
+		},
+		primaryKey: "`user_id`,`type`,`uuid`",
+	},
+	{
+		name: "local_sync_version",
+		columns: []string{
+			"`table_name` varchar(255)",
+			"`entity_id` varchar(255)",
+			"`version_id` text",
+			"`version` integer",
+			"`create_time` integer",
+			"`id_list` text",
+		},
+		primaryKey: "`table_name`,`entity_id`",
+	},
+}
+
+// createBaseTables creates the base tables. A database written before the migrations already has
+// them, possibly without the columns added since, the missing columns are added to it.
+func createBaseTables(tx *gorm.DB) error {
+	return createTables(tx, baseTables)
+}
+
+// createTables creates the tables, adding the columns missing from those that already exist.
+func createTables(tx *gorm.DB, tables []baseTable) error {
+	for _, table := range tables {
+		if tx.Migrator().HasTable(table.name) {
+			if err := addMissingColumns(tx, table); err != nil {
+				return err
+			}
+		} else if err := tx.Exec(table.createSQL()).Error; err != nil {
+			return errs.WrapMsg(err, "create table failed", "table", table.name)
+		}
+		for _, index := range table.indexes {
+			if err := tx.Exec(index).Error; err != nil {
+				return errs.WrapMsg(err, "create index failed", "table", table.name)
+			}
+		}
+	}
+	return nil
+}
+
+func dropTables(tx *gorm.DB, tables []baseTable) error {
+	for _, table := range tables {
+		if err := tx.Exec(fmt.Sprintf("DROP TABLE IF EXISTS `%s`", table.name)).Error; err != nil {
+			return errs.WrapMsg(err, "drop table failed", "table", table.name)
+		}
+	}
+	return nil
+}
+
+func addMissingColumns(tx *gorm.DB, table baseTable) error {
+	for _, column := range table.columns {
+		name := strings.Trim(strings.Fields(column)[0], "`")
+		if tx.Migrator().HasColumn(table.name, name) {
+			continue
+		}
+		if err := tx.Exec(fmt.Sprintf("ALTER TABLE `%s` ADD COLUMN %s", table.name, column)).Error; err != nil {
+			return errs.WrapMsg(err, "add column failed", "table", table.name, "column", name)
+		}
+	}
+	return nil
+}
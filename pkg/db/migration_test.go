@@ -0,0 +1,281 @@
+//go:build !js
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdkerrs"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/version"
+)
+
+// restoreFixture writes the database dumped in testdata/name into dir as the database of
+// userID, as the SDK that wrote it left it.
+func restoreFixture(t *testing.T, name, dir, userID string) {
+	script, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB := openKeyDB(dbFile(dir, userID), "")
+	defer sqlDB.Close()
+	if _, err := sqlDB.Exec(string(script)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func latestMigration() int {
+	return migrations[len(migrations)-1].version
+}
+
+func appliedMigrations(t *testing.T, db *DataBase) []int {
+	var versions []int
+	if err := db.conn.Model(&localMigration{}).Order("version").Pluck("version", &versions).Error; err != nil {
+		t.Fatal(err)
+	}
+	return versions
+}
+
+func TestMigrateFixture(t *testing.T) {
+	for _, fixture := range []string{"OpenIM_v3.5.1.sql", "OpenIM_v3.8.0.sql"} {
+		t.Run(fixture, func(t *testing.T) {
+			testMigrateFixture(t, fixture)
+		})
+	}
+}
+
+func testMigrateFixture(t *testing.T, fixture string) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	restoreFixture(t, fixture, dir, "fixture_user")
+	db, err := NewDataBase(ctx, "fixture_user", dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if versions := appliedMigrations(t, db); len(versions) != len(migrations) || versions[len(versions)-1] != latestMigration() {
+		t.Fatalf("applied migrations %v", versions)
+	}
+	if err := db.InsertOutboxMessage(ctx, &model_struct.LocalOutboxMessage{ClientMsgID: "outbox", ConversationID: "si_fixture_user_friend"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the data written by the old sdk is kept
+	messages, err := db.GetMessageList(ctx, "si_fixture_user_friend", 10, 0, 0, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 2 || messages[0].ClientMsgID != "msg2" || messages[1].ClientMsgID != "msg1" {
+		t.Fatalf("messages after migration %v", messages)
+	}
+	friends, err := db.GetAllFriendList(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(friends) != 1 || friends[0].FriendUserID != "friend" {
+		t.Fatalf("friends after migration %v", friends)
+	}
+	if !db.conn.Migrator().HasColumn(&model_struct.LocalFriend{}, "is_pinned") || !db.conn.Migrator().HasColumn(&model_struct.LocalConversation{}, "is_msg_destruct") {
+		t.Fatal("columns missing from the old tables were not added")
+	}
+	if err := db.SetVersionSync(ctx, &model_struct.LocalVersionSync{Table: "local_friends", EntityID: "fixture_user", Version: 1}); err != nil {
+		t.Fatal(err)
+	}
+	appVersion, err := db.GetAppSDKVersion(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if appVersion.Version != version.Version || !appVersion.Installed {
+		t.Fatalf("app sdk version after migration %+v", appVersion)
+	}
+	if err := db.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// opening a migrated database applies nothing
+	db, err = NewDataBase(ctx, "fixture_user", dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close(ctx)
+	if versions := appliedMigrations(t, db); len(versions) != len(migrations) {
+		t.Fatalf("applied migrations after reopen %v", versions)
+	}
+	if _, err := db.GetOutboxMessage(ctx, "outbox"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigrationTablesFrozen(t *testing.T) {
+	sqlDB := openKeyDB(filepath.Join(t.TempDir(), "base.db"), "")
+	defer sqlDB.Close()
+	conn, err := gorm.Open(&sqlite.Dialector{Conn: sqlDB}, &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tables []baseTable
+	for _, frozen := range [][]baseTable{baseTables, sendQueueTables, pinnedMessageTables, mentionTables} {
+		tables = append(tables, frozen...)
+	}
+	create := func(tx *gorm.DB) error { return createTables(tx, tables) }
+	if err := conn.Transaction(create); err != nil {
+		t.Fatal(err)
+	}
+	for _, table := range tables {
+		var sql string
+		if err := conn.Raw("SELECT sql FROM sqlite_master WHERE type = 'table' AND name = ?", table.name).Scan(&sql).Error; err != nil {
+			t.Fatal(err)
+		}
+		if want := strings.Replace(table.createSQL(), "CREATE TABLE IF NOT EXISTS", "CREATE TABLE", 1); sql != want {
+			t.Fatalf("table %s created as\n%s\nwant\n%s", table.name, sql, want)
+		}
+	}
+	// columns added to the models later belong to their own migrations
+	if conn.Migrator().HasColumn(modelChatLogTable, "thread_root_id") {
+		t.Fatal("base tables follow the current chat log model")
+	}
+	// running it again on the tables it created changes nothing
+	if err := conn.Transaction(create); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMigrateNewerDatabase(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewDataBase(ctx, "newerUser", dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.conn.Create(&localMigration{Version: latestMigration() + 1, Name: "future"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewDataBase(ctx, "newerUser", dir, 0); !sdkerrs.ErrDBVersion.Is(err) {
+		t.Fatalf("open newer database: %v, want ErrDBVersion", err)
+	}
+}
+
+func TestMigrateFailure(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewDataBase(ctx, "failUser", dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	released := migrations
+	t.Cleanup(func() { migrations = released })
+	migrations = append(migrations[:len(released):len(released)], migration{
+		version: latestMigration() + 1,
+		name:    "failing",
+		up: func(tx *gorm.DB) error {
+			if err := tx.Exec("CREATE TABLE local_half_done (id INTEGER)").Error; err != nil {
+				return err
+			}
+			return errors.New("step failed")
+		},
+	})
+	if _, err := NewDataBase(ctx, "failUser", dir, 0); err == nil {
+		t.Fatal("failing migration applied")
+	}
+
+	// the failed step left nothing behind and runs again on the next open
+	migrations = released
+	db, err = NewDataBase(ctx, "failUser", dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close(ctx)
+	if db.conn.Migrator().HasTable("local_half_done") {
+		t.Fatal("failed migration was not rolled back")
+	}
+	if versions := appliedMigrations(t, db); versions[len(versions)-1] != latestMigration() {
+		t.Fatalf("applied migrations %v", versions)
+	}
+}
+
+func TestMigrateRollback(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewDataBase(ctx, "rollbackUser", dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.rollback(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if db.conn.Migrator().HasTable(&model_struct.LocalOutboxMessage{}) {
+		t.Fatal("outbox table kept by rollback")
+	}
+	if versions := appliedMigrations(t, db); fmt.Sprint(versions) != "[1]" {
+		t.Fatalf("applied migrations after rollback %v", versions)
+	}
+	if err := db.rollback(ctx, 0); err == nil {
+		t.Fatal("irreversible migration rolled back")
+	}
+	if err := db.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err = NewDataBase(ctx, "rollbackUser", dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close(ctx)
+	if !db.conn.Migrator().HasTable(&model_struct.LocalOutboxMessage{}) {
+		t.Fatal("outbox table not migrated again")
+	}
+}
+
+func TestMigrateThreadRoots(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewDataBase(ctx, "threadUser", dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close(ctx)
+	quote := utils.StructToJsonString(sdk_struct.QuoteElem{Text: "reply", QuoteMessage: &sdk_struct.MsgStruct{ClientMsgID: "root"}})
+	if err := db.BatchInsertMessageList(ctx, "si_threadUser_friend", []*model_struct.LocalChatLog{
+		{ClientMsgID: "root", ContentType: constant.Text, Content: `{"content":"root"}`, Status: constant.MsgStatusSendSuccess, SendTime: 1},
+		{ClientMsgID: "reply", ContentType: constant.Quote, Content: quote, Status: constant.MsgStatusSendSuccess, SendTime: 2},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	table := utils.GetTableName("si_threadUser_friend")
+	if err := db.rollback(ctx, 3); err != nil {
+		t.Fatal(err)
+	}
+	if db.conn.Migrator().HasColumn(table, "thread_root_id") {
+		t.Fatal("thread_root_id kept by rollback")
+	}
+
+	// quotes written before threads join the thread of the quoted message
+	if err := db.migrate(ctx); err != nil {
+		t.Fatal(err)
+	}
+	replies, err := db.GetThreadMessages(ctx, "si_threadUser_friend", "root", 0, "", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(replies) != 1 || replies[0].ClientMsgID != "reply" {
+		t.Fatalf("thread after migration %v", replies)
+	}
+}
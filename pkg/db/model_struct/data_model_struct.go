@@ -189,6 +189,14 @@ type LocalChatLog struct {
 	AttachedInfo     string `gorm:"column:attached_info;type:varchar(1024)" json:"attachedInfo"`
 	Ex               string `gorm:"column:ex;type:varchar(1024)" json:"ex"`
 	LocalEx          string `gorm:"column:local_ex;type:varchar(1024)" json:"localEx"`
+	ThreadRootID     string `gorm:"column:thread_root_id;type:char(64);default:''" json:"threadRootID"`
+}
+
+// LocalThreadSummary is the reply count and latest reply of a thread root.
+type LocalThreadSummary struct {
+	RootClientMsgID string        `json:"rootClientMsgID"`
+	ReplyCount      int64         `json:"replyCount"`
+	LatestReply     *LocalChatLog `json:"latestReply"`
 }
 
 type LocalConversation struct {
@@ -284,6 +292,74 @@ func (LocalSendingMessages) TableName() string {
 	return "local_sending_messages"
 }
 
+// LocalScheduledMessage is a message queued to be sent at SendAt. Message is the json MsgStruct,
+// Attempts counts the sends that failed on the network and are retried.
+type LocalScheduledMessage struct {
+	ClientMsgID     string `gorm:"column:client_msg_id;primary_key;type:char(64)" json:"clientMsgID"`
+	RecvID          string `gorm:"column:recv_id;type:char(64)" json:"recvID"`
+	GroupID         string `gorm:"column:group_id;type:char(64)" json:"groupID"`
+	SendAt          int64  `gorm:"column:send_at;index:index_send_at" json:"sendAt"`
+	Message         string `gorm:"column:message;type:text" json:"message"`
+	OfflinePushInfo string `gorm:"column:offline_push_info;type:varchar(1024)" json:"offlinePushInfo"`
+	Attempts        int32  `gorm:"column:attempts" json:"attempts"`
+	CreateTime      int64  `gorm:"column:create_time" json:"createTime"`
+	Ex              string `gorm:"column:ex;type:varchar(1024)" json:"ex"`
+}
+
+func (LocalScheduledMessage) TableName() string {
+	return "local_scheduled_messages"
+}
+
+// LocalOutboxMessage is a message sent while disconnected, waiting in the outbox for the
+// connection. OrderID keeps the send order, Attempts and NextAttemptTime drive the retry backoff.
+type LocalOutboxMessage struct {
+	ClientMsgID     string `gorm:"column:client_msg_id;primary_key;type:char(64)" json:"clientMsgID"`
+	ConversationID  string `gorm:"column:conversation_id;type:char(128);index:index_outbox_conversation_id" json:"conversationID"`
+	RecvID          string `gorm:"column:recv_id;type:char(64)" json:"recvID"`
+	GroupID         string `gorm:"column:group_id;type:char(64)" json:"groupID"`
+	OrderID         int64  `gorm:"column:order_id;index:index_outbox_order_id" json:"orderID"`
+	Message         string `gorm:"column:message;type:text" json:"message"`
+	OfflinePushInfo string `gorm:"column:offline_push_info;type:varchar(1024)" json:"offlinePushInfo"`
+	Attempts        int32  `gorm:"column:attempts" json:"attempts"`
+	NextAttemptTime int64  `gorm:"column:next_attempt_time" json:"nextAttemptTime"`
+	CreateTime      int64  `gorm:"column:create_time" json:"createTime"`
+	Ex              string `gorm:"column:ex;type:varchar(1024)" json:"ex"`
+}
+
+func (LocalOutboxMessage) TableName() string {
+	return "local_outbox_messages"
+}
+
+// LocalPinnedMessage is the pin state of a message in its conversation. Unpinned messages are
+// kept with IsPinned false, so a replayed or late pin notification is never applied twice.
+type LocalPinnedMessage struct {
+	ConversationID string `gorm:"column:conversation_id;primary_key;type:char(128)" json:"conversationID"`
+	ClientMsgID    string `gorm:"column:client_msg_id;primary_key;type:char(64)" json:"clientMsgID"`
+	Seq            int64  `gorm:"column:seq" json:"seq"`
+	IsPinned       bool   `gorm:"column:is_pinned" json:"isPinned"`
+	OperatorUserID string `gorm:"column:operator_user_id;type:char(64)" json:"operatorUserID"`
+	OperateTime    int64  `gorm:"column:operate_time" json:"operateTime"`
+}
+
+func (LocalPinnedMessage) TableName() string {
+	return "local_pinned_messages"
+}
+
+// LocalMention is a message of another user mentioning the login user, listed in the mentions
+// inbox until the message is revoked or deleted.
+type LocalMention struct {
+	ConversationID string `gorm:"column:conversation_id;primary_key;type:char(128)" json:"conversationID"`
+	ClientMsgID    string `gorm:"column:client_msg_id;primary_key;type:char(64)" json:"clientMsgID"`
+	MentionType    int32  `gorm:"column:mention_type" json:"mentionType"`
+	SendID         string `gorm:"column:send_id;type:char(64)" json:"sendID"`
+	SendTime       int64  `gorm:"column:send_time;index:index_mention_send_time" json:"sendTime"`
+	IsRead         bool   `gorm:"column:is_read" json:"isRead"`
+}
+
+func (LocalMention) TableName() string {
+	return "local_mentions"
+}
+
 type LocalUserCommand struct {
 	UserID     string `gorm:"column:user_id;type:char(128);primary_key" json:"userID"`
 	Type       int32  `gorm:"column:type;primary_key" json:"type"`
@@ -0,0 +1,46 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+const (
+	// PersistentStorage keeps the local data in a SQLite file under the data dir, or in
+	// IndexedDB in the browser. It is the default.
+	PersistentStorage = "persistent"
+	// MemoryStorage keeps the local data in memory only, nothing survives a logout.
+	MemoryStorage = "memory"
+)
+
+type options struct {
+	key string
+}
+
+// Option configures how NewDataBase opens the local store.
+type Option func(*options)
+
+// WithKey opens the database encrypted with key. An existing plaintext database is
+// encrypted in place on first use. An empty key opens it as plaintext.
+func WithKey(key string) Option {
+	return func(o *options) {
+		o.key = key
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
@@ -0,0 +1,73 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"gorm.io/gorm"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) SetPinnedMessage(ctx context.Context, pinned *model_struct.LocalPinnedMessage) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	cursor := d.conn.WithContext(ctx).Model(pinned).Select("*").Updates(*pinned)
+	if cursor.Error != nil {
+		return errs.WrapMsg(cursor.Error, "Updates failed")
+	}
+	if cursor.RowsAffected == 0 {
+		return errs.WrapMsg(d.conn.WithContext(ctx).Create(pinned).Error, "Create failed")
+	}
+	return nil
+}
+
+func (d *DataBase) GetPinnedMessage(ctx context.Context, conversationID, clientMsgID string) (*model_struct.LocalPinnedMessage, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	var pinned model_struct.LocalPinnedMessage
+	err := d.conn.WithContext(ctx).Where("conversation_id = ? AND client_msg_id = ?", conversationID, clientMsgID).Take(&pinned).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errs.ErrRecordNotFound.Wrap()
+	}
+	return &pinned, errs.WrapMsg(err, "GetPinnedMessage failed")
+}
+
+func (d *DataBase) GetPinnedMessages(ctx context.Context, conversationID string) (result []*model_struct.LocalPinnedMessage, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return result, errs.WrapMsg(d.conn.WithContext(ctx).Where("conversation_id = ? AND is_pinned = ?", conversationID, true).
+		Order("operate_time DESC").Find(&result).Error, "GetPinnedMessages failed")
+}
+
+func (d *DataBase) DeletePinnedMessages(ctx context.Context, conversationID string, clientMsgIDs []string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.conn.WithContext(ctx).Where("conversation_id = ? AND client_msg_id IN ?", conversationID, clientMsgIDs).
+		Delete(&model_struct.LocalPinnedMessage{}).Error, "DeletePinnedMessages failed")
+}
+
+func (d *DataBase) DeleteConversationPinnedMessages(ctx context.Context, conversationID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.conn.WithContext(ctx).Where("conversation_id = ?", conversationID).
+		Delete(&model_struct.LocalPinnedMessage{}).Error, "DeleteConversationPinnedMessages failed")
+}
@@ -0,0 +1,46 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) GetMessageReactionExtensions(ctx context.Context, clientMsgIDs []string) (result []*model_struct.LocalChatLogReactionExtensions, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return result, errs.WrapMsg(d.conn.WithContext(ctx).Where("client_msg_id IN ?", clientMsgIDs).Find(&result).Error, "GetMessageReactionExtensions failed")
+}
+
+func (d *DataBase) SetMessageReactionExtension(ctx context.Context, extension *model_struct.LocalChatLogReactionExtensions) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	cursor := d.conn.WithContext(ctx).Model(&model_struct.LocalChatLogReactionExtensions{}).Where("client_msg_id = ?", extension.ClientMsgID).
+		Updates(map[string]interface{}{"local_reaction_extensions": extension.LocalReactionExtensions})
+	if cursor.Error != nil {
+		return errs.WrapMsg(cursor.Error, "Updates failed")
+	}
+	if cursor.RowsAffected == 0 {
+		return errs.WrapMsg(d.conn.WithContext(ctx).Create(extension).Error, "Create failed")
+	}
+	return nil
+}
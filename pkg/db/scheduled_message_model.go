@@ -0,0 +1,74 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !js
+// +build !js
+
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"gorm.io/gorm"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+func (d *DataBase) InsertScheduledMessage(ctx context.Context, message *model_struct.LocalScheduledMessage) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	return errs.WrapMsg(d.conn.WithContext(ctx).Create(message).Error, "InsertScheduledMessage failed")
+}
+
+func (d *DataBase) UpdateScheduledMessage(ctx context.Context, message *model_struct.LocalScheduledMessage) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	t := d.conn.WithContext(ctx).Model(message).Select("*").Updates(*message)
+	if t.RowsAffected == 0 {
+		return errs.WrapMsg(errors.New("RowsAffected == 0"), "no update")
+	}
+	return errs.WrapMsg(t.Error, "UpdateScheduledMessage failed")
+}
+
+func (d *DataBase) DeleteScheduledMessage(ctx context.Context, clientMsgID string) error {
+	d.mRWMutex.Lock()
+	defer d.mRWMutex.Unlock()
+	message := model_struct.LocalScheduledMessage{ClientMsgID: clientMsgID}
+	return errs.WrapMsg(d.conn.WithContext(ctx).Delete(&message).Error, "DeleteScheduledMessage failed")
+}
+
+func (d *DataBase) GetScheduledMessage(ctx context.Context, clientMsgID string) (*model_struct.LocalScheduledMessage, error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	var message model_struct.LocalScheduledMessage
+	err := d.conn.WithContext(ctx).Where("client_msg_id = ?", clientMsgID).Take(&message).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errs.ErrRecordNotFound.Wrap()
+	}
+	return &message, errs.WrapMsg(err, "GetScheduledMessage failed")
+}
+
+func (d *DataBase) GetAllScheduledMessages(ctx context.Context) (messages []*model_struct.LocalScheduledMessage, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return messages, errs.WrapMsg(d.conn.WithContext(ctx).Order("send_at ASC").Find(&messages).Error, "GetAllScheduledMessages failed")
+}
+
+func (d *DataBase) GetDueScheduledMessages(ctx context.Context, sendAt int64) (messages []*model_struct.LocalScheduledMessage, err error) {
+	d.mRWMutex.RLock()
+	defer d.mRWMutex.RUnlock()
+	return messages, errs.WrapMsg(d.conn.WithContext(ctx).Where("send_at <= ?", sendAt).Order("send_at ASC").Find(&messages).Error, "GetDueScheduledMessages failed")
+}
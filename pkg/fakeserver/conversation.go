@@ -0,0 +1,154 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeserver
+
+import (
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/api"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	pbConversation "github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (s *Server) userConversations(userID string) *versionList[*pbConversation.Conversation] {
+	l, ok := s.conversations[userID]
+	if !ok {
+		l = newVersionList[*pbConversation.Conversation]()
+		s.conversations[userID] = l
+	}
+	return l
+}
+
+func (s *Server) conversation(userID, conversationID string) (*pbConversation.Conversation, bool) {
+	return s.userConversations(userID).get(conversationID)
+}
+
+// ensureConversation creates the conversation of ownerUserID when missing, the same as the
+// server does on the first message.
+func (s *Server) ensureConversation(ownerUserID, conversationID string, conversationType int32, userID, groupID string) *pbConversation.Conversation {
+	l := s.userConversations(ownerUserID)
+	if conversation, ok := l.get(conversationID); ok {
+		return conversation
+	}
+	conversation := &pbConversation.Conversation{
+		OwnerUserID:      ownerUserID,
+		ConversationID:   conversationID,
+		ConversationType: conversationType,
+		UserID:           userID,
+		GroupID:          groupID,
+	}
+	l.set(conversationID, conversation)
+	return conversation
+}
+
+// setConversation applies the fields set in req to conversation.
+func setConversation(conversation *pbConversation.Conversation, req *pbConversation.ConversationReq) {
+	if req.RecvMsgOpt != nil {
+		conversation.RecvMsgOpt = req.RecvMsgOpt.Value
+	}
+	if req.IsPinned != nil {
+		conversation.IsPinned = req.IsPinned.Value
+	}
+	if req.AttachedInfo != nil {
+		conversation.AttachedInfo = req.AttachedInfo.Value
+	}
+	if req.IsPrivateChat != nil {
+		conversation.IsPrivateChat = req.IsPrivateChat.Value
+	}
+	if req.Ex != nil {
+		conversation.Ex = req.Ex.Value
+	}
+	if req.BurnDuration != nil {
+		conversation.BurnDuration = req.BurnDuration.Value
+	}
+	if req.MinSeq != nil {
+		conversation.MinSeq = req.MinSeq.Value
+	}
+	if req.MaxSeq != nil {
+		conversation.MaxSeq = req.MaxSeq.Value
+	}
+	if req.GroupAtType != nil {
+		conversation.GroupAtType = req.GroupAtType.Value
+	}
+	if req.MsgDestructTime != nil {
+		conversation.MsgDestructTime = req.MsgDestructTime.Value
+	}
+	if req.IsMsgDestruct != nil {
+		conversation.IsMsgDestruct = req.IsMsgDestruct.Value
+	}
+}
+
+func (s *Server) registerConversation() {
+	handle(s, api.GetConversations, false, func(c *caller, req *pbConversation.GetConversationsReq) (*pbConversation.GetConversationsResp, error) {
+		if err := c.check(req.OwnerUserID); err != nil {
+			return nil, err
+		}
+		resp := &pbConversation.GetConversationsResp{}
+		for _, conversationID := range req.ConversationIDs {
+			if conversation, ok := s.conversation(req.OwnerUserID, conversationID); ok {
+				resp.Conversations = append(resp.Conversations, conversation)
+			}
+		}
+		return resp, nil
+	})
+	handle(s, api.GetAllConversations, false, func(c *caller, req *pbConversation.GetAllConversationsReq) (*pbConversation.GetAllConversationsResp, error) {
+		if err := c.check(req.OwnerUserID); err != nil {
+			return nil, err
+		}
+		return &pbConversation.GetAllConversationsResp{Conversations: s.userConversations(req.OwnerUserID).list()}, nil
+	})
+	handle(s, api.SetConversations, false, func(c *caller, req *pbConversation.SetConversationsReq) (*pbConversation.SetConversationsResp, error) {
+		if req.Conversation == nil || req.Conversation.ConversationID == "" {
+			return nil, errs.ErrArgs.WrapMsg("conversation is empty")
+		}
+		for _, userID := range req.UserIDs {
+			if err := c.check(userID); err != nil {
+				return nil, err
+			}
+		}
+		for _, userID := range req.UserIDs {
+			conversation := s.ensureConversation(userID, req.Conversation.ConversationID, req.Conversation.ConversationType,
+				req.Conversation.UserID, req.Conversation.GroupID)
+			setConversation(conversation, req.Conversation)
+			s.userConversations(userID).touch(conversation.ConversationID)
+			s.notify(userID, userID, constant.ConversationChangeNotification,
+				&sdkws.ConversationUpdateTips{UserID: userID, ConversationIDList: []string{conversation.ConversationID}})
+		}
+		return &pbConversation.SetConversationsResp{}, nil
+	})
+	handle(s, api.GetIncrementalConversation, false, func(c *caller, req *pbConversation.GetIncrementalConversationReq) (*pbConversation.GetIncrementalConversationResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		l := s.userConversations(req.UserID)
+		resp := &pbConversation.GetIncrementalConversationResp{VersionID: l.versionID, Version: l.version}
+		resp.Full, resp.Insert, resp.Update, resp.Delete = l.changes(req.VersionID, req.Version)
+		return resp, nil
+	})
+	handle(s, api.GetFullConversationIDs, false, func(c *caller, req *pbConversation.GetFullOwnerConversationIDsReq) (*pbConversation.GetFullOwnerConversationIDsResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		l := s.userConversations(req.UserID)
+		return &pbConversation.GetFullOwnerConversationIDsResp{VersionID: l.versionID, Version: l.version, ConversationIDs: l.idList()}, nil
+	})
+	handle(s, api.GetOwnerConversation, false, func(c *caller, req *pbConversation.GetOwnerConversationReq) (*pbConversation.GetOwnerConversationResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		list := s.userConversations(req.UserID).list()
+		return &pbConversation.GetOwnerConversationResp{Total: int64(len(list)), Conversations: page(list, req.Pagination)}, nil
+	})
+}
@@ -0,0 +1,263 @@
+//go:build !js
+
+package fakeserver
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/internal/third/file"
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/ccontext"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/relation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/log"
+)
+
+type testConnListener struct{}
+
+func (testConnListener) OnConnecting()                    {}
+func (testConnListener) OnConnectSuccess()                {}
+func (testConnListener) OnConnectFailed(int32, string)    {}
+func (testConnListener) OnKickedOffline()                 {}
+func (testConnListener) OnUserTokenExpired()              {}
+func (testConnListener) OnUserTokenInvalid(errMsg string) {}
+func (testConnListener) OnReconnecting(int32, int64)      {}
+
+// syncListener reports the end of the sync of the login.
+type syncListener struct {
+	once sync.Once
+	done chan struct{}
+}
+
+func (l *syncListener) finish() { l.once.Do(func() { close(l.done) }) }
+
+func (l *syncListener) OnSyncServerStart(bool)                      {}
+func (l *syncListener) OnSyncServerFinish(bool)                     { l.finish() }
+func (l *syncListener) OnSyncServerProgress(int)                    {}
+func (l *syncListener) OnSyncServerFailed(bool)                     { l.finish() }
+func (l *syncListener) OnNewConversation(string)                    {}
+func (l *syncListener) OnConversationChanged(string)                {}
+func (l *syncListener) OnTotalUnreadMessageCountChanged(int32)      {}
+func (l *syncListener) OnConversationUserInputStatusChanged(string) {}
+func (l *syncListener) OnUnreadMentionCountChanged(int32)           {}
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "fakeserver")
+	if err != nil {
+		panic(err)
+	}
+	// the sdk logs to the working directory unless the logger is initialized
+	if err := log.InitLoggerFromConfig("sdk", "", "", "", 3, false, false, dir, 1, 24, "", false); err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// newServer returns a Server closed after the logouts of the test.
+func newServer(t *testing.T) *Server {
+	s := New()
+	t.Cleanup(s.Close)
+	return s
+}
+
+// login logs userID in to s with a LoginMgr of its own data dir.
+func login(t *testing.T, s *Server, userID string) *open_im_sdk.LoginMgr {
+	t.Helper()
+	return loginConfig(t, s, userID, s.Config(t.TempDir()))
+}
+
+func loginConfig(t *testing.T, s *Server, userID string, config sdk_struct.IMConfig) *open_im_sdk.LoginMgr {
+	t.Helper()
+	u := open_im_sdk.NewLoginMgr()
+	if !u.InitSDK(config, testConnListener{}) {
+		t.Fatal("init sdk")
+	}
+	listener := &syncListener{done: make(chan struct{})}
+	u.SetConversationListener(listener)
+	if err := u.Login(ctx(u), userID, s.Token(userID, constant.LinuxPlatformID)); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = u.Logout(ctx(u)) })
+	select {
+	case <-listener.done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the sync of " + userID)
+	}
+	return u
+}
+
+func ctx(u *open_im_sdk.LoginMgr) context.Context {
+	return ccontext.WithOperationID(u.Context(), utils.OperationIDGenerator())
+}
+
+// eventually fails t unless cond holds within a few seconds.
+func eventually(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); time.Sleep(50 * time.Millisecond) {
+		if cond() {
+			return
+		}
+	}
+	t.Fatal("timed out waiting for " + what)
+}
+
+func sendText(t *testing.T, u *open_im_sdk.LoginMgr, text, recvID, groupID string) {
+	t.Helper()
+	msg, err := u.Conversation().CreateTextMessage(ctx(u), text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := u.Conversation().SendMessage(ctx(u), msg, recvID, groupID, &sdkws.OfflinePushInfo{}, false); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// texts returns the contents of the messages of conversationID in u, the latest first.
+func texts(u *open_im_sdk.LoginMgr, conversationID string) []string {
+	resp, err := u.Conversation().GetAdvancedHistoryMessageList(ctx(u), sdk_params_callback.GetAdvancedHistoryMessageListParams{
+		ConversationID: conversationID,
+		Count:          20,
+	})
+	if err != nil {
+		return nil
+	}
+	var texts []string
+	for _, msg := range resp.MessageList {
+		if msg.TextElem != nil {
+			texts = append(texts, msg.TextElem.Content)
+		}
+	}
+	return texts
+}
+
+func unread(u *open_im_sdk.LoginMgr, conversationID string) int32 {
+	conversations, err := u.Conversation().GetAllConversationList(ctx(u))
+	if err != nil {
+		return -1
+	}
+	for _, conversation := range conversations {
+		if conversation.ConversationID == conversationID {
+			return conversation.UnreadCount
+		}
+	}
+	return -1
+}
+
+func TestFriendAndSingleChat(t *testing.T) {
+	s := newServer(t)
+	alice, bob := login(t, s, "alice"), login(t, s, "bob")
+
+	if err := alice.Relation().AddFriend(ctx(alice), &relation.ApplyToAddFriendReq{FromUserID: "alice", ToUserID: "bob", ReqMsg: "hi"}); err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, "the friend request", func() bool {
+		requests, err := bob.Relation().GetFriendApplicationListAsRecipient(ctx(bob))
+		if err == nil && len(requests) == 1 && requests[0].FromUserID == "alice" {
+			return true
+		}
+		// The login full sync of bob may still hold the request syncer, which drops the
+		// notification sync, so pull the requests again until they arrive.
+		_ = bob.Relation().SyncAllFriendApplication(ctx(bob))
+		return false
+	})
+	if err := bob.Relation().AcceptFriendApplication(ctx(bob), &sdk_params_callback.ProcessFriendApplicationParams{ToUserID: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, "the friends", func() bool {
+		a, _ := alice.Relation().GetFriendList(ctx(alice), false)
+		b, _ := bob.Relation().GetFriendList(ctx(bob), false)
+		return len(a) == 1 && a[0].FriendUserID == "bob" && len(b) == 1 && b[0].FriendUserID == "alice"
+	})
+
+	for _, text := range []string{"one", "two", "three"} {
+		sendText(t, alice, text, "bob", "")
+	}
+	conversationID := singleConversationID("alice", "bob")
+	eventually(t, "the messages", func() bool {
+		got := texts(bob, conversationID)
+		return len(got) == 3 && got[0] == "one" && got[2] == "three" || len(got) == 3 && got[0] == "three" && got[2] == "one"
+	})
+	eventually(t, "the unread count", func() bool { return unread(bob, conversationID) == 3 })
+
+	// the messages sent while offline are pulled on login
+	s.AddUser(&sdkws.UserInfo{UserID: "dave", Nickname: "dave"})
+	carol := login(t, s, "carol")
+	sendText(t, carol, "offline", "dave", "")
+	dave := login(t, s, "dave")
+	eventually(t, "the offline message", func() bool {
+		got := texts(dave, singleConversationID("carol", "dave"))
+		return len(got) == 1 && got[0] == "offline"
+	})
+}
+
+func TestGroupChat(t *testing.T) {
+	s := newServer(t)
+	alice, bob, carol := login(t, s, "alice"), login(t, s, "bob"), login(t, s, "carol")
+
+	info, err := alice.Group().CreateGroup(ctx(alice), &group.CreateGroupReq{
+		MemberUserIDs: []string{"bob", "carol"},
+		GroupInfo:     &sdkws.GroupInfo{GroupName: "test", GroupType: constant.WorkingGroup},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conversationID := groupConversationID(info.GroupID)
+	sendText(t, alice, "hello", "", info.GroupID)
+	sendText(t, bob, "world", "", info.GroupID)
+	for _, u := range []*open_im_sdk.LoginMgr{alice, bob, carol} {
+		eventually(t, "the group messages", func() bool { return len(texts(u, conversationID)) == 2 })
+	}
+	eventually(t, "the unread count", func() bool { return unread(carol, conversationID) == 2 })
+}
+
+func TestUpload(t *testing.T) {
+	s := newServer(t)
+	alice := login(t, s, "alice")
+
+	data := make([]byte, minPartSize+1024)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	path := filepath.Join(t.TempDir(), "data.bin")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := alice.File().UploadFile(ctx(alice), &file.UploadFileReq{Filepath: path, Name: "alice/data.bin", Cause: "test"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := http.Get(resp.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer got.Body.Close()
+	body, err := io.ReadAll(got.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != string(data) {
+		t.Fatalf("downloaded %d bytes, uploaded %d", len(body), len(data))
+	}
+	// the same content is not uploaded again
+	again, err := alice.File().UploadFile(ctx(alice), &file.UploadFileReq{Filepath: path, Name: "alice/copy.bin", Cause: "test"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.URL != s.objectURL("alice/copy.bin") {
+		t.Fatalf("url %s", again.URL)
+	}
+}
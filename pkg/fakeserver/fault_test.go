@@ -0,0 +1,122 @@
+//go:build faultinject && !js
+
+package fakeserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk"
+	sdkconstant "github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/network"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/sdk_params_callback"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+// isPush selects the PushMsg frames, the connections of the scenarios send plain json.
+func isPush(frame network.Frame) bool {
+	var resp struct {
+		ReqIdentifier int `json:"reqIdentifier"`
+	}
+	return json.Unmarshal(frame.Data, &resp) == nil && resp.ReqIdentifier == sdkconstant.PushMsg
+}
+
+func textMsg(sendID, recvID, text string) *sdkws.MsgData {
+	return &sdkws.MsgData{
+		SendID:           sendID,
+		RecvID:           recvID,
+		ClientMsgID:      utils.GetMsgID(sendID),
+		SenderPlatformID: constant.LinuxPlatformID,
+		SessionType:      constant.SingleChatType,
+		MsgFrom:          constant.UserMsgType,
+		ContentType:      constant.Text,
+		Content:          []byte(utils.StructToJsonString(sdk_struct.TextElem{Content: text})),
+		CreateTime:       now(),
+	}
+}
+
+// history returns the messages of conversationID in u.
+func history(u *open_im_sdk.LoginMgr, conversationID string) []*sdk_struct.MsgStruct {
+	resp, err := u.Conversation().GetAdvancedHistoryMessageList(ctx(u), sdk_params_callback.GetAdvancedHistoryMessageListParams{
+		ConversationID: conversationID,
+		Count:          100,
+	})
+	if err != nil {
+		return nil
+	}
+	return resp.MessageList
+}
+
+// checkHistory returns why msgs are not the n texts in seq order from seq 1.
+func checkHistory(msgs []*sdk_struct.MsgStruct, n int) error {
+	if len(msgs) != n {
+		return fmt.Errorf("%d messages, want %d", len(msgs), n)
+	}
+	for i, msg := range msgs {
+		if msg.Seq != int64(i+1) {
+			return fmt.Errorf("message %d has seq %d", i, msg.Seq)
+		}
+		if msg.TextElem == nil || msg.TextElem.Content != fmt.Sprint(i+1) {
+			return fmt.Errorf("message of seq %d is %v", msg.Seq, msg.TextElem)
+		}
+	}
+	return nil
+}
+
+func TestFaultScenarios(t *testing.T) {
+	const count = 20
+	tests := []struct {
+		name  string
+		rules []network.FaultRule
+	}{
+		{"drop", []network.FaultRule{{Target: network.FaultRead, Kind: network.FaultDrop, Match: isPush, Probability: 0.5, Limit: 4}}},
+		{"delay", []network.FaultRule{{Target: network.FaultRead, Kind: network.FaultDelay, Match: isPush, Probability: 0.5, Limit: 4, Delay: 200 * time.Millisecond}}},
+		{"duplicate", []network.FaultRule{{Target: network.FaultRead, Kind: network.FaultDuplicate, Match: isPush, Probability: 0.5, Limit: 4}}},
+		{"reorder", []network.FaultRule{{Target: network.FaultRead, Kind: network.FaultReorder, Match: isPush, Probability: 0.5, Limit: 4}}},
+		{"corrupt", []network.FaultRule{{Target: network.FaultRead, Kind: network.FaultCorrupt, Match: isPush, Probability: 0.5, Limit: 2}}},
+		{"disconnect", []network.FaultRule{{Target: network.FaultRead, Kind: network.FaultDisconnect, Match: isPush, Probability: 0.5, Limit: 2}}},
+		{"http", []network.FaultRule{
+			{Target: network.FaultHTTP, Kind: network.FaultDuplicate, Probability: 0.3},
+			{Target: network.FaultHTTP, Kind: network.FaultReorder, Probability: 0.3, Delay: 100 * time.Millisecond},
+			{Target: network.FaultHTTP, Kind: network.FaultDelay, Probability: 0.3, Delay: 50 * time.Millisecond},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newServer(t)
+			s.AddUser(&sdkws.UserInfo{UserID: "alice", Nickname: "alice"})
+			f := network.NewFaultInjector(network.FaultScript{Seed: 1, Rules: tt.rules})
+			t.Cleanup(network.InjectFaults(f))
+			config := s.Config(t.TempDir())
+			config.WsCodec, config.Compression = "json", "none"
+			bob := loginConfig(t, s, "bob", config)
+
+			for i := 1; i <= count; i++ {
+				if err := s.SendMsg(textMsg("alice", "bob", fmt.Sprint(i))); err != nil {
+					t.Fatal(err)
+				}
+				time.Sleep(20 * time.Millisecond)
+			}
+			conversationID := singleConversationID("alice", "bob")
+			var err error
+			eventually(t, "the messages", func() bool {
+				err = checkHistory(history(bob, conversationID), count)
+				return err == nil
+			})
+			eventually(t, "the unread count", func() bool { return unread(bob, conversationID) == count })
+			if err := bob.Conversation().MarkConversationMessageAsRead(ctx(bob), conversationID); err != nil {
+				t.Fatal(err)
+			}
+			eventually(t, "the read messages", func() bool { return unread(bob, conversationID) == 0 })
+			if len(f.Events()) == 0 {
+				t.Fatal("no fault injected")
+			}
+			t.Logf("%d faults injected", len(f.Events()))
+		})
+	}
+}
@@ -0,0 +1,356 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeserver
+
+import (
+	"sort"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/api"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/protocol/relation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+)
+
+func (s *Server) userFriends(userID string) *versionList[*sdkws.FriendInfo] {
+	l, ok := s.friends[userID]
+	if !ok {
+		l = newVersionList[*sdkws.FriendInfo]()
+		s.friends[userID] = l
+	}
+	return l
+}
+
+func (s *Server) isFriend(ownerUserID, friendUserID string) bool {
+	_, ok := s.userFriends(ownerUserID).get(friendUserID)
+	return ok
+}
+
+// addFriend adds friendUserID to the friends of ownerUserID, the friend info shares the user.
+func (s *Server) addFriend(ownerUserID, friendUserID, operatorUserID string, addSource int32) *sdkws.FriendInfo {
+	friend := &sdkws.FriendInfo{
+		OwnerUserID:    ownerUserID,
+		CreateTime:     now(),
+		FriendUser:     s.users[friendUserID],
+		AddSource:      addSource,
+		OperatorUserID: operatorUserID,
+	}
+	s.userFriends(ownerUserID).set(friendUserID, friend)
+	return friend
+}
+
+// friendRequest returns the request of fromUserID to toUserID.
+func (s *Server) friendRequest(fromUserID, toUserID string) (*sdkws.FriendRequest, bool) {
+	for _, req := range s.friendRequests {
+		if req.FromUserID == fromUserID && req.ToUserID == toUserID {
+			return req, true
+		}
+	}
+	return nil, false
+}
+
+// friendRequestsOf returns the requests selected by fn, the latest first.
+func (s *Server) friendRequestsOf(fn func(req *sdkws.FriendRequest) bool) []*sdkws.FriendRequest {
+	var requests []*sdkws.FriendRequest
+	for i := len(s.friendRequests) - 1; i >= 0; i-- {
+		if fn(s.friendRequests[i]) {
+			requests = append(requests, s.friendRequests[i])
+		}
+	}
+	return requests
+}
+
+func (s *Server) blackList(ownerUserID string) []*sdkws.BlackInfo {
+	var blacks []*sdkws.BlackInfo
+	for _, black := range s.blacks[ownerUserID] {
+		blacks = append(blacks, black)
+	}
+	sort.Slice(blacks, func(i, j int) bool {
+		if blacks[i].CreateTime != blacks[j].CreateTime {
+			return blacks[i].CreateTime < blacks[j].CreateTime
+		}
+		return blacks[i].BlackUserInfo.UserID < blacks[j].BlackUserInfo.UserID
+	})
+	return blacks
+}
+
+func (s *Server) registerFriend() {
+	handle(s, api.AddFriend, false, func(c *caller, req *relation.ApplyToAddFriendReq) (*relation.ApplyToAddFriendResp, error) {
+		if err := c.check(req.FromUserID); err != nil {
+			return nil, err
+		}
+		if req.FromUserID == req.ToUserID {
+			return nil, errs.ErrArgs.WrapMsg("can not add self as a friend")
+		}
+		from, err := s.user(req.FromUserID)
+		if err != nil {
+			return nil, err
+		}
+		to, err := s.user(req.ToUserID)
+		if err != nil {
+			return nil, err
+		}
+		if s.isFriend(req.FromUserID, req.ToUserID) && s.isFriend(req.ToUserID, req.FromUserID) {
+			return nil, errs.ErrArgs.WrapMsg("already friends")
+		}
+		if _, ok := s.blacks[req.ToUserID][req.FromUserID]; ok {
+			return nil, errBlockedByPeer.WrapMsg("blocked by " + req.ToUserID)
+		}
+		// a new application replaces the former one
+		for i, r := range s.friendRequests {
+			if r.FromUserID == req.FromUserID && r.ToUserID == req.ToUserID {
+				s.friendRequests = append(s.friendRequests[:i], s.friendRequests[i+1:]...)
+				break
+			}
+		}
+		s.friendRequests = append(s.friendRequests, &sdkws.FriendRequest{
+			FromUserID:   from.UserID,
+			FromNickname: from.Nickname,
+			FromFaceURL:  from.FaceURL,
+			ToUserID:     to.UserID,
+			ToNickname:   to.Nickname,
+			ToFaceURL:    to.FaceURL,
+			ReqMsg:       req.ReqMsg,
+			CreateTime:   now(),
+			Ex:           req.Ex,
+		})
+		s.notify(req.FromUserID, req.ToUserID, constant.FriendApplicationNotification,
+			&sdkws.FriendApplicationTips{FromToUserID: &sdkws.FromToUserID{FromUserID: req.FromUserID, ToUserID: req.ToUserID}})
+		return &relation.ApplyToAddFriendResp{}, nil
+	})
+	handle(s, api.AddFriendResponse, false, func(c *caller, req *relation.RespondFriendApplyReq) (*relation.RespondFriendApplyResp, error) {
+		if err := c.check(req.ToUserID); err != nil {
+			return nil, err
+		}
+		request, ok := s.friendRequest(req.FromUserID, req.ToUserID)
+		if !ok {
+			return nil, errs.ErrRecordNotFound.WrapMsg("friend request not found", "fromUserID", req.FromUserID, "toUserID", req.ToUserID)
+		}
+		if request.HandleResult != 0 {
+			return nil, errs.ErrArgs.WrapMsg("friend request handled already")
+		}
+		request.HandleResult, request.HandleMsg = req.HandleResult, req.HandleMsg
+		request.HandlerUserID, request.HandleTime = c.userID, now()
+		// from of the tips is the handler
+		fromTo := &sdkws.FromToUserID{FromUserID: req.ToUserID, ToUserID: req.FromUserID}
+		switch req.HandleResult {
+		case constant.FriendResponseAgree:
+			s.addFriend(req.FromUserID, req.ToUserID, req.ToUserID, 0)
+			s.addFriend(req.ToUserID, req.FromUserID, req.ToUserID, 0)
+			friends := s.userFriends(req.ToUserID)
+			s.notify(req.ToUserID, req.FromUserID, constant.FriendApplicationApprovedNotification, &sdkws.FriendApplicationApprovedTips{
+				FromToUserID:    fromTo,
+				HandleMsg:       req.HandleMsg,
+				FriendVersion:   friends.version,
+				FriendVersionID: friends.versionID,
+			})
+		case constant.FriendResponseRefuse:
+			s.notify(req.ToUserID, req.FromUserID, constant.FriendApplicationRejectedNotification,
+				&sdkws.FriendApplicationRejectedTips{FromToUserID: fromTo, HandleMsg: req.HandleMsg})
+		default:
+			return nil, errs.ErrArgs.WrapMsg("invalid handleResult")
+		}
+		return &relation.RespondFriendApplyResp{}, nil
+	})
+	handle(s, api.DeleteFriend, false, func(c *caller, req *relation.DeleteFriendReq) (*relation.DeleteFriendResp, error) {
+		if err := c.check(req.OwnerUserID); err != nil {
+			return nil, err
+		}
+		friends := s.userFriends(req.OwnerUserID)
+		if !friends.delete(req.FriendUserID) {
+			return nil, errs.ErrRecordNotFound.WrapMsg("not friends", "friendUserID", req.FriendUserID)
+		}
+		s.notify(req.OwnerUserID, req.FriendUserID, constant.FriendDeletedNotification, &sdkws.FriendDeletedTips{
+			FromToUserID:    &sdkws.FromToUserID{FromUserID: req.OwnerUserID, ToUserID: req.FriendUserID},
+			FriendVersion:   friends.version,
+			FriendVersionID: friends.versionID,
+		})
+		return &relation.DeleteFriendResp{}, nil
+	})
+	handle(s, api.ImportFriendList, false, func(c *caller, req *relation.ImportFriendReq) (*relation.ImportFriendResp, error) {
+		if !c.isAdmin() {
+			return nil, errs.ErrNoPermission.WrapMsg("only the admin imports friends")
+		}
+		for _, userID := range append([]string{req.OwnerUserID}, req.FriendUserIDs...) {
+			if _, err := s.user(userID); err != nil {
+				return nil, err
+			}
+		}
+		for _, friendUserID := range req.FriendUserIDs {
+			if friendUserID == req.OwnerUserID || s.isFriend(req.OwnerUserID, friendUserID) {
+				continue
+			}
+			friend := s.addFriend(req.OwnerUserID, friendUserID, c.userID, 0)
+			s.addFriend(friendUserID, req.OwnerUserID, c.userID, 0)
+			s.notify(req.OwnerUserID, friendUserID, constant.FriendAddedNotification, &sdkws.FriendAddedTips{
+				Friend:        friend,
+				OperationTime: now(),
+				OpUser:        s.publicUser(c.userID),
+			})
+		}
+		return &relation.ImportFriendResp{}, nil
+	})
+	handle(s, api.SetFriendRemark, false, func(c *caller, req *relation.SetFriendRemarkReq) (*relation.SetFriendRemarkResp, error) {
+		if err := c.check(req.OwnerUserID); err != nil {
+			return nil, err
+		}
+		friends := s.userFriends(req.OwnerUserID)
+		friend, ok := friends.get(req.FriendUserID)
+		if !ok {
+			return nil, errs.ErrRecordNotFound.WrapMsg("not friends", "friendUserID", req.FriendUserID)
+		}
+		friend.Remark = req.Remark
+		friends.touch(req.FriendUserID)
+		s.notify(req.OwnerUserID, req.OwnerUserID, constant.FriendRemarkSetNotification, &sdkws.FriendInfoChangedTips{
+			FromToUserID:    &sdkws.FromToUserID{FromUserID: req.OwnerUserID, ToUserID: req.FriendUserID},
+			FriendVersion:   friends.version,
+			FriendVersionID: friends.versionID,
+		})
+		return &relation.SetFriendRemarkResp{}, nil
+	})
+	handle(s, api.UpdateFriends, false, func(c *caller, req *relation.UpdateFriendsReq) (*relation.UpdateFriendsResp, error) {
+		if err := c.check(req.OwnerUserID); err != nil {
+			return nil, err
+		}
+		friends := s.userFriends(req.OwnerUserID)
+		for _, friendUserID := range req.FriendUserIDs {
+			if _, ok := friends.get(friendUserID); !ok {
+				return nil, errs.ErrRecordNotFound.WrapMsg("not friends", "friendUserID", friendUserID)
+			}
+		}
+		for _, friendUserID := range req.FriendUserIDs {
+			friend, _ := friends.get(friendUserID)
+			if req.IsPinned != nil {
+				friend.IsPinned = req.IsPinned.Value
+			}
+			if req.Remark != nil {
+				friend.Remark = req.Remark.Value
+			}
+			if req.Ex != nil {
+				friend.Ex = req.Ex.Value
+			}
+			friends.touch(friendUserID)
+		}
+		s.notify(req.OwnerUserID, req.OwnerUserID, constant.FriendsInfoUpdateNotification, &sdkws.FriendsInfoUpdateTips{
+			FromToUserID:    &sdkws.FromToUserID{FromUserID: req.OwnerUserID, ToUserID: req.OwnerUserID},
+			FriendIDs:       req.FriendUserIDs,
+			FriendVersion:   friends.version,
+			FriendVersionID: friends.versionID,
+		})
+		return &relation.UpdateFriendsResp{}, nil
+	})
+	handle(s, api.GetFriendApplicationList, false, func(c *caller, req *relation.GetPaginationFriendsApplyToReq) (*relation.GetPaginationFriendsApplyToResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		requests := s.friendRequestsOf(func(r *sdkws.FriendRequest) bool { return r.ToUserID == req.UserID })
+		return &relation.GetPaginationFriendsApplyToResp{FriendRequests: page(requests, req.Pagination), Total: int32(len(requests))}, nil
+	})
+	handle(s, api.GetSelfFriendApplicationList, false, func(c *caller, req *relation.GetPaginationFriendsApplyFromReq) (*relation.GetPaginationFriendsApplyFromResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		requests := s.friendRequestsOf(func(r *sdkws.FriendRequest) bool { return r.FromUserID == req.UserID })
+		return &relation.GetPaginationFriendsApplyFromResp{FriendRequests: page(requests, req.Pagination), Total: int32(len(requests))}, nil
+	})
+	handle(s, api.GetDesignatedFriendsApply, false, func(c *caller, req *relation.GetDesignatedFriendsApplyReq) (*relation.GetDesignatedFriendsApplyResp, error) {
+		if c.check(req.FromUserID) != nil && c.check(req.ToUserID) != nil {
+			return nil, errs.ErrNoPermission.WrapMsg("caller is not in the friend requests")
+		}
+		// the requests of both directions, the same as the server
+		requests := s.friendRequestsOf(func(r *sdkws.FriendRequest) bool {
+			return (r.FromUserID == req.FromUserID && r.ToUserID == req.ToUserID) ||
+				(r.FromUserID == req.ToUserID && r.ToUserID == req.FromUserID)
+		})
+		return &relation.GetDesignatedFriendsApplyResp{FriendRequests: requests}, nil
+	})
+	handle(s, api.GetFriendList, false, func(c *caller, req *relation.GetPaginationFriendsReq) (*relation.GetPaginationFriendsResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		friends := s.userFriends(req.UserID).list()
+		return &relation.GetPaginationFriendsResp{FriendsInfo: page(friends, req.Pagination), Total: int32(len(friends))}, nil
+	})
+	handle(s, api.GetDesignatedFriends, false, func(c *caller, req *relation.GetDesignatedFriendsReq) (*relation.GetDesignatedFriendsResp, error) {
+		if err := c.check(req.OwnerUserID); err != nil {
+			return nil, err
+		}
+		resp := &relation.GetDesignatedFriendsResp{}
+		for _, friendUserID := range req.FriendUserIDs {
+			if friend, ok := s.userFriends(req.OwnerUserID).get(friendUserID); ok {
+				resp.FriendsInfo = append(resp.FriendsInfo, friend)
+			}
+		}
+		return resp, nil
+	})
+	handle(s, api.GetIncrementalFriends, false, func(c *caller, req *relation.GetIncrementalFriendsReq) (*relation.GetIncrementalFriendsResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		l := s.userFriends(req.UserID)
+		resp := &relation.GetIncrementalFriendsResp{VersionID: l.versionID, Version: l.version}
+		resp.Full, resp.Insert, resp.Update, resp.Delete = l.changes(req.VersionID, req.Version)
+		return resp, nil
+	})
+	handle(s, api.GetFullFriendUserIDs, false, func(c *caller, req *relation.GetFullFriendUserIDsReq) (*relation.GetFullFriendUserIDsResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		l := s.userFriends(req.UserID)
+		return &relation.GetFullFriendUserIDsResp{VersionID: l.versionID, Version: l.version, UserIDs: l.idList()}, nil
+	})
+	handle(s, api.AddBlack, false, func(c *caller, req *relation.AddBlackReq) (*relation.AddBlackResp, error) {
+		if err := c.check(req.OwnerUserID); err != nil {
+			return nil, err
+		}
+		if _, err := s.user(req.BlackUserID); err != nil {
+			return nil, err
+		}
+		blacks, ok := s.blacks[req.OwnerUserID]
+		if !ok {
+			blacks = make(map[string]*sdkws.BlackInfo)
+			s.blacks[req.OwnerUserID] = blacks
+		}
+		blacks[req.BlackUserID] = &sdkws.BlackInfo{
+			OwnerUserID:    req.OwnerUserID,
+			CreateTime:     now(),
+			BlackUserInfo:  s.publicUser(req.BlackUserID),
+			OperatorUserID: c.userID,
+			Ex:             req.Ex,
+		}
+		s.notify(req.OwnerUserID, req.OwnerUserID, constant.BlackAddedNotification,
+			&sdkws.BlackAddedTips{FromToUserID: &sdkws.FromToUserID{FromUserID: req.OwnerUserID, ToUserID: req.BlackUserID}})
+		return &relation.AddBlackResp{}, nil
+	})
+	handle(s, api.RemoveBlack, false, func(c *caller, req *relation.RemoveBlackReq) (*relation.RemoveBlackResp, error) {
+		if err := c.check(req.OwnerUserID); err != nil {
+			return nil, err
+		}
+		if _, ok := s.blacks[req.OwnerUserID][req.BlackUserID]; !ok {
+			return nil, errs.ErrRecordNotFound.WrapMsg("not in the blacklist", "blackUserID", req.BlackUserID)
+		}
+		delete(s.blacks[req.OwnerUserID], req.BlackUserID)
+		s.notify(req.OwnerUserID, req.OwnerUserID, constant.BlackDeletedNotification,
+			&sdkws.BlackDeletedTips{FromToUserID: &sdkws.FromToUserID{FromUserID: req.OwnerUserID, ToUserID: req.BlackUserID}})
+		return &relation.RemoveBlackResp{}, nil
+	})
+	handle(s, api.GetBlackList, false, func(c *caller, req *relation.GetPaginationBlacksReq) (*relation.GetPaginationBlacksResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		blacks := s.blackList(req.UserID)
+		return &relation.GetPaginationBlacksResp{Blacks: page(blacks, req.Pagination), Total: int32(len(blacks))}, nil
+	})
+}
@@ -0,0 +1,315 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeserver
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/openimsdk/openim-sdk-core/v3/internal/interaction"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/push"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+	"google.golang.org/protobuf/proto"
+)
+
+const writeTimeout = 5 * time.Second
+
+var upgrader = websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+
+// gatewayConn is a long connection of a user, its writes are serialized by mu.
+type gatewayConn struct {
+	userID     string
+	platformID int32
+	token      string
+
+	mu         sync.Mutex
+	ws         *websocket.Conn
+	encoder    interaction.Encoder
+	compressor interaction.Compressor
+	closed     bool
+
+	// subscribed is the set of users whose online status is pushed to the connection.
+	subscribed map[string]struct{}
+}
+
+// write sends a frame to the connection, a failed connection is closed.
+func (c *gatewayConn) write(resp *interaction.GeneralWsResp) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return
+	}
+	data, err := c.encoder.Encode(resp)
+	if err == nil && c.compressor != nil {
+		data, err = c.compressor.Compress(data)
+	}
+	if err == nil {
+		_ = c.ws.SetWriteDeadline(time.Now().Add(writeTimeout))
+		err = c.ws.WriteMessage(websocket.BinaryMessage, data)
+	}
+	if err != nil {
+		c.closed = true
+		_ = c.ws.Close()
+	}
+}
+
+// push sends a server initiated frame of identifier, the SDK handles it in the context of its
+// operationID.
+func (c *gatewayConn) push(identifier int, data proto.Message) {
+	out, err := proto.Marshal(data)
+	if err != nil {
+		return
+	}
+	c.write(&interaction.GeneralWsResp{ReqIdentifier: identifier, OperationID: utils.OperationIDGenerator(), Data: out})
+}
+
+func (c *gatewayConn) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.closed {
+		c.closed = true
+		_ = c.ws.Close()
+	}
+}
+
+// serveGateway accepts a long connection of the SDK, the codec and the compression asked by the
+// query are confirmed by the response headers the same as the real gateway.
+func (s *Server) serveGateway(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	platformID, _ := strconv.Atoi(query.Get("platformID"))
+	s.mu.Lock()
+	t, ok := s.tokens[query.Get("token")]
+	var err error
+	switch {
+	case !ok:
+		err = errs.ErrTokenNotExist.WrapMsg("token not exist")
+	case t.kicked:
+		err = errs.ErrTokenKicked.WrapMsg("token kicked")
+	case t.userID != query.Get("sendID") || t.platformID != int32(platformID):
+		err = errs.ErrTokenInvalid.WrapMsg("token does not match sendID or platformID")
+	}
+	s.mu.Unlock()
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write(encodeResp(nil, err))
+		return
+	}
+	codec := query.Get("codec")
+	encoder, err := interaction.NewEncoder(codec)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var compressor interaction.Compressor
+	header := http.Header{}
+	if codec != "" {
+		header.Set("X-Ws-Codec", encoder.Name())
+	}
+	if compression := query.Get("compression"); compression != "" {
+		if compressor, err = interaction.NewCompressor(compression); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		header.Set("X-Ws-Compression", compressor.Name())
+	}
+	ws, err := upgrader.Upgrade(w, r, header)
+	if err != nil {
+		return
+	}
+	conn := &gatewayConn{userID: t.userID, platformID: t.platformID, token: query.Get("token"), ws: ws,
+		encoder: encoder, compressor: compressor, subscribed: make(map[string]struct{})}
+	s.addConn(conn)
+	defer s.removeConn(conn)
+	for {
+		messageType, message, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		if messageType != websocket.BinaryMessage {
+			continue
+		}
+		if compressor != nil && compressor.IsCompressed(message) {
+			if message, err = compressor.DeCompress(message); err != nil {
+				return
+			}
+		}
+		var req interaction.GeneralWsReq
+		if err := encoder.Decode(message, &req); err != nil {
+			return
+		}
+		conn.write(s.handleWs(conn, &req))
+		if req.ReqIdentifier == constant.LogoutMsg {
+			conn.close()
+			return
+		}
+	}
+}
+
+// addConn registers conn, the connection of the same platform it replaces is kicked.
+func (s *Server) addConn(conn *gatewayConn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	conns := s.conns[conn.userID][:0:0]
+	for _, old := range s.conns[conn.userID] {
+		if old.platformID != conn.platformID {
+			conns = append(conns, old)
+			continue
+		}
+		if old.token != conn.token {
+			if t, ok := s.tokens[old.token]; ok {
+				t.kicked = true
+			}
+		}
+		old.write(&interaction.GeneralWsResp{ReqIdentifier: constant.KickOnlineMsg})
+		old.close()
+	}
+	s.conns[conn.userID] = append(conns, conn)
+	s.onlineChanged(conn.userID)
+}
+
+func (s *Server) removeConn(conn *gatewayConn) {
+	conn.close()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	conns := s.conns[conn.userID]
+	for i := range conns {
+		if conns[i] == conn {
+			s.conns[conn.userID] = append(conns[:i:i], conns[i+1:]...)
+			s.onlineChanged(conn.userID)
+			break
+		}
+	}
+	if len(s.conns[conn.userID]) == 0 {
+		delete(s.conns, conn.userID)
+	}
+}
+
+// onlinePlatformIDs returns the platforms userID is connected on.
+func (s *Server) onlinePlatformIDs(userID string) []int32 {
+	var platformIDs []int32
+	for _, conn := range s.conns[userID] {
+		platformIDs = append(platformIDs, conn.platformID)
+	}
+	return platformIDs
+}
+
+// onlineChanged pushes the online status of userID to its subscribers.
+func (s *Server) onlineChanged(userID string) {
+	tips := &sdkws.SubUserOnlineStatusTips{Subscribers: []*sdkws.SubUserOnlineStatusElem{
+		{UserID: userID, OnlinePlatformIDs: s.onlinePlatformIDs(userID)},
+	}}
+	for _, conns := range s.conns {
+		for _, conn := range conns {
+			if _, ok := conn.subscribed[userID]; ok {
+				conn.push(constant.WsSubUserOnlineStatus, tips)
+			}
+		}
+	}
+}
+
+// handleWs answers a request of the long connection.
+func (s *Server) handleWs(conn *gatewayConn, req *interaction.GeneralWsReq) *interaction.GeneralWsResp {
+	resp := &interaction.GeneralWsResp{ReqIdentifier: req.ReqIdentifier, MsgIncr: req.MsgIncr, OperationID: req.OperationID}
+	// the response shares the state of the server, it is encoded before the unlock
+	s.mu.Lock()
+	data, err := s.handleWsReq(conn, req)
+	if err == nil && data != nil {
+		resp.Data, err = proto.Marshal(data)
+	}
+	s.mu.Unlock()
+	if err != nil {
+		resp.ErrCode, resp.ErrMsg = errs.ServerInternalError, err.Error()
+		if code, ok := errs.Unwrap(err).(errs.CodeError); ok {
+			resp.ErrCode, resp.ErrMsg = code.Code(), code.Msg()
+		}
+	}
+	return resp
+}
+
+func (s *Server) handleWsReq(conn *gatewayConn, req *interaction.GeneralWsReq) (proto.Message, error) {
+	if req.SendID != conn.userID {
+		return nil, errs.ErrArgs.WrapMsg("sendID does not match the connection")
+	}
+	c := &caller{userID: conn.userID, platformID: conn.platformID, operationID: req.OperationID}
+	switch req.ReqIdentifier {
+	case constant.GetNewestSeq:
+		return wsCall(req, func(r *sdkws.GetMaxSeqReq) (proto.Message, error) {
+			return s.getMaxSeq(c), nil
+		})
+	case constant.PullMsgByRange:
+		return wsCall(req, func(r *sdkws.PullMessageBySeqsReq) (proto.Message, error) {
+			return s.pullMsgByRange(c, r), nil
+		})
+	case constant.PullMsgBySeqList:
+		return wsCall(req, func(r *msg.GetSeqMessageReq) (proto.Message, error) {
+			return s.pullMsgBySeqs(c, r), nil
+		})
+	case constant.GetConvMaxReadSeq:
+		return wsCall(req, func(r *msg.GetConversationsHasReadAndMaxSeqReq) (proto.Message, error) {
+			return s.hasReadAndMaxSeqs(c.userID, r.ConversationIDs), nil
+		})
+	case constant.PullConvLastMessage:
+		return wsCall(req, func(r *msg.GetLastMessageReq) (proto.Message, error) {
+			return s.lastMsgs(c.userID, r.ConversationIDs), nil
+		})
+	case constant.SendMsg:
+		return wsCall(req, func(r *sdkws.MsgData) (proto.Message, error) {
+			return s.sendMsg(c, r)
+		})
+	case constant.SetBackgroundStatus:
+		return wsCall(req, func(r *sdkws.SetAppBackgroundStatusReq) (proto.Message, error) {
+			return &sdkws.SetAppBackgroundStatusResp{}, nil
+		})
+	case constant.LogoutMsg:
+		return wsCall(req, func(r *push.DelUserPushTokenReq) (proto.Message, error) {
+			return &push.DelUserPushTokenResp{}, nil
+		})
+	case constant.WsSubUserOnlineStatus:
+		return wsCall(req, func(r *sdkws.SubUserOnlineStatus) (proto.Message, error) {
+			for _, userID := range r.UnsubscribeUserID {
+				delete(conn.subscribed, userID)
+			}
+			tips := &sdkws.SubUserOnlineStatusTips{}
+			for _, userID := range r.SubscribeUserID {
+				conn.subscribed[userID] = struct{}{}
+				tips.Subscribers = append(tips.Subscribers,
+					&sdkws.SubUserOnlineStatusElem{UserID: userID, OnlinePlatformIDs: s.onlinePlatformIDs(userID)})
+			}
+			return tips, nil
+		})
+	default:
+		return nil, errs.ErrArgs.WrapMsg("unsupported reqIdentifier " + strconv.Itoa(req.ReqIdentifier))
+	}
+}
+
+// wsCall decodes the protobuf request of req and runs fn with it.
+func wsCall[Req any, P interface {
+	*Req
+	proto.Message
+}](req *interaction.GeneralWsReq, fn func(r P) (proto.Message, error)) (proto.Message, error) {
+	r := P(new(Req))
+	if err := proto.Unmarshal(req.Data, r); err != nil {
+		return nil, errs.ErrArgs.WrapMsg("invalid request: " + err.Error())
+	}
+	return fn(r)
+}
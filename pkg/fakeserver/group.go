@@ -0,0 +1,727 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeserver
+
+import (
+	"strings"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/api"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	pconstant "github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+)
+
+// group returns the groupID, a dismissed group is still returned.
+func (s *Server) group(groupID string) (*sdkws.GroupInfo, error) {
+	g, ok := s.groups[groupID]
+	if !ok {
+		return nil, errs.ErrRecordNotFound.WrapMsg("group not found", "groupID", groupID)
+	}
+	return g, nil
+}
+
+// activeGroup returns the groupID unless it is dismissed.
+func (s *Server) activeGroup(groupID string) (*sdkws.GroupInfo, error) {
+	g, err := s.group(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if g.Status == constant.GroupStatusDismissed {
+		return nil, errs.ErrRecordNotFound.WrapMsg("group dismissed", "groupID", groupID)
+	}
+	return g, nil
+}
+
+func (s *Server) groupMemberList(groupID string) *versionList[*sdkws.GroupMemberFullInfo] {
+	l, ok := s.groupMembers[groupID]
+	if !ok {
+		l = newVersionList[*sdkws.GroupMemberFullInfo]()
+		s.groupMembers[groupID] = l
+	}
+	return l
+}
+
+func (s *Server) groupMember(groupID, userID string) (*sdkws.GroupMemberFullInfo, bool) {
+	return s.groupMemberList(groupID).get(userID)
+}
+
+func (s *Server) groupMemberIDs(groupID string) []string {
+	return s.groupMemberList(groupID).idList()
+}
+
+func (s *Server) userJoinedGroups(userID string) *versionList[*sdkws.GroupInfo] {
+	l, ok := s.joinedGroups[userID]
+	if !ok {
+		l = newVersionList[*sdkws.GroupInfo]()
+		s.joinedGroups[userID] = l
+	}
+	return l
+}
+
+// opUser returns the member info of the operator c, the admin out of the group gets a stand-in.
+func (s *Server) opUser(groupID string, c *caller) *sdkws.GroupMemberFullInfo {
+	if member, ok := s.groupMember(groupID, c.userID); ok {
+		return member
+	}
+	user := s.publicUser(c.userID)
+	return &sdkws.GroupMemberFullInfo{GroupID: groupID, UserID: c.userID, Nickname: user.Nickname, FaceURL: user.FaceURL,
+		AppMangerLevel: pconstant.AppAdmin}
+}
+
+// checkGroupAdmin returns ErrNoPermission unless c is the owner or an admin of groupID, or the admin.
+func (s *Server) checkGroupAdmin(c *caller, groupID string) error {
+	if c.isAdmin() {
+		return nil
+	}
+	if member, ok := s.groupMember(groupID, c.userID); ok && member.RoleLevel >= constant.GroupAdmin {
+		return nil
+	}
+	return errs.ErrNoPermission.WrapMsg("not the owner or an admin of group " + groupID)
+}
+
+// groupAdminIDs returns the owner and the admins of groupID.
+func (s *Server) groupAdminIDs(groupID string) []string {
+	var userIDs []string
+	for _, member := range s.groupMemberList(groupID).list() {
+		if member.RoleLevel >= constant.GroupAdmin {
+			userIDs = append(userIDs, member.UserID)
+		}
+	}
+	return userIDs
+}
+
+// addGroupMember makes userID a member of g, its messages are visible from now on.
+func (s *Server) addGroupMember(g *sdkws.GroupInfo, userID string, roleLevel, joinSource int32, operatorUserID, inviterUserID string) *sdkws.GroupMemberFullInfo {
+	user := s.users[userID]
+	member := &sdkws.GroupMemberFullInfo{
+		GroupID:        g.GroupID,
+		UserID:         userID,
+		RoleLevel:      roleLevel,
+		JoinTime:       now(),
+		Nickname:       user.Nickname,
+		FaceURL:        user.FaceURL,
+		JoinSource:     joinSource,
+		OperatorUserID: operatorUserID,
+		InviterUserID:  inviterUserID,
+	}
+	s.groupMemberList(g.GroupID).set(userID, member)
+	g.MemberCount++
+	s.groupChanged(g)
+	s.userJoinedGroups(userID).set(g.GroupID, g)
+	s.joinConversation(userID, groupConversationID(g.GroupID))
+	s.joinConversation(userID, "n_"+g.GroupID)
+	s.ensureConversation(userID, groupConversationID(g.GroupID), constant.ReadGroupChatType, "", g.GroupID)
+	return member
+}
+
+// removeGroupMember removes userID from g, the notification of the removal is sent with notifyGroup
+// to the removed users so they leave the conversations of the group.
+func (s *Server) removeGroupMember(g *sdkws.GroupInfo, userID string) {
+	if !s.groupMemberList(g.GroupID).delete(userID) {
+		return
+	}
+	g.MemberCount--
+	s.userJoinedGroups(userID).delete(g.GroupID)
+	s.groupChanged(g)
+	s.leaveConversation(userID, groupConversationID(g.GroupID))
+}
+
+// groupChanged logs the change of the info of g in the joined group lists of its members.
+func (s *Server) groupChanged(g *sdkws.GroupInfo) {
+	for _, userID := range s.groupMemberIDs(g.GroupID) {
+		s.userJoinedGroups(userID).touch(g.GroupID)
+	}
+}
+
+// groupRequest returns the pending request of userID to join groupID.
+func (s *Server) groupRequest(groupID, userID string) (*sdkws.GroupRequest, int) {
+	for i, req := range s.groupRequests {
+		if req.GroupInfo.GroupID == groupID && req.UserInfo.UserID == userID {
+			return req, i
+		}
+	}
+	return nil, -1
+}
+
+// groupRequestsOf returns the requests selected by fn, the latest first.
+func (s *Server) groupRequestsOf(fn func(req *sdkws.GroupRequest) bool) []*sdkws.GroupRequest {
+	var requests []*sdkws.GroupRequest
+	for i := len(s.groupRequests) - 1; i >= 0; i-- {
+		if fn(s.groupRequests[i]) {
+			requests = append(requests, s.groupRequests[i])
+		}
+	}
+	return requests
+}
+
+// filterMember reports whether member is selected by filter of get_group_member_list.
+func filterMember(member *sdkws.GroupMemberFullInfo, filter int32, keyword string) bool {
+	if keyword != "" && !strings.Contains(member.Nickname, keyword) && !strings.Contains(member.UserID, keyword) {
+		return false
+	}
+	switch filter {
+	case constant.GroupFilterOwner:
+		return member.RoleLevel == constant.GroupOwner
+	case constant.GroupFilterAdmin:
+		return member.RoleLevel == constant.GroupAdmin
+	case constant.GroupFilterOrdinaryUsers:
+		return member.RoleLevel == constant.GroupOrdinaryUsers
+	case constant.GroupFilterAdminAndOrdinaryUsers:
+		return member.RoleLevel != constant.GroupOwner
+	case constant.GroupFilterOwnerAndAdmin:
+		return member.RoleLevel != constant.GroupOrdinaryUsers
+	default:
+		return true
+	}
+}
+
+func (s *Server) registerGroup() {
+	handle(s, api.CreateGroup, false, func(c *caller, req *group.CreateGroupReq) (*group.CreateGroupResp, error) {
+		if req.GroupInfo == nil {
+			return nil, errs.ErrArgs.WrapMsg("groupInfo is empty")
+		}
+		if err := c.check(req.OwnerUserID); err != nil {
+			return nil, err
+		}
+		userIDs := append(append([]string{req.OwnerUserID}, req.AdminUserIDs...), req.MemberUserIDs...)
+		seen := make(map[string]struct{})
+		for _, userID := range userIDs {
+			if _, err := s.user(userID); err != nil {
+				return nil, err
+			}
+			if _, ok := seen[userID]; ok {
+				return nil, errs.ErrArgs.WrapMsg("duplicate member", "userID", userID)
+			}
+			seen[userID] = struct{}{}
+		}
+		g := copyMsg(req.GroupInfo)
+		if g.GroupID == "" {
+			g.GroupID = utils.OperationIDGenerator()
+		}
+		if _, ok := s.groups[g.GroupID]; ok {
+			return nil, errs.ErrDuplicateKey.WrapMsg("group exists already", "groupID", g.GroupID)
+		}
+		g.OwnerUserID, g.CreatorUserID = req.OwnerUserID, c.userID
+		g.CreateTime, g.Status, g.MemberCount = now(), constant.GroupOk, 0
+		s.groups[g.GroupID] = g
+		tips := &sdkws.GroupCreatedTips{Group: g, OperationTime: now()}
+		for i, userID := range userIDs {
+			roleLevel := int32(constant.GroupOrdinaryUsers)
+			switch {
+			case i == 0:
+				roleLevel = constant.GroupOwner
+			case i <= len(req.AdminUserIDs):
+				roleLevel = constant.GroupAdmin
+			}
+			member := s.addGroupMember(g, userID, roleLevel, pconstant.JoinByInvitation, c.userID, c.userID)
+			tips.MemberList = append(tips.MemberList, member)
+		}
+		members := s.groupMemberList(g.GroupID)
+		tips.OpUser, tips.GroupOwnerUser = s.opUser(g.GroupID, c), tips.MemberList[0]
+		tips.GroupMemberVersion, tips.GroupMemberVersionID = members.version, members.versionID
+		s.notifyGroup(g.GroupID, c.userID, constant.GroupCreatedNotification, tips)
+		return &group.CreateGroupResp{GroupInfo: g}, nil
+	})
+	handle(s, api.SetGroupInfoEx, false, func(c *caller, req *group.SetGroupInfoExReq) (*group.SetGroupInfoExResp, error) {
+		g, err := s.activeGroup(req.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkGroupAdmin(c, req.GroupID); err != nil {
+			return nil, err
+		}
+		if req.GroupName != nil {
+			g.GroupName = req.GroupName.Value
+		}
+		if req.Notification != nil {
+			g.Notification = req.Notification.Value
+			g.NotificationUpdateTime, g.NotificationUserID = now(), c.userID
+		}
+		if req.Introduction != nil {
+			g.Introduction = req.Introduction.Value
+		}
+		if req.FaceURL != nil {
+			g.FaceURL = req.FaceURL.Value
+		}
+		if req.Ex != nil {
+			g.Ex = req.Ex.Value
+		}
+		if req.NeedVerification != nil {
+			g.NeedVerification = req.NeedVerification.Value
+		}
+		if req.LookMemberInfo != nil {
+			g.LookMemberInfo = req.LookMemberInfo.Value
+		}
+		if req.ApplyMemberFriend != nil {
+			g.ApplyMemberFriend = req.ApplyMemberFriend.Value
+		}
+		members := s.groupMemberList(g.GroupID)
+		members.bump()
+		s.groupChanged(g)
+		opUser := s.opUser(g.GroupID, c)
+		others := req.Introduction != nil || req.FaceURL != nil || req.Ex != nil || req.NeedVerification != nil ||
+			req.LookMemberInfo != nil || req.ApplyMemberFriend != nil
+		switch {
+		case req.Notification != nil && req.GroupName == nil && !others:
+			s.notifyGroup(g.GroupID, c.userID, constant.GroupInfoSetAnnouncementNotification, &sdkws.GroupInfoSetAnnouncementTips{
+				OpUser: opUser, Group: g, GroupMemberVersion: members.version, GroupMemberVersionID: members.versionID,
+			})
+		case req.GroupName != nil && req.Notification == nil && !others:
+			s.notifyGroup(g.GroupID, c.userID, constant.GroupInfoSetNameNotification, &sdkws.GroupInfoSetNameTips{
+				OpUser: opUser, Group: g, GroupMemberVersion: members.version, GroupMemberVersionID: members.versionID,
+			})
+		default:
+			s.notifyGroup(g.GroupID, c.userID, constant.GroupInfoSetNotification, &sdkws.GroupInfoSetTips{
+				OpUser: opUser, Group: g, GroupMemberVersion: members.version, GroupMemberVersionID: members.versionID,
+			})
+		}
+		return &group.SetGroupInfoExResp{}, nil
+	})
+	handle(s, api.JoinGroup, false, func(c *caller, req *group.JoinGroupReq) (*group.JoinGroupResp, error) {
+		g, err := s.activeGroup(req.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := s.groupMember(req.GroupID, c.userID); ok {
+			return nil, errs.ErrArgs.WrapMsg("already in group " + req.GroupID)
+		}
+		if g.NeedVerification == pconstant.Directly {
+			member := s.addGroupMember(g, c.userID, constant.GroupOrdinaryUsers, req.JoinSource, c.userID, req.InviterUserID)
+			members := s.groupMemberList(g.GroupID)
+			s.notifyGroup(g.GroupID, c.userID, constant.MemberEnterNotification, &sdkws.MemberEnterTips{
+				Group: g, EntrantUser: member, OperationTime: now(),
+				GroupMemberVersion: members.version, GroupMemberVersionID: members.versionID,
+			})
+			return &group.JoinGroupResp{}, nil
+		}
+		if _, i := s.groupRequest(req.GroupID, c.userID); i >= 0 {
+			s.groupRequests = append(s.groupRequests[:i], s.groupRequests[i+1:]...)
+		}
+		s.groupRequests = append(s.groupRequests, &sdkws.GroupRequest{
+			UserInfo:      s.publicUser(c.userID),
+			GroupInfo:     g,
+			ReqMsg:        req.ReqMessage,
+			ReqTime:       now(),
+			Ex:            req.Ex,
+			JoinSource:    req.JoinSource,
+			InviterUserID: req.InviterUserID,
+		})
+		tips := &sdkws.JoinGroupApplicationTips{Group: g, Applicant: s.publicUser(c.userID), ReqMsg: req.ReqMessage}
+		for _, adminID := range s.groupAdminIDs(g.GroupID) {
+			s.notify(c.userID, adminID, constant.JoinGroupApplicationNotification, tips)
+		}
+		return &group.JoinGroupResp{}, nil
+	})
+	handle(s, api.AcceptGroupApplication, false, func(c *caller, req *group.GroupApplicationResponseReq) (*group.GroupApplicationResponseResp, error) {
+		g, err := s.activeGroup(req.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkGroupAdmin(c, req.GroupID); err != nil {
+			return nil, err
+		}
+		request, i := s.groupRequest(req.GroupID, req.FromUserID)
+		if i < 0 {
+			return nil, errs.ErrRecordNotFound.WrapMsg("group request not found", "fromUserID", req.FromUserID)
+		}
+		if request.HandleResult != 0 {
+			return nil, errs.ErrArgs.WrapMsg("group request handled already")
+		}
+		request.HandleResult, request.HandleMsg = req.HandleResult, req.HandledMsg
+		request.HandleUserID, request.HandleTime = c.userID, now()
+		opUser := s.opUser(g.GroupID, c)
+		switch req.HandleResult {
+		case constant.GroupResponseAgree:
+			s.notify(c.userID, req.FromUserID, constant.GroupApplicationAcceptedNotification, &sdkws.GroupApplicationAcceptedTips{
+				Group: g, OpUser: opUser, HandleMsg: req.HandledMsg, ReceiverAs: constant.ApplicantReceiver,
+			})
+			for _, adminID := range s.groupAdminIDs(g.GroupID) {
+				s.notify(c.userID, adminID, constant.GroupApplicationAcceptedNotification, &sdkws.GroupApplicationAcceptedTips{
+					Group: g, OpUser: opUser, HandleMsg: req.HandledMsg, ReceiverAs: constant.AdminReceiver,
+				})
+			}
+			if _, ok := s.groupMember(g.GroupID, req.FromUserID); ok {
+				break
+			}
+			member := s.addGroupMember(g, req.FromUserID, constant.GroupOrdinaryUsers, request.JoinSource, c.userID, request.InviterUserID)
+			members := s.groupMemberList(g.GroupID)
+			s.notifyGroup(g.GroupID, c.userID, constant.MemberEnterNotification, &sdkws.MemberEnterTips{
+				Group: g, EntrantUser: member, OperationTime: now(),
+				GroupMemberVersion: members.version, GroupMemberVersionID: members.versionID,
+			})
+		case constant.GroupResponseRefuse:
+			s.notify(c.userID, req.FromUserID, constant.GroupApplicationRejectedNotification, &sdkws.GroupApplicationRejectedTips{
+				Group: g, OpUser: opUser, HandleMsg: req.HandledMsg, ReceiverAs: constant.ApplicantReceiver,
+			})
+			for _, adminID := range s.groupAdminIDs(g.GroupID) {
+				s.notify(c.userID, adminID, constant.GroupApplicationRejectedNotification, &sdkws.GroupApplicationRejectedTips{
+					Group: g, OpUser: opUser, HandleMsg: req.HandledMsg, ReceiverAs: constant.AdminReceiver,
+				})
+			}
+		default:
+			return nil, errs.ErrArgs.WrapMsg("invalid handleResult")
+		}
+		return &group.GroupApplicationResponseResp{}, nil
+	})
+	handle(s, api.QuitGroup, false, func(c *caller, req *group.QuitGroupReq) (*group.QuitGroupResp, error) {
+		if req.UserID == "" {
+			req.UserID = c.userID
+		}
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		g, err := s.activeGroup(req.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		member, ok := s.groupMember(req.GroupID, req.UserID)
+		if !ok {
+			return nil, errs.ErrRecordNotFound.WrapMsg("not in group " + req.GroupID)
+		}
+		if member.RoleLevel == constant.GroupOwner {
+			return nil, errs.ErrNoPermission.WrapMsg("the owner can not quit the group")
+		}
+		s.removeGroupMember(g, req.UserID)
+		members := s.groupMemberList(g.GroupID)
+		s.notifyGroup(g.GroupID, req.UserID, constant.MemberQuitNotification, &sdkws.MemberQuitTips{
+			Group: g, QuitUser: member, OperationTime: now(),
+			GroupMemberVersion: members.version, GroupMemberVersionID: members.versionID,
+		}, req.UserID)
+		return &group.QuitGroupResp{}, nil
+	})
+	handle(s, api.GetGroupsInfo, false, func(c *caller, req *group.GetGroupsInfoReq) (*group.GetGroupsInfoResp, error) {
+		resp := &group.GetGroupsInfoResp{}
+		for _, groupID := range req.GroupIDs {
+			if g, ok := s.groups[groupID]; ok {
+				resp.GroupInfos = append(resp.GroupInfos, g)
+			}
+		}
+		return resp, nil
+	})
+	handle(s, api.GetGroupMemberList, false, func(c *caller, req *group.GetGroupMemberListReq) (*group.GetGroupMemberListResp, error) {
+		if _, err := s.group(req.GroupID); err != nil {
+			return nil, err
+		}
+		var members []*sdkws.GroupMemberFullInfo
+		for _, member := range s.groupMemberList(req.GroupID).list() {
+			if filterMember(member, req.Filter, req.Keyword) {
+				members = append(members, member)
+			}
+		}
+		return &group.GetGroupMemberListResp{Total: uint32(len(members)), Members: page(members, req.Pagination)}, nil
+	})
+	handle(s, api.GetGroupMembersInfo, false, func(c *caller, req *group.GetGroupMembersInfoReq) (*group.GetGroupMembersInfoResp, error) {
+		resp := &group.GetGroupMembersInfoResp{}
+		for _, userID := range req.UserIDs {
+			if member, ok := s.groupMember(req.GroupID, userID); ok {
+				resp.Members = append(resp.Members, member)
+			}
+		}
+		return resp, nil
+	})
+	handle(s, api.InviteUserToGroup, false, func(c *caller, req *group.InviteUserToGroupReq) (*group.InviteUserToGroupResp, error) {
+		g, err := s.activeGroup(req.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := s.groupMember(req.GroupID, c.userID); !ok && !c.isAdmin() {
+			return nil, errs.ErrNoPermission.WrapMsg("not in group " + req.GroupID)
+		}
+		for _, userID := range req.InvitedUserIDs {
+			if _, err := s.user(userID); err != nil {
+				return nil, err
+			}
+			if _, ok := s.groupMember(req.GroupID, userID); ok {
+				return nil, errs.ErrArgs.WrapMsg("already in group", "userID", userID)
+			}
+		}
+		tips := &sdkws.MemberInvitedTips{Group: g, OpUser: s.opUser(g.GroupID, c), OperationTime: now()}
+		for _, userID := range req.InvitedUserIDs {
+			member := s.addGroupMember(g, userID, constant.GroupOrdinaryUsers, pconstant.JoinByInvitation, c.userID, c.userID)
+			tips.InvitedUserList = append(tips.InvitedUserList, member)
+		}
+		members := s.groupMemberList(g.GroupID)
+		tips.GroupMemberVersion, tips.GroupMemberVersionID, tips.InviterUser = members.version, members.versionID, tips.OpUser
+		s.notifyGroup(g.GroupID, c.userID, constant.MemberInvitedNotification, tips)
+		return &group.InviteUserToGroupResp{}, nil
+	})
+	handle(s, api.GetJoinedGroupList, false, func(c *caller, req *group.GetJoinedGroupListReq) (*group.GetJoinedGroupListResp, error) {
+		if err := c.check(req.FromUserID); err != nil {
+			return nil, err
+		}
+		groups := s.userJoinedGroups(req.FromUserID).list()
+		return &group.GetJoinedGroupListResp{Total: uint32(len(groups)), Groups: page(groups, req.Pagination)}, nil
+	})
+	handle(s, api.KickGroupMember, false, func(c *caller, req *group.KickGroupMemberReq) (*group.KickGroupMemberResp, error) {
+		g, err := s.activeGroup(req.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkGroupAdmin(c, req.GroupID); err != nil {
+			return nil, err
+		}
+		opUser := s.opUser(g.GroupID, c)
+		var kicked []*sdkws.GroupMemberFullInfo
+		for _, userID := range req.KickedUserIDs {
+			member, ok := s.groupMember(req.GroupID, userID)
+			if !ok {
+				return nil, errs.ErrRecordNotFound.WrapMsg("not in group", "userID", userID)
+			}
+			if member.RoleLevel == constant.GroupOwner || (member.RoleLevel == constant.GroupAdmin && opUser.RoleLevel != constant.GroupOwner && !c.isAdmin()) {
+				return nil, errs.ErrNoPermission.WrapMsg("can not kick", "userID", userID)
+			}
+			kicked = append(kicked, member)
+		}
+		for _, userID := range req.KickedUserIDs {
+			s.removeGroupMember(g, userID)
+		}
+		members := s.groupMemberList(g.GroupID)
+		s.notifyGroup(g.GroupID, c.userID, constant.MemberKickedNotification, &sdkws.MemberKickedTips{
+			Group: g, OpUser: opUser, KickedUserList: kicked, OperationTime: now(),
+			GroupMemberVersion: members.version, GroupMemberVersionID: members.versionID,
+		}, req.KickedUserIDs...)
+		return &group.KickGroupMemberResp{}, nil
+	})
+	handle(s, api.TransferGroup, false, func(c *caller, req *group.TransferGroupOwnerReq) (*group.TransferGroupOwnerResp, error) {
+		g, err := s.activeGroup(req.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.check(req.OldOwnerUserID); err != nil {
+			return nil, err
+		}
+		if g.OwnerUserID != req.OldOwnerUserID {
+			return nil, errs.ErrNoPermission.WrapMsg("not the owner of group " + req.GroupID)
+		}
+		oldOwner, _ := s.groupMember(req.GroupID, req.OldOwnerUserID)
+		newOwner, ok := s.groupMember(req.GroupID, req.NewOwnerUserID)
+		if !ok {
+			return nil, errs.ErrRecordNotFound.WrapMsg("not in group", "userID", req.NewOwnerUserID)
+		}
+		oldOwner.RoleLevel, newOwner.RoleLevel, g.OwnerUserID = constant.GroupOrdinaryUsers, constant.GroupOwner, req.NewOwnerUserID
+		members := s.groupMemberList(g.GroupID)
+		members.touch(req.OldOwnerUserID)
+		members.touch(req.NewOwnerUserID)
+		s.groupChanged(g)
+		s.notifyGroup(g.GroupID, c.userID, constant.GroupOwnerTransferredNotification, &sdkws.GroupOwnerTransferredTips{
+			Group: g, OpUser: s.opUser(g.GroupID, c), NewGroupOwner: newOwner, OldGroupOwner: req.OldOwnerUserID,
+			OperationTime: now(), OldGroupOwnerInfo: oldOwner,
+			GroupMemberVersion: members.version, GroupMemberVersionID: members.versionID,
+		})
+		return &group.TransferGroupOwnerResp{}, nil
+	})
+	handle(s, api.GetRecvGroupApplicationList, false, func(c *caller, req *group.GetGroupApplicationListReq) (*group.GetGroupApplicationListResp, error) {
+		if err := c.check(req.FromUserID); err != nil {
+			return nil, err
+		}
+		requests := s.groupRequestsOf(func(r *sdkws.GroupRequest) bool {
+			member, ok := s.groupMember(r.GroupInfo.GroupID, req.FromUserID)
+			return ok && member.RoleLevel >= constant.GroupAdmin
+		})
+		return &group.GetGroupApplicationListResp{Total: uint32(len(requests)), GroupRequests: page(requests, req.Pagination)}, nil
+	})
+	handle(s, api.GetSendGroupApplicationList, false, func(c *caller, req *group.GetUserReqApplicationListReq) (*group.GetUserReqApplicationListResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		requests := s.groupRequestsOf(func(r *sdkws.GroupRequest) bool { return r.UserInfo.UserID == req.UserID })
+		return &group.GetUserReqApplicationListResp{Total: uint32(len(requests)), GroupRequests: page(requests, req.Pagination)}, nil
+	})
+	handle(s, api.DismissGroup, false, func(c *caller, req *group.DismissGroupReq) (*group.DismissGroupResp, error) {
+		g, err := s.activeGroup(req.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		if g.OwnerUserID != c.userID && !c.isAdmin() {
+			return nil, errs.ErrNoPermission.WrapMsg("not the owner of group " + req.GroupID)
+		}
+		opUser := s.opUser(g.GroupID, c)
+		g.Status = constant.GroupStatusDismissed
+		userIDs := s.groupMemberIDs(g.GroupID)
+		for _, userID := range userIDs {
+			s.removeGroupMember(g, userID)
+		}
+		s.notifyGroup(g.GroupID, c.userID, constant.GroupDismissedNotification,
+			&sdkws.GroupDismissedTips{Group: g, OpUser: opUser, OperationTime: now()}, userIDs...)
+		return &group.DismissGroupResp{}, nil
+	})
+	muteMember := func(c *caller, groupID, userID string, mutedSeconds uint32) error {
+		g, err := s.activeGroup(groupID)
+		if err != nil {
+			return err
+		}
+		if err := s.checkGroupAdmin(c, groupID); err != nil {
+			return err
+		}
+		member, ok := s.groupMember(groupID, userID)
+		if !ok {
+			return errs.ErrRecordNotFound.WrapMsg("not in group", "userID", userID)
+		}
+		members := s.groupMemberList(groupID)
+		opUser := s.opUser(groupID, c)
+		if mutedSeconds == 0 {
+			member.MuteEndTime = 0
+			members.touch(userID)
+			s.notifyGroup(groupID, c.userID, constant.GroupMemberCancelMutedNotification, &sdkws.GroupMemberCancelMutedTips{
+				Group: g, OpUser: opUser, OperationTime: now(), MutedUser: member,
+				GroupMemberVersion: members.version, GroupMemberVersionID: members.versionID,
+			})
+			return nil
+		}
+		member.MuteEndTime = now() + int64(mutedSeconds)*1000
+		members.touch(userID)
+		s.notifyGroup(groupID, c.userID, constant.GroupMemberMutedNotification, &sdkws.GroupMemberMutedTips{
+			Group: g, OpUser: opUser, OperationTime: now(), MutedUser: member, MutedSeconds: mutedSeconds,
+			GroupMemberVersion: members.version, GroupMemberVersionID: members.versionID,
+		})
+		return nil
+	}
+	handle(s, api.MuteGroupMember, false, func(c *caller, req *group.MuteGroupMemberReq) (*group.MuteGroupMemberResp, error) {
+		if req.MutedSeconds == 0 {
+			return nil, errs.ErrArgs.WrapMsg("mutedSeconds is 0")
+		}
+		return &group.MuteGroupMemberResp{}, muteMember(c, req.GroupID, req.UserID, req.MutedSeconds)
+	})
+	handle(s, api.CancelMuteGroupMember, false, func(c *caller, req *group.CancelMuteGroupMemberReq) (*group.CancelMuteGroupMemberResp, error) {
+		return &group.CancelMuteGroupMemberResp{}, muteMember(c, req.GroupID, req.UserID, 0)
+	})
+	muteGroup := func(c *caller, groupID string, muted bool) error {
+		g, err := s.activeGroup(groupID)
+		if err != nil {
+			return err
+		}
+		if err := s.checkGroupAdmin(c, groupID); err != nil {
+			return err
+		}
+		members := s.groupMemberList(groupID)
+		members.bump()
+		s.groupChanged(g)
+		opUser := s.opUser(groupID, c)
+		if muted {
+			g.Status = constant.GroupStatusMuted
+			s.notifyGroup(groupID, c.userID, constant.GroupMutedNotification, &sdkws.GroupMutedTips{
+				Group: g, OpUser: opUser, OperationTime: now(),
+				GroupMemberVersion: members.version, GroupMemberVersionID: members.versionID,
+			})
+			return nil
+		}
+		g.Status = constant.GroupOk
+		s.notifyGroup(groupID, c.userID, constant.GroupCancelMutedNotification, &sdkws.GroupCancelMutedTips{
+			Group: g, OpUser: opUser, OperationTime: now(),
+			GroupMemberVersion: members.version, GroupMemberVersionID: members.versionID,
+		})
+		return nil
+	}
+	handle(s, api.MuteGroup, false, func(c *caller, req *group.MuteGroupReq) (*group.MuteGroupResp, error) {
+		return &group.MuteGroupResp{}, muteGroup(c, req.GroupID, true)
+	})
+	handle(s, api.CancelMuteGroup, false, func(c *caller, req *group.CancelMuteGroupReq) (*group.CancelMuteGroupResp, error) {
+		return &group.CancelMuteGroupResp{}, muteGroup(c, req.GroupID, false)
+	})
+	handle(s, api.SetGroupMemberInfo, false, func(c *caller, req *group.SetGroupMemberInfoReq) (*group.SetGroupMemberInfoResp, error) {
+		for _, m := range req.Members {
+			if _, err := s.activeGroup(m.GroupID); err != nil {
+				return nil, err
+			}
+			member, ok := s.groupMember(m.GroupID, m.UserID)
+			if !ok {
+				return nil, errs.ErrRecordNotFound.WrapMsg("not in group", "userID", m.UserID)
+			}
+			if m.RoleLevel != nil {
+				if m.RoleLevel.Value != constant.GroupAdmin && m.RoleLevel.Value != constant.GroupOrdinaryUsers {
+					return nil, errs.ErrArgs.WrapMsg("invalid roleLevel")
+				}
+				if member.RoleLevel == constant.GroupOwner {
+					return nil, errs.ErrNoPermission.WrapMsg("can not change the role of the owner")
+				}
+				if owner, _ := s.groupMember(m.GroupID, c.userID); !c.isAdmin() && (owner == nil || owner.RoleLevel != constant.GroupOwner) {
+					return nil, errs.ErrNoPermission.WrapMsg("only the owner sets the role")
+				}
+			} else if c.userID != m.UserID {
+				if err := s.checkGroupAdmin(c, m.GroupID); err != nil {
+					return nil, err
+				}
+			}
+		}
+		for _, m := range req.Members {
+			g := s.groups[m.GroupID]
+			member, _ := s.groupMember(m.GroupID, m.UserID)
+			contentType := int32(constant.GroupMemberInfoSetNotification)
+			if m.Nickname != nil {
+				member.Nickname = m.Nickname.Value
+			}
+			if m.FaceURL != nil {
+				member.FaceURL = m.FaceURL.Value
+			}
+			if m.Ex != nil {
+				member.Ex = m.Ex.Value
+			}
+			if m.RoleLevel != nil && m.RoleLevel.Value != member.RoleLevel {
+				member.RoleLevel = m.RoleLevel.Value
+				contentType = constant.GroupMemberSetToOrdinaryUserNotification
+				if member.RoleLevel == constant.GroupAdmin {
+					contentType = constant.GroupMemberSetToAdminNotification
+				}
+			}
+			members := s.groupMemberList(m.GroupID)
+			members.touch(m.UserID)
+			s.notifyGroup(m.GroupID, c.userID, contentType, &sdkws.GroupMemberInfoSetTips{
+				Group: g, OpUser: s.opUser(m.GroupID, c), OperationTime: now(), ChangedUser: member,
+				GroupMemberVersion: members.version, GroupMemberVersionID: members.versionID,
+			})
+		}
+		return &group.SetGroupMemberInfoResp{}, nil
+	})
+	handle(s, api.GetIncrementalJoinGroup, false, func(c *caller, req *group.GetIncrementalJoinGroupReq) (*group.GetIncrementalJoinGroupResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		l := s.userJoinedGroups(req.UserID)
+		resp := &group.GetIncrementalJoinGroupResp{VersionID: l.versionID, Version: l.version}
+		resp.Full, resp.Insert, resp.Update, resp.Delete = l.changes(req.VersionID, req.Version)
+		return resp, nil
+	})
+	handle(s, api.GetIncrementalGroupMemberBatch, false, func(c *caller, req *group.BatchGetIncrementalGroupMemberReq) (*group.BatchGetIncrementalGroupMemberResp, error) {
+		resp := &group.BatchGetIncrementalGroupMemberResp{RespList: make(map[string]*group.GetIncrementalGroupMemberResp)}
+		for _, r := range req.ReqList {
+			g, err := s.group(r.GroupID)
+			if err != nil {
+				return nil, err
+			}
+			l := s.groupMemberList(r.GroupID)
+			groupResp := &group.GetIncrementalGroupMemberResp{VersionID: l.versionID, Version: l.version, Group: g}
+			groupResp.Full, groupResp.Insert, groupResp.Update, groupResp.Delete = l.changes(r.VersionID, r.Version)
+			resp.RespList[r.GroupID] = groupResp
+		}
+		return resp, nil
+	})
+	handle(s, api.GetFullJoinedGroupIDs, false, func(c *caller, req *group.GetFullJoinGroupIDsReq) (*group.GetFullJoinGroupIDsResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		l := s.userJoinedGroups(req.UserID)
+		return &group.GetFullJoinGroupIDsResp{VersionID: l.versionID, Version: l.version, GroupIDs: l.idList()}, nil
+	})
+	handle(s, api.GetFullGroupMemberUserIDs, false, func(c *caller, req *group.GetFullGroupMemberUserIDsReq) (*group.GetFullGroupMemberUserIDsResp, error) {
+		if _, err := s.group(req.GroupID); err != nil {
+			return nil, err
+		}
+		l := s.groupMemberList(req.GroupID)
+		return &group.GetFullGroupMemberUserIDsResp{VersionID: l.versionID, Version: l.version, UserIDs: l.idList()}, nil
+	})
+}
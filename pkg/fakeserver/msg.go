@@ -0,0 +1,669 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeserver
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/api"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/server_api_params"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/protocol/msg"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+)
+
+// errBlockedByPeer is the error of the server for a message to a user who blocked the sender.
+var errBlockedByPeer = errs.NewCodeError(1302, "BlockedByPeer")
+
+// conversationMsgs is the message list of a conversation, the message of seq is msgs[seq-1].
+type conversationMsgs struct {
+	msgs []*sdkws.MsgData
+}
+
+func (m *conversationMsgs) maxSeq() int64 {
+	return int64(len(m.msgs))
+}
+
+func (m *conversationMsgs) get(seq int64) *sdkws.MsgData {
+	if seq < 1 || seq > m.maxSeq() {
+		return nil
+	}
+	return m.msgs[seq-1]
+}
+
+// userConv is the state of a conversation for one of its users.
+type userConv struct {
+	// minSeq is the first seq the user can pull, set when joining a group or clearing the messages.
+	minSeq int64
+	// maxSeq caps the seqs of a user who left the conversation, 0 when unset.
+	maxSeq     int64
+	hasReadSeq int64
+	deleted    map[int64]struct{}
+}
+
+func singleConversationID(sendID, recvID string) string {
+	ids := []string{sendID, recvID}
+	sort.Strings(ids)
+	return "si_" + strings.Join(ids, "_")
+}
+
+func groupConversationID(groupID string) string {
+	return "sg_" + groupID
+}
+
+// notificationConversationID returns the conversation of the notifications of m.
+func notificationConversationID(m *sdkws.MsgData) string {
+	if m.SessionType == constant.ReadGroupChatType {
+		return "n_" + m.GroupID
+	}
+	ids := []string{m.SendID, m.RecvID}
+	sort.Strings(ids)
+	return "n_" + strings.Join(ids, "_")
+}
+
+func isNotification(conversationID string) bool {
+	return strings.HasPrefix(conversationID, "n_")
+}
+
+func (s *Server) conversationMsgs(conversationID string) *conversationMsgs {
+	m, ok := s.msgs[conversationID]
+	if !ok {
+		m = &conversationMsgs{}
+		s.msgs[conversationID] = m
+	}
+	return m
+}
+
+// userConv returns the state of conversationID for userID, it is created when missing.
+func (s *Server) userConv(userID, conversationID string) *userConv {
+	convs, ok := s.userConvs[userID]
+	if !ok {
+		convs = make(map[string]*userConv)
+		s.userConvs[userID] = convs
+	}
+	conv, ok := convs[conversationID]
+	if !ok {
+		conv = &userConv{deleted: make(map[int64]struct{})}
+		convs[conversationID] = conv
+	}
+	return conv
+}
+
+// visibleSeqs returns the range of the seqs of conversationID userID can pull.
+func (s *Server) visibleSeqs(userID, conversationID string) (minSeq, maxSeq int64, ok bool) {
+	conv, ok := s.userConvs[userID][conversationID]
+	if !ok {
+		return 0, 0, false
+	}
+	maxSeq = s.conversationMsgs(conversationID).maxSeq()
+	if conv.maxSeq > 0 && conv.maxSeq < maxSeq {
+		maxSeq = conv.maxSeq
+	}
+	return max(conv.minSeq, 1), maxSeq, true
+}
+
+// joinConversation makes the messages of conversationID sent from now on visible to userID.
+func (s *Server) joinConversation(userID, conversationID string) {
+	conv := s.userConv(userID, conversationID)
+	conv.minSeq = s.conversationMsgs(conversationID).maxSeq() + 1
+	conv.maxSeq = 0
+}
+
+// leaveConversation hides the messages of conversationID sent from now on from userID.
+func (s *Server) leaveConversation(userID, conversationID string) {
+	if conv, ok := s.userConvs[userID][conversationID]; ok {
+		conv.maxSeq = max(s.conversationMsgs(conversationID).maxSeq(), conv.minSeq-1)
+	}
+}
+
+// recipients returns the users m is delivered to.
+func (s *Server) recipients(m *sdkws.MsgData) []string {
+	switch m.SessionType {
+	case constant.ReadGroupChatType:
+		return s.groupMemberIDs(m.GroupID)
+	case constant.NotificationChatType:
+		return []string{m.RecvID}
+	default:
+		if m.SendID == m.RecvID {
+			return []string{m.SendID}
+		}
+		return []string{m.SendID, m.RecvID}
+	}
+}
+
+// store appends m to conversationID, delivers it to recipients and pushes it to their connections.
+// A message not kept in the history gets no seq and is only pushed.
+func (s *Server) store(conversationID string, m *sdkws.MsgData, recipients []string) {
+	if history, ok := m.Options[constant.IsHistory]; !ok || history {
+		msgs := s.conversationMsgs(conversationID)
+		m.Seq = msgs.maxSeq() + 1
+		msgs.msgs = append(msgs.msgs, m)
+		for _, userID := range recipients {
+			s.userConv(userID, conversationID)
+		}
+	}
+	push := &sdkws.PushMessages{}
+	pullMsgs := map[string]*sdkws.PullMsgs{conversationID: {Msgs: []*sdkws.MsgData{m}}}
+	if isNotification(conversationID) {
+		push.NotificationMsgs = pullMsgs
+	} else {
+		push.Msgs = pullMsgs
+	}
+	for _, userID := range recipients {
+		for _, conn := range s.conns[userID] {
+			conn.push(constant.PushMsg, push)
+		}
+	}
+}
+
+// SendMsg sends data on behalf of its sender, the same as /msg/send_msg of the admin.
+func (s *Server) SendMsg(data *sdkws.MsgData) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.sendMsg(&caller{userID: AdminUserID}, data)
+	return err
+}
+
+// sendMsg sends the message of c, the admin can send on behalf of any user.
+func (s *Server) sendMsg(c *caller, data *sdkws.MsgData) (*msg.SendMsgResp, error) {
+	if data == nil {
+		return nil, errs.ErrArgs.WrapMsg("msgData is empty")
+	}
+	if err := c.check(data.SendID); err != nil {
+		return nil, err
+	}
+	data = copyMsg(data)
+	var conversationID string
+	switch data.SessionType {
+	case constant.SingleChatType:
+		if _, err := s.user(data.RecvID); err != nil {
+			return nil, err
+		}
+		if _, ok := s.blacks[data.RecvID][data.SendID]; ok {
+			return nil, errBlockedByPeer.WrapMsg("blocked by " + data.RecvID)
+		}
+		conversationID = singleConversationID(data.SendID, data.RecvID)
+		s.ensureConversation(data.SendID, conversationID, data.SessionType, data.RecvID, "")
+		s.ensureConversation(data.RecvID, conversationID, data.SessionType, data.SendID, "")
+	case constant.ReadGroupChatType:
+		group, err := s.group(data.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		member, ok := s.groupMember(data.GroupID, data.SendID)
+		if !c.isAdmin() {
+			switch {
+			case !ok || group.Status == constant.GroupStatusDismissed:
+				return nil, errs.ErrNoPermission.WrapMsg("not in group " + data.GroupID)
+			case member.MuteEndTime > now():
+				return nil, errs.ErrNoPermission.WrapMsg("muted in group " + data.GroupID)
+			case group.Status == constant.GroupStatusMuted && member.RoleLevel < constant.GroupAdmin:
+				return nil, errs.ErrNoPermission.WrapMsg("group " + data.GroupID + " is muted")
+			}
+		}
+		conversationID = groupConversationID(data.GroupID)
+	case constant.NotificationChatType:
+		if !c.isAdmin() {
+			return nil, errs.ErrNoPermission.WrapMsg("only the admin sends notification messages")
+		}
+		conversationID = "sn_" + data.SendID + "_" + data.RecvID
+		s.ensureConversation(data.RecvID, conversationID, data.SessionType, data.SendID, "")
+	default:
+		return nil, errs.ErrArgs.WrapMsg("unsupported sessionType")
+	}
+	data.ServerMsgID = utils.GetMsgID(data.SendID)
+	data.SendTime = now()
+	data.Status = constant.MsgStatusSendSuccess
+	if data.CreateTime == 0 {
+		data.CreateTime = data.SendTime
+	}
+	s.store(conversationID, data, s.recipients(data))
+	if data.Seq > 0 {
+		s.userConv(data.SendID, conversationID).hasReadSeq = data.Seq
+	}
+	return &msg.SendMsgResp{ServerMsgID: data.ServerMsgID, ClientMsgID: data.ClientMsgID, SendTime: data.SendTime}, nil
+}
+
+// notify sends a notification of contentType from sendID to recvID, it is delivered to both users.
+func (s *Server) notify(sendID, recvID string, contentType int32, tips any) {
+	m := newNotification(sendID, contentType, tips)
+	m.RecvID, m.SessionType = recvID, constant.SingleChatType
+	s.store(notificationConversationID(m), m, s.recipients(m))
+}
+
+// notifyGroup sends a notification of contentType to the members of groupID and to the users of
+// extra, who just left the group.
+func (s *Server) notifyGroup(groupID, sendID string, contentType int32, tips any, extra ...string) {
+	m := newNotification(sendID, contentType, tips)
+	m.GroupID, m.SessionType = groupID, constant.ReadGroupChatType
+	conversationID := notificationConversationID(m)
+	s.store(conversationID, m, append(s.recipients(m), extra...))
+	for _, userID := range extra {
+		s.leaveConversation(userID, conversationID)
+	}
+}
+
+func newNotification(sendID string, contentType int32, tips any) *sdkws.MsgData {
+	content := sdk_struct.NotificationElem{Detail: utils.StructToJsonString(tips)}
+	t := now()
+	return &sdkws.MsgData{
+		SendID:      sendID,
+		ClientMsgID: utils.GetMsgID(sendID),
+		ServerMsgID: utils.GetMsgID(sendID),
+		MsgFrom:     constant.SysMsgType,
+		ContentType: contentType,
+		Content:     []byte(utils.StructToJsonString(content)),
+		SendTime:    t,
+		CreateTime:  t,
+		Status:      constant.MsgStatusSendSuccess,
+	}
+}
+
+// pullMsg returns the message of seq as pulled by userID, a message the user deleted is a placeholder.
+func (s *Server) pullMsg(conv *userConv, conversationID string, seq int64) *sdkws.MsgData {
+	m := s.conversationMsgs(conversationID).get(seq)
+	if m == nil {
+		return nil
+	}
+	if _, ok := conv.deleted[seq]; ok {
+		return &sdkws.MsgData{Seq: seq, Status: constant.MsgStatusHasDeleted}
+	}
+	return m
+}
+
+func pullMsgsOf(resp map[string]*sdkws.PullMsgs, notificationResp map[string]*sdkws.PullMsgs, conversationID string) map[string]*sdkws.PullMsgs {
+	if isNotification(conversationID) {
+		return notificationResp
+	}
+	return resp
+}
+
+func (s *Server) getMaxSeq(c *caller) *sdkws.GetMaxSeqResp {
+	resp := &sdkws.GetMaxSeqResp{MaxSeqs: make(map[string]int64), MinSeqs: make(map[string]int64)}
+	for conversationID := range s.userConvs[c.userID] {
+		minSeq, maxSeq, _ := s.visibleSeqs(c.userID, conversationID)
+		resp.MaxSeqs[conversationID] = maxSeq
+		resp.MinSeqs[conversationID] = minSeq
+	}
+	return resp
+}
+
+// pullMsgByRange returns the messages of the ranges, at most num of each from its end, or from its
+// beginning for the ascending order.
+func (s *Server) pullMsgByRange(c *caller, req *sdkws.PullMessageBySeqsReq) *sdkws.PullMessageBySeqsResp {
+	resp := &sdkws.PullMessageBySeqsResp{Msgs: make(map[string]*sdkws.PullMsgs), NotificationMsgs: make(map[string]*sdkws.PullMsgs)}
+	for _, r := range req.SeqRanges {
+		minSeq, maxSeq, ok := s.visibleSeqs(c.userID, r.ConversationID)
+		if !ok {
+			continue
+		}
+		begin, end := max(r.Begin, minSeq), min(r.End, maxSeq)
+		if r.Num > 0 && end-begin+1 > r.Num {
+			if req.Order == sdkws.PullOrder_PullOrderAsc {
+				end = begin + r.Num - 1
+			} else {
+				begin = end - r.Num + 1
+			}
+		}
+		pull := &sdkws.PullMsgs{EndSeq: end}
+		if req.Order == sdkws.PullOrder_PullOrderAsc {
+			pull.IsEnd = end >= maxSeq
+		} else {
+			pull.IsEnd = begin <= minSeq
+		}
+		conv := s.userConvs[c.userID][r.ConversationID]
+		for seq := begin; seq <= end; seq++ {
+			if m := s.pullMsg(conv, r.ConversationID, seq); m != nil {
+				pull.Msgs = append(pull.Msgs, m)
+			}
+		}
+		pullMsgsOf(resp.Msgs, resp.NotificationMsgs, r.ConversationID)[r.ConversationID] = pull
+	}
+	return resp
+}
+
+func (s *Server) pullMsgBySeqs(c *caller, req *msg.GetSeqMessageReq) *msg.GetSeqMessageResp {
+	resp := &msg.GetSeqMessageResp{Msgs: make(map[string]*sdkws.PullMsgs), NotificationMsgs: make(map[string]*sdkws.PullMsgs)}
+	for _, conversation := range req.Conversations {
+		minSeq, maxSeq, ok := s.visibleSeqs(c.userID, conversation.ConversationID)
+		if !ok {
+			continue
+		}
+		conv := s.userConvs[c.userID][conversation.ConversationID]
+		pull := &sdkws.PullMsgs{}
+		for _, seq := range conversation.Seqs {
+			if seq <= minSeq {
+				pull.IsEnd = true
+			}
+			if seq < minSeq || seq > maxSeq {
+				continue
+			}
+			if m := s.pullMsg(conv, conversation.ConversationID, seq); m != nil {
+				pull.Msgs = append(pull.Msgs, m)
+			}
+		}
+		sort.Slice(pull.Msgs, func(i, j int) bool { return pull.Msgs[i].Seq < pull.Msgs[j].Seq })
+		pullMsgsOf(resp.Msgs, resp.NotificationMsgs, conversation.ConversationID)[conversation.ConversationID] = pull
+	}
+	return resp
+}
+
+// hasReadAndMaxSeqs returns the seqs of conversationIDs, of all the conversations of userID without any.
+func (s *Server) hasReadAndMaxSeqs(userID string, conversationIDs []string) *msg.GetConversationsHasReadAndMaxSeqResp {
+	if len(conversationIDs) == 0 {
+		for conversationID := range s.userConvs[userID] {
+			if !isNotification(conversationID) {
+				conversationIDs = append(conversationIDs, conversationID)
+			}
+		}
+	}
+	resp := &msg.GetConversationsHasReadAndMaxSeqResp{Seqs: make(map[string]*msg.Seqs)}
+	for _, conversationID := range conversationIDs {
+		_, maxSeq, ok := s.visibleSeqs(userID, conversationID)
+		if !ok {
+			continue
+		}
+		seqs := &msg.Seqs{MaxSeq: maxSeq, HasReadSeq: min(s.userConvs[userID][conversationID].hasReadSeq, maxSeq)}
+		if m := s.conversationMsgs(conversationID).get(maxSeq); m != nil {
+			seqs.MaxSeqTime = m.SendTime
+		}
+		resp.Seqs[conversationID] = seqs
+	}
+	return resp
+}
+
+// lastMsgs returns the latest message of conversationIDs userID did not delete.
+func (s *Server) lastMsgs(userID string, conversationIDs []string) *msg.GetLastMessageResp {
+	resp := &msg.GetLastMessageResp{Msgs: make(map[string]*sdkws.MsgData)}
+	for _, conversationID := range conversationIDs {
+		minSeq, maxSeq, ok := s.visibleSeqs(userID, conversationID)
+		if !ok {
+			continue
+		}
+		conv := s.userConvs[userID][conversationID]
+		for seq := maxSeq; seq >= minSeq; seq-- {
+			if _, ok := conv.deleted[seq]; !ok {
+				resp.Msgs[conversationID] = s.conversationMsgs(conversationID).get(seq)
+				break
+			}
+		}
+	}
+	return resp
+}
+
+// visibleMsg returns the message of seq in conversationID userID can see.
+func (s *Server) visibleMsg(userID, conversationID string, seq int64) (*sdkws.MsgData, error) {
+	minSeq, maxSeq, ok := s.visibleSeqs(userID, conversationID)
+	if !ok || seq < minSeq || seq > maxSeq {
+		return nil, errs.ErrRecordNotFound.WrapMsg("message not found", "conversationID", conversationID, "seq", seq)
+	}
+	if _, ok := s.userConvs[userID][conversationID].deleted[seq]; ok {
+		return nil, errs.ErrRecordNotFound.WrapMsg("message deleted", "conversationID", conversationID, "seq", seq)
+	}
+	return s.conversationMsgs(conversationID).get(seq), nil
+}
+
+// notifyConversation sends a notification of contentType to the users of the conversation of m.
+func (s *Server) notifyConversation(m *sdkws.MsgData, sendID string, contentType int32, tips any) {
+	if m.SessionType == constant.ReadGroupChatType {
+		s.notifyGroup(m.GroupID, sendID, contentType, tips)
+		return
+	}
+	recvID := m.RecvID
+	if recvID == sendID {
+		recvID = m.SendID
+	}
+	s.notify(sendID, recvID, contentType, tips)
+}
+
+// markAsRead moves the read seq of userID in conversationID to hasReadSeq and sends the receipt
+// of seqs, a group receipt is only sent to the members when seqs is not empty.
+func (s *Server) markAsRead(userID, conversationID string, hasReadSeq int64, seqs []int64, notify bool) error {
+	_, maxSeq, ok := s.visibleSeqs(userID, conversationID)
+	if !ok {
+		return errs.ErrRecordNotFound.WrapMsg("conversation not found", "conversationID", conversationID)
+	}
+	conv := s.userConvs[userID][conversationID]
+	for _, seq := range seqs {
+		hasReadSeq = max(hasReadSeq, seq)
+	}
+	conv.hasReadSeq = max(conv.hasReadSeq, min(hasReadSeq, maxSeq))
+	if !notify {
+		return nil
+	}
+	tips := &sdkws.MarkAsReadTips{MarkAsReadUserID: userID, ConversationID: conversationID, Seqs: seqs, HasReadSeq: conv.hasReadSeq}
+	conversation, ok := s.conversation(userID, conversationID)
+	switch {
+	case ok && conversation.ConversationType == constant.SingleChatType:
+		s.notify(userID, conversation.UserID, constant.HasReadReceipt, tips)
+	case ok && conversation.ConversationType == constant.ReadGroupChatType && len(seqs) > 0:
+		s.notify(userID, userID, constant.HasReadReceipt, tips)
+		s.notifyGroup(conversation.GroupID, userID, constant.HasReadReceipt, tips)
+	default:
+		s.notify(userID, userID, constant.HasReadReceipt, tips)
+	}
+	return nil
+}
+
+// clearMsgs hides the messages of conversationIDs from userID.
+func (s *Server) clearMsgs(userID string, conversationIDs []string, opt *msg.DeleteSyncOpt) {
+	for _, conversationID := range conversationIDs {
+		if _, ok := s.userConvs[userID][conversationID]; ok {
+			s.userConv(userID, conversationID).minSeq = s.conversationMsgs(conversationID).maxSeq() + 1
+		}
+	}
+	if opt == nil || opt.IsSyncSelf {
+		s.notify(userID, userID, constant.ClearConversationNotification,
+			&sdkws.ClearConversationTips{UserID: userID, ConversationIDs: conversationIDs})
+	}
+}
+
+func (s *Server) registerMsg() {
+	handle(s, api.SendMsg, false, func(c *caller, req *msg.SendMsgReq) (*msg.SendMsgResp, error) {
+		if !c.isAdmin() {
+			return nil, errs.ErrNoPermission.WrapMsg("only the admin sends messages by the API")
+		}
+		return s.sendMsg(c, req.MsgData)
+	})
+	handle(s, api.RevokeMsg, false, func(c *caller, req *msg.RevokeMsgReq) (*msg.RevokeMsgResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		m, err := s.visibleMsg(req.UserID, req.ConversationID, req.Seq)
+		if err != nil {
+			return nil, err
+		}
+		if m.ContentType == constant.RevokeNotification {
+			return nil, errs.ErrArgs.WrapMsg("message already revoked")
+		}
+		isAdminRevoke := m.SendID != req.UserID
+		if isAdminRevoke && !c.isAdmin() {
+			member, ok := s.groupMember(m.GroupID, req.UserID)
+			if m.SessionType != constant.ReadGroupChatType || !ok || member.RoleLevel < constant.GroupAdmin {
+				return nil, errs.ErrNoPermission.WrapMsg("can not revoke the message of " + m.SendID)
+			}
+		}
+		revokeTime := now()
+		revoked := sdk_struct.MessageRevoked{
+			RevokerID:                   req.UserID,
+			ClientMsgID:                 m.ClientMsgID,
+			RevokerNickname:             s.publicUser(req.UserID).Nickname,
+			RevokeTime:                  revokeTime,
+			SourceMessageSendTime:       m.SendTime,
+			SourceMessageSendID:         m.SendID,
+			SourceMessageSenderNickname: m.SenderNickname,
+			SessionType:                 m.SessionType,
+			Seq:                         m.Seq,
+			Ex:                          m.Ex,
+			IsAdminRevoke:               isAdminRevoke,
+		}
+		m.ContentType = constant.RevokeNotification
+		m.Content = []byte(utils.StructToJsonString(sdk_struct.NotificationElem{Detail: utils.StructToJsonString(revoked)}))
+		s.notifyConversation(m, req.UserID, constant.RevokeNotification, &sdkws.RevokeMsgTips{
+			RevokerUserID:  req.UserID,
+			ClientMsgID:    m.ClientMsgID,
+			RevokeTime:     revokeTime,
+			SesstionType:   m.SessionType,
+			Seq:            m.Seq,
+			ConversationID: req.ConversationID,
+			IsAdminRevoke:  isAdminRevoke,
+		})
+		return &msg.RevokeMsgResp{}, nil
+	})
+	handle(s, api.DeleteMsgs, false, func(c *caller, req *msg.DeleteMsgsReq) (*msg.DeleteMsgsResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		if _, _, ok := s.visibleSeqs(req.UserID, req.ConversationID); !ok {
+			return nil, errs.ErrRecordNotFound.WrapMsg("conversation not found", "conversationID", req.ConversationID)
+		}
+		conv := s.userConvs[req.UserID][req.ConversationID]
+		for _, seq := range req.Seqs {
+			conv.deleted[seq] = struct{}{}
+		}
+		if req.DeleteSyncOpt == nil || req.DeleteSyncOpt.IsSyncSelf {
+			s.notify(req.UserID, req.UserID, constant.DeleteMsgsNotification,
+				&sdkws.DeleteMsgsTips{UserID: req.UserID, ConversationID: req.ConversationID, Seqs: req.Seqs})
+		}
+		return &msg.DeleteMsgsResp{}, nil
+	})
+	handle(s, api.ClearConversationMsg, false, func(c *caller, req *msg.ClearConversationsMsgReq) (*msg.ClearConversationsMsgResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		s.clearMsgs(req.UserID, req.ConversationIDs, req.DeleteSyncOpt)
+		return &msg.ClearConversationsMsgResp{}, nil
+	})
+	handle(s, api.ClearAllMsg, false, func(c *caller, req *msg.UserClearAllMsgReq) (*msg.UserClearAllMsgResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		var conversationIDs []string
+		for conversationID := range s.userConvs[req.UserID] {
+			if !isNotification(conversationID) {
+				conversationIDs = append(conversationIDs, conversationID)
+			}
+		}
+		sort.Strings(conversationIDs)
+		s.clearMsgs(req.UserID, conversationIDs, req.DeleteSyncOpt)
+		return &msg.UserClearAllMsgResp{}, nil
+	})
+	handle(s, api.MarkMsgsAsRead, false, func(c *caller, req *msg.MarkMsgsAsReadReq) (*msg.MarkMsgsAsReadResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		return &msg.MarkMsgsAsReadResp{}, s.markAsRead(req.UserID, req.ConversationID, 0, req.Seqs, true)
+	})
+	handle(s, api.MarkConversationAsRead, false, func(c *caller, req *msg.MarkConversationAsReadReq) (*msg.MarkConversationAsReadResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		return &msg.MarkConversationAsReadResp{}, s.markAsRead(req.UserID, req.ConversationID, req.HasReadSeq, req.Seqs, true)
+	})
+	handle(s, api.SetConversationHasReadSeq, false, func(c *caller, req *msg.SetConversationHasReadSeqReq) (*msg.SetConversationHasReadSeqResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		return &msg.SetConversationHasReadSeqResp{}, s.markAsRead(req.UserID, req.ConversationID, req.HasReadSeq, nil, !req.NoNotification)
+	})
+	handle(s, api.GetConversationsHasReadAndMaxSeq, false, func(c *caller, req *msg.GetConversationsHasReadAndMaxSeqReq) (*msg.GetConversationsHasReadAndMaxSeqResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		return s.hasReadAndMaxSeqs(req.UserID, req.ConversationIDs), nil
+	})
+	handle(s, api.GetServerTime, true, func(c *caller, req *msg.GetServerTimeReq) (*msg.GetServerTimeResp, error) {
+		return &msg.GetServerTimeResp{ServerTime: now()}, nil
+	})
+	handle(s, api.GetStreamMsg, false, func(c *caller, req *msg.GetStreamMsgReq) (*msg.GetStreamMsgResp, error) {
+		return nil, errs.ErrRecordNotFound.WrapMsg("stream message not found", "clientMsgID", req.ClientMsgID)
+	})
+	handle(s, api.EditMsg, false, func(c *caller, req *server_api_params.EditMsgReq) (*server_api_params.EditMsgResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		m, err := s.visibleMsg(req.UserID, req.ConversationID, req.Seq)
+		if err != nil {
+			return nil, err
+		}
+		if m.SendID != req.UserID {
+			return nil, errs.ErrNoPermission.WrapMsg("can not edit the message of " + m.SendID)
+		}
+		editTime := now()
+		m.Content = []byte(req.Content)
+		s.notifyConversation(m, req.UserID, constant.MsgEditNotification, &server_api_params.MsgEditedTips{
+			ConversationID: req.ConversationID,
+			SessionType:    m.SessionType,
+			ClientMsgID:    m.ClientMsgID,
+			Seq:            m.Seq,
+			EditorUserID:   req.UserID,
+			Content:        req.Content,
+			EditTime:       editTime,
+		})
+		return &server_api_params.EditMsgResp{EditTime: editTime}, nil
+	})
+	reaction := func(removed bool) func(c *caller, req *server_api_params.MsgReactionReq) (*server_api_params.MsgReactionResp, error) {
+		return func(c *caller, req *server_api_params.MsgReactionReq) (*server_api_params.MsgReactionResp, error) {
+			if err := c.check(req.UserID); err != nil {
+				return nil, err
+			}
+			m, err := s.visibleMsg(req.UserID, req.ConversationID, req.Seq)
+			if err != nil {
+				return nil, err
+			}
+			operateTime := now()
+			s.notifyConversation(m, req.UserID, constant.MsgReactionNotification, &server_api_params.MsgReactionTips{
+				ConversationID: req.ConversationID,
+				ClientMsgID:    m.ClientMsgID,
+				Seq:            m.Seq,
+				UserID:         req.UserID,
+				ReactionType:   req.ReactionType,
+				Info:           req.Info,
+				IsRemoved:      removed,
+				OperateTime:    operateTime,
+			})
+			return &server_api_params.MsgReactionResp{OperateTime: operateTime}, nil
+		}
+	}
+	handle(s, api.AddMsgReaction, false, reaction(false))
+	handle(s, api.RemoveMsgReaction, false, reaction(true))
+	pin := func(pinned bool) func(c *caller, req *server_api_params.MsgPinReq) (*server_api_params.MsgPinResp, error) {
+		return func(c *caller, req *server_api_params.MsgPinReq) (*server_api_params.MsgPinResp, error) {
+			if err := c.check(req.UserID); err != nil {
+				return nil, err
+			}
+			m, err := s.visibleMsg(req.UserID, req.ConversationID, req.Seq)
+			if err != nil {
+				return nil, err
+			}
+			operateTime := now()
+			s.notifyConversation(m, req.UserID, constant.MsgPinNotification, &server_api_params.MsgPinTips{
+				ConversationID: req.ConversationID,
+				ClientMsgID:    m.ClientMsgID,
+				Seq:            m.Seq,
+				OperatorUserID: req.UserID,
+				IsPinned:       pinned,
+				OperateTime:    operateTime,
+			})
+			return &server_api_params.MsgPinResp{OperateTime: operateTime}, nil
+		}
+	}
+	handle(s, api.PinMsg, false, pin(true))
+	handle(s, api.UnpinMsg, false, pin(false))
+}
@@ -0,0 +1,154 @@
+//go:build !js
+
+package fakeserver
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/openimsdk/openim-sdk-core/v3/internal/interaction"
+	"github.com/openimsdk/openim-sdk-core/v3/open_im_sdk"
+	sdkconstant "github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/group"
+	"github.com/openimsdk/protocol/sdkws"
+	"google.golang.org/protobuf/proto"
+)
+
+var recordDir = flag.String("record", "", "write the PushMsg frames of TestRecordPushFrames to this directory")
+
+// recordingEncoder keeps the encoded PushMsg frames written to a connection, before compression.
+type recordingEncoder struct {
+	interaction.Encoder
+
+	mu     sync.Mutex
+	frames []recordedFrame
+}
+
+type recordedFrame struct {
+	contentType int32
+	data        []byte
+}
+
+func (e *recordingEncoder) Encode(data any) ([]byte, error) {
+	out, err := e.Encoder.Encode(data)
+	resp, ok := data.(*interaction.GeneralWsResp)
+	if err != nil || !ok || resp.ReqIdentifier != sdkconstant.PushMsg {
+		return out, err
+	}
+	var push sdkws.PushMessages
+	if err := proto.Unmarshal(resp.Data, &push); err != nil {
+		return out, err
+	}
+	var contentType int32
+	for _, pulls := range []map[string]*sdkws.PullMsgs{push.Msgs, push.NotificationMsgs} {
+		for _, pull := range pulls {
+			for _, msg := range pull.Msgs {
+				contentType = msg.ContentType
+			}
+		}
+	}
+	e.mu.Lock()
+	e.frames = append(e.frames, recordedFrame{contentType: contentType, data: append([]byte(nil), out...)})
+	e.mu.Unlock()
+	return out, err
+}
+
+// msgCount returns the number of recorded frames of user messages.
+func (e *recordingEncoder) msgCount() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var n int
+	for _, frame := range e.frames {
+		if frame.contentType < constant.NotificationBegin {
+			n++
+		}
+	}
+	return n
+}
+
+// record replaces the encoder of the connections of userID with a recordingEncoder.
+func record(s *Server, userID string) *recordingEncoder {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var e *recordingEncoder
+	for _, conn := range s.conns[userID] {
+		conn.mu.Lock()
+		if e == nil {
+			e = &recordingEncoder{Encoder: conn.encoder}
+		}
+		conn.encoder = e
+		conn.mu.Unlock()
+	}
+	return e
+}
+
+// TestRecordPushFrames records the frames pushed to a member of a group chat while the SDK
+// sends the usual kinds of messages, the compression benchmark of internal/interaction runs
+// on them:
+//
+//	go test ./pkg/fakeserver -run TestRecordPushFrames -record ../../internal/interaction/testdata
+func TestRecordPushFrames(t *testing.T) {
+	if *recordDir == "" {
+		t.Skip("no -record directory")
+	}
+	s := newServer(t)
+	alice, carol := login(t, s, "alice"), login(t, s, "carol")
+	login(t, s, "bob")
+	recorder := record(s, "bob")
+
+	info, err := alice.Group().CreateGroup(ctx(alice), &group.CreateGroupReq{
+		MemberUserIDs: []string{"bob", "carol"},
+		GroupInfo:     &sdkws.GroupInfo{GroupName: "weekend hiking", GroupType: constant.WorkingGroup},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := func(msg *sdk_struct.MsgStruct, err error) *sdk_struct.MsgStruct {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return msg
+	}
+	send := func(u *open_im_sdk.LoginMgr, recvID, groupID string, msg *sdk_struct.MsgStruct) *sdk_struct.MsgStruct {
+		t.Helper()
+		sent, err := u.Conversation().SendMessage(ctx(u), msg, recvID, groupID, &sdkws.OfflinePushInfo{}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sent
+	}
+	c := alice.Conversation()
+	first := send(alice, "bob", "", msg(c.CreateTextMessage(ctx(alice), "Are we still on for lunch tomorrow?")))
+	send(alice, "bob", "", msg(c.CreateTextMessage(ctx(alice), "好的，明天中午十二点在公司楼下见，不见不散。")))
+	send(alice, "bob", "", msg(c.CreateQuoteMessage(ctx(alice), "I booked a table for three", first)))
+	send(alice, "bob", "", msg(c.CreateLocationMessage(ctx(alice), "Golden Gate Park, San Francisco", -122.4862, 37.7694)))
+	send(alice, "bob", "", msg(c.CreateCardMessage(ctx(alice), &sdk_struct.CardElem{UserID: "carol", Nickname: "carol"})))
+	send(alice, "bob", "", msg(c.CreateFaceMessage(ctx(alice), 3, `{"url":"https://www.openim.io/emoji/3.gif"}`)))
+	send(alice, "bob", "", msg(c.CreateCustomMessage(ctx(alice), `{"type":"order","orderID":"20231001123456","amount":"129.90"}`, "", "order shared")))
+	send(alice, "", info.GroupID, msg(c.CreateTextAtMessage(ctx(alice), "@bob can you bring the map?", []string{"bob"},
+		[]*sdk_struct.AtInfo{{AtUserID: "bob", GroupNickname: "bob"}}, nil)))
+	send(carol, "", info.GroupID, msg(carol.Conversation().CreateTextMessage(ctx(carol), "I will pick everyone up at 7am, the trail starts at the north entrance.")))
+	send(carol, "", info.GroupID, msg(carol.Conversation().CreateAdvancedTextMessage(ctx(carol), "route: https://www.openim.io/trails/42",
+		[]*sdk_struct.MessageEntity{{Type: "url", Offset: 7, Length: 31, Url: "https://www.openim.io/trails/42"}})))
+	eventually(t, "the messages of bob", func() bool { return recorder.msgCount() == 10 })
+
+	if err := os.MkdirAll(*recordDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	for i, frame := range recorder.frames {
+		name := filepath.Join(*recordDir, fmt.Sprintf("push_%02d_%d.bin", i, frame.contentType))
+		if err := os.WriteFile(name, frame.data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Logf("recorded %d frames", len(recorder.frames))
+}
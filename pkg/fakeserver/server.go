@@ -0,0 +1,329 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fakeserver is an in-memory OpenIM server for tests. It serves the HTTP API routes
+// of pkg/api and the long connection gateway on a local listener, so the SDK can be driven
+// end to end by go test without a server deployment.
+//
+// The server keeps users, relations, groups, conversations, messages and uploaded objects in
+// memory and sends the notifications a real server would, the incremental sync APIs answer
+// from a version log of every list.
+package fakeserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/api"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+	"github.com/openimsdk/protocol/constant"
+	pbConversation "github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	// AdminUserID and Secret get the admin token of /auth/get_admin_token, the same as the
+	// default server configuration.
+	AdminUserID = "imAdmin"
+	Secret      = "openIM123"
+
+	tokenExpireSeconds = 90 * 24 * 3600
+)
+
+type Server struct {
+	httpServer *httptest.Server
+	mux        *http.ServeMux
+
+	mu     sync.Mutex
+	tokens map[string]*token
+	users  map[string]*sdkws.UserInfo
+
+	commands map[string]map[string]*command
+
+	friends        map[string]*versionList[*sdkws.FriendInfo]
+	blacks         map[string]map[string]*sdkws.BlackInfo
+	friendRequests []*sdkws.FriendRequest
+
+	groups        map[string]*sdkws.GroupInfo
+	groupMembers  map[string]*versionList[*sdkws.GroupMemberFullInfo]
+	joinedGroups  map[string]*versionList[*sdkws.GroupInfo]
+	groupRequests []*sdkws.GroupRequest
+
+	conversations map[string]*versionList[*pbConversation.Conversation]
+	msgs          map[string]*conversationMsgs
+	userConvs     map[string]map[string]*userConv
+
+	objects map[string]*object
+	uploads map[string]*upload
+
+	conns map[string][]*gatewayConn
+}
+
+type token struct {
+	userID     string
+	platformID int32
+	// kicked is set when another connection of the platform replaced the one of the token.
+	kicked bool
+}
+
+// New starts a Server on a local port, it is stopped by Close.
+func New() *Server {
+	s := &Server{
+		mux:           http.NewServeMux(),
+		tokens:        make(map[string]*token),
+		users:         make(map[string]*sdkws.UserInfo),
+		commands:      make(map[string]map[string]*command),
+		friends:       make(map[string]*versionList[*sdkws.FriendInfo]),
+		blacks:        make(map[string]map[string]*sdkws.BlackInfo),
+		groups:        make(map[string]*sdkws.GroupInfo),
+		groupMembers:  make(map[string]*versionList[*sdkws.GroupMemberFullInfo]),
+		joinedGroups:  make(map[string]*versionList[*sdkws.GroupInfo]),
+		conversations: make(map[string]*versionList[*pbConversation.Conversation]),
+		msgs:          make(map[string]*conversationMsgs),
+		userConvs:     make(map[string]map[string]*userConv),
+		objects:       make(map[string]*object),
+		uploads:       make(map[string]*upload),
+		conns:         make(map[string][]*gatewayConn),
+	}
+	s.registerAuth()
+	s.registerUser()
+	s.registerFriend()
+	s.registerGroup()
+	s.registerConversation()
+	s.registerMsg()
+	s.registerThird()
+	s.httpServer = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// ApiAddr returns the address of the HTTP API, IMConfig.ApiAddr.
+func (s *Server) ApiAddr() string {
+	return s.httpServer.URL
+}
+
+// WsAddr returns the address of the long connection gateway, IMConfig.WsAddr.
+func (s *Server) WsAddr() string {
+	return "ws" + strings.TrimPrefix(s.httpServer.URL, "http")
+}
+
+// Config returns an IMConfig connecting to the server with its data in dataDir.
+func (s *Server) Config(dataDir string) sdk_struct.IMConfig {
+	return sdk_struct.IMConfig{
+		PlatformID:  constant.LinuxPlatformID,
+		ApiAddr:     s.ApiAddr(),
+		WsAddr:      s.WsAddr(),
+		DataDir:     dataDir,
+		LogLevel:    3,
+		LogFilePath: dataDir,
+	}
+}
+
+// Close closes the connections and stops the server.
+func (s *Server) Close() {
+	s.mu.Lock()
+	for _, conns := range s.conns {
+		for _, conn := range conns {
+			conn.close()
+		}
+	}
+	s.conns = make(map[string][]*gatewayConn)
+	s.mu.Unlock()
+	s.httpServer.Close()
+}
+
+// AddUser registers users, an existing user is updated.
+func (s *Server) AddUser(users ...*sdkws.UserInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, user := range users {
+		s.addUser(user)
+	}
+}
+
+// addUser registers user, an existing user is updated in place as the friend lists share it.
+func (s *Server) addUser(user *sdkws.UserInfo) {
+	if old, ok := s.users[user.UserID]; ok {
+		old.Nickname, old.FaceURL, old.Ex = user.Nickname, user.FaceURL, user.Ex
+		old.AppMangerLevel, old.GlobalRecvMsgOpt = user.AppMangerLevel, user.GlobalRecvMsgOpt
+		return
+	}
+	user = copyMsg(user)
+	if user.CreateTime == 0 {
+		user.CreateTime = now()
+	}
+	s.users[user.UserID] = user
+}
+
+// Token registers userID when unknown and returns a token for it on platformID.
+func (s *Server) Token(userID string, platformID int32) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.users[userID]; !ok {
+		s.addUser(&sdkws.UserInfo{UserID: userID, Nickname: userID})
+	}
+	return s.newToken(userID, platformID)
+}
+
+func (s *Server) newToken(userID string, platformID int32) string {
+	t := utils.OperationIDGenerator()
+	s.tokens[t] = &token{userID: userID, platformID: platformID}
+	return t
+}
+
+// caller is the user of an API request.
+type caller struct {
+	userID      string
+	platformID  int32
+	operationID string
+}
+
+func (c *caller) isAdmin() bool {
+	return c.userID == AdminUserID
+}
+
+// check returns ErrNoPermission unless the caller is userID or the admin.
+func (c *caller) check(userID string) error {
+	if c.userID != userID && !c.isAdmin() {
+		return errs.ErrNoPermission.WrapMsg("caller is not " + userID)
+	}
+	return nil
+}
+
+// handle serves the route of a with fn, the caller must have a token unless public is set.
+func handle[Req, Resp any](s *Server, a api.Api[Req, Resp], public bool, fn func(c *caller, req *Req) (*Resp, error)) {
+	s.mux.HandleFunc(a.Route(), func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeResp(w, nil, errs.ErrArgs.WrapMsg(err.Error()))
+			return
+		}
+		var req Req
+		if err := json.Unmarshal(body, &req); err != nil {
+			writeResp(w, nil, errs.ErrArgs.WrapMsg("invalid request: "+err.Error()))
+			return
+		}
+		// the response shares the state of the server, it is encoded before the unlock
+		s.mu.Lock()
+		var data []byte
+		if c, err := s.caller(r, public); err != nil {
+			data = encodeResp(nil, err)
+		} else {
+			resp, err := fn(c, &req)
+			data = encodeResp(resp, err)
+		}
+		s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	})
+}
+
+// caller returns the caller of r, its token is checked unless public is set.
+func (s *Server) caller(r *http.Request, public bool) (*caller, error) {
+	c := &caller{operationID: r.Header.Get("operationID")}
+	if public {
+		return c, nil
+	}
+	t, ok := s.tokens[r.Header.Get("token")]
+	if !ok {
+		return nil, errs.ErrTokenNotExist.WrapMsg("token not exist")
+	}
+	if t.kicked {
+		return nil, errs.ErrTokenKicked.WrapMsg("token kicked")
+	}
+	c.userID, c.platformID = t.userID, t.platformID
+	return c, nil
+}
+
+func writeResp(w http.ResponseWriter, data any, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(encodeResp(data, err))
+}
+
+// encodeResp returns the ApiResponse of data or err.
+func encodeResp(data any, err error) []byte {
+	resp := struct {
+		ErrCode int    `json:"errCode"`
+		ErrMsg  string `json:"errMsg"`
+		ErrDlt  string `json:"errDlt"`
+		Data    any    `json:"data,omitempty"`
+	}{}
+	if err != nil {
+		resp.ErrCode, resp.ErrMsg = errs.ServerInternalError, err.Error()
+		if code, ok := errs.Unwrap(err).(errs.CodeError); ok {
+			resp.ErrCode, resp.ErrMsg, resp.ErrDlt = code.Code(), code.Msg(), err.Error()
+		}
+	} else {
+		resp.Data = data
+	}
+	out, err := json.Marshal(resp)
+	if err != nil {
+		return encodeResp(nil, errs.ErrInternalServer.WrapMsg(err.Error()))
+	}
+	return out
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Upgrade") == "websocket" {
+		s.serveGateway(w, r)
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// user returns the registered userID.
+func (s *Server) user(userID string) (*sdkws.UserInfo, error) {
+	user, ok := s.users[userID]
+	if !ok {
+		return nil, errs.ErrRecordNotFound.WrapMsg("user not found", "userID", userID)
+	}
+	return user, nil
+}
+
+func (s *Server) publicUser(userID string) *sdkws.PublicUserInfo {
+	user, ok := s.users[userID]
+	if !ok {
+		return &sdkws.PublicUserInfo{UserID: userID}
+	}
+	return &sdkws.PublicUserInfo{UserID: user.UserID, Nickname: user.Nickname, FaceURL: user.FaceURL, Ex: user.Ex}
+}
+
+// page returns the page of list selected by pagination, all of it without pagination.
+func page[T any](list []T, pagination *sdkws.RequestPagination) []T {
+	if pagination == nil || pagination.ShowNumber <= 0 {
+		return list
+	}
+	start := int(pagination.PageNumber-1) * int(pagination.ShowNumber)
+	if pagination.PageNumber < 1 {
+		start = 0
+	}
+	if start >= len(list) {
+		return nil
+	}
+	end := start + int(pagination.ShowNumber)
+	if end > len(list) {
+		end = len(list)
+	}
+	return list[start:end]
+}
+
+func now() int64 {
+	return time.Now().UnixMilli()
+}
@@ -0,0 +1,239 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeserver
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/api"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/protocol/third"
+	"github.com/openimsdk/tools/errs"
+)
+
+// The part limits of the default server configuration.
+const (
+	minPartSize = 1024 * 1024 * 5
+	maxPartSize = 1024 * 1024 * 1024 * 5
+	maxNumSize  = 10000
+
+	uploadsPath = "/uploads/"
+	objectsPath = "/objects/"
+)
+
+// object is a completed upload, served at objectsPath.
+type object struct {
+	name        string
+	contentType string
+	hash        string
+	data        []byte
+}
+
+// upload is a multipart upload in progress, its parts are put at uploadsPath.
+type upload struct {
+	id         string
+	hash       string
+	size       int64
+	partSize   int64
+	parts      map[int32][]byte
+	expireTime int64
+}
+
+func (u *upload) partNum() int32 {
+	n := u.size / u.partSize
+	if u.size%u.partSize != 0 {
+		n++
+	}
+	return int32(n)
+}
+
+// sign returns the signature of the parts of u, they are put at its url with the partNumber query.
+func (s *Server) sign(u *upload, partNumbers []int32) *third.AuthSignParts {
+	sign := &third.AuthSignParts{Url: s.ApiAddr() + uploadsPath + u.id}
+	for _, partNumber := range partNumbers {
+		sign.Parts = append(sign.Parts, &third.SignPart{
+			PartNumber: partNumber,
+			Query:      []*third.KeyValues{{Key: "partNumber", Values: []string{strconv.Itoa(int(partNumber))}}},
+		})
+	}
+	return sign
+}
+
+func (s *Server) objectURL(name string) string {
+	return s.ApiAddr() + objectsPath + name
+}
+
+func md5Hex(data []byte) string {
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *Server) registerThird() {
+	handle(s, api.ObjectPartLimit, false, func(c *caller, req *third.PartLimitReq) (*third.PartLimitResp, error) {
+		return &third.PartLimitResp{MinPartSize: minPartSize, MaxPartSize: maxPartSize, MaxNumSize: maxNumSize}, nil
+	})
+	handle(s, api.ObjectInitiateMultipartUpload, false, func(c *caller, req *third.InitiateMultipartUploadReq) (*third.InitiateMultipartUploadResp, error) {
+		if req.Name == "" || req.Hash == "" || req.Size <= 0 {
+			return nil, errs.ErrArgs.WrapMsg("name, hash and size are required")
+		}
+		if req.PartSize < minPartSize && req.PartSize < req.Size || req.PartSize > maxPartSize {
+			return nil, errs.ErrArgs.WrapMsg("invalid part size", "partSize", req.PartSize)
+		}
+		// the same content is uploaded once, the same as the server
+		for _, o := range s.objects {
+			if o.hash == req.Hash {
+				s.objects[req.Name] = &object{name: req.Name, contentType: req.ContentType, hash: o.hash, data: o.data}
+				return &third.InitiateMultipartUploadResp{Url: s.objectURL(req.Name)}, nil
+			}
+		}
+		u := &upload{
+			id:         utils.OperationIDGenerator(),
+			hash:       req.Hash,
+			size:       req.Size,
+			partSize:   req.PartSize,
+			parts:      make(map[int32][]byte),
+			expireTime: time.Now().Add(time.Hour).UnixMilli(),
+		}
+		if u.partNum() > maxNumSize {
+			return nil, errs.ErrArgs.WrapMsg("too many parts", "partNum", u.partNum())
+		}
+		s.uploads[u.id] = u
+		var partNumbers []int32
+		for i := int32(1); i <= u.partNum() && i <= req.MaxParts; i++ {
+			partNumbers = append(partNumbers, i)
+		}
+		return &third.InitiateMultipartUploadResp{Upload: &third.UploadInfo{
+			UploadID:   u.id,
+			PartSize:   u.partSize,
+			Sign:       s.sign(u, partNumbers),
+			ExpireTime: u.expireTime,
+		}}, nil
+	})
+	handle(s, api.ObjectAuthSign, false, func(c *caller, req *third.AuthSignReq) (*third.AuthSignResp, error) {
+		u, ok := s.uploads[req.UploadID]
+		if !ok {
+			return nil, errs.ErrRecordNotFound.WrapMsg("upload not found", "uploadID", req.UploadID)
+		}
+		for _, partNumber := range req.PartNumbers {
+			if partNumber < 1 || partNumber > u.partNum() {
+				return nil, errs.ErrArgs.WrapMsg("invalid part number", "partNumber", partNumber)
+			}
+		}
+		sign := s.sign(u, req.PartNumbers)
+		return &third.AuthSignResp{Url: sign.Url, Query: sign.Query, Header: sign.Header, Parts: sign.Parts}, nil
+	})
+	handle(s, api.ObjectCompleteMultipartUpload, false, func(c *caller, req *third.CompleteMultipartUploadReq) (*third.CompleteMultipartUploadResp, error) {
+		u, ok := s.uploads[req.UploadID]
+		if !ok {
+			return nil, errs.ErrRecordNotFound.WrapMsg("upload not found", "uploadID", req.UploadID)
+		}
+		if len(req.Parts) != int(u.partNum()) {
+			return nil, errs.ErrArgs.WrapMsg("part count mismatch", "expect", u.partNum(), "got", len(req.Parts))
+		}
+		var data []byte
+		for i, partMd5 := range req.Parts {
+			part, ok := u.parts[int32(i+1)]
+			if !ok {
+				return nil, errs.ErrArgs.WrapMsg("part not uploaded", "partNumber", i+1)
+			}
+			if md5Hex(part) != partMd5 {
+				return nil, errs.ErrArgs.WrapMsg("part md5 mismatch", "partNumber", i+1)
+			}
+			data = append(data, part...)
+		}
+		if int64(len(data)) != u.size {
+			return nil, errs.ErrArgs.WrapMsg("size mismatch", "expect", u.size, "got", len(data))
+		}
+		if md5Hex([]byte(strings.Join(req.Parts, ","))) != u.hash {
+			return nil, errs.ErrArgs.WrapMsg("hash mismatch", "hash", u.hash)
+		}
+		delete(s.uploads, u.id)
+		s.objects[req.Name] = &object{name: req.Name, contentType: req.ContentType, hash: u.hash, data: data}
+		return &third.CompleteMultipartUploadResp{Url: s.objectURL(req.Name)}, nil
+	})
+	handle(s, api.ObjectAccessURL, false, func(c *caller, req *third.AccessURLReq) (*third.AccessURLResp, error) {
+		if _, ok := s.objects[req.Name]; !ok {
+			return nil, errs.ErrRecordNotFound.WrapMsg("object not found", "name", req.Name)
+		}
+		return &third.AccessURLResp{Url: s.objectURL(req.Name), ExpireTime: time.Now().Add(time.Hour).UnixMilli()}, nil
+	})
+	handle(s, api.FcmUpdateToken, false, func(c *caller, req *third.FcmUpdateTokenReq) (*third.FcmUpdateTokenResp, error) {
+		return &third.FcmUpdateTokenResp{}, nil
+	})
+	handle(s, api.SetAppBadge, false, func(c *caller, req *third.SetAppBadgeReq) (*third.SetAppBadgeResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		return &third.SetAppBadgeResp{}, nil
+	})
+	handle(s, api.UploadLogs, false, func(c *caller, req *third.UploadLogsReq) (*third.UploadLogsResp, error) {
+		return &third.UploadLogsResp{}, nil
+	})
+	s.mux.HandleFunc(uploadsPath, s.putPart)
+	s.mux.HandleFunc(objectsPath, s.getObject)
+}
+
+// putPart stores the part of an upload, the storage side of the part signatures.
+func (s *Server) putPart(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	partNumber, err := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	if err != nil {
+		http.Error(w, "invalid partNumber", http.StatusBadRequest)
+		return
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.uploads[strings.TrimPrefix(r.URL.Path, uploadsPath)]
+	if !ok {
+		http.Error(w, "upload not found", http.StatusNotFound)
+		return
+	}
+	if partNumber < 1 || int32(partNumber) > u.partNum() {
+		http.Error(w, "invalid partNumber", http.StatusBadRequest)
+		return
+	}
+	u.parts[int32(partNumber)] = data
+	w.Header().Set("ETag", md5Hex(data))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) getObject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	o, ok := s.objects[strings.TrimPrefix(r.URL.Path, objectsPath)]
+	s.mu.Unlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if o.contentType != "" {
+		w.Header().Set("Content-Type", o.contentType)
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(o.data)))
+	_, _ = w.Write(o.data)
+}
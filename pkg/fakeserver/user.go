@@ -0,0 +1,245 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeserver
+
+import (
+	"sort"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/api"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/protocol/auth"
+	pconstant "github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"github.com/openimsdk/protocol/user"
+	"github.com/openimsdk/tools/errs"
+)
+
+// command is a user command of process_user_command_add, keyed by its uuid.
+type command struct {
+	Type       int32
+	Uuid       string
+	Value      string
+	Ex         string
+	CreateTime int64
+}
+
+// userCommands returns the commands of userID of commandType, of all types when it is 0, in
+// creation order.
+func (s *Server) userCommands(userID string, commandType int32) []*command {
+	var commands []*command
+	for _, cmd := range s.commands[userID] {
+		if commandType == 0 || cmd.Type == commandType {
+			commands = append(commands, cmd)
+		}
+	}
+	sort.Slice(commands, func(i, j int) bool {
+		if commands[i].CreateTime != commands[j].CreateTime {
+			return commands[i].CreateTime < commands[j].CreateTime
+		}
+		return commands[i].Uuid < commands[j].Uuid
+	})
+	return commands
+}
+
+// userUpdated notifies userID and the users who have it as a friend of the change of its info.
+func (s *Server) userUpdated(userID string) {
+	s.notify(userID, userID, constant.UserInfoUpdatedNotification, &sdkws.UserInfoUpdatedTips{UserID: userID})
+	for ownerUserID, friends := range s.friends {
+		if _, ok := friends.get(userID); ok {
+			friends.touch(userID)
+			s.notify(userID, ownerUserID, constant.FriendInfoUpdatedNotification, &sdkws.UserInfoUpdatedTips{UserID: userID})
+		}
+	}
+}
+
+func (s *Server) registerAuth() {
+	handle(s, api.GetAdminToken, true, func(c *caller, req *auth.GetAdminTokenReq) (*auth.GetAdminTokenResp, error) {
+		if req.Secret != Secret || req.UserID != AdminUserID {
+			return nil, errs.ErrNoPermission.WrapMsg("invalid secret or admin userID")
+		}
+		if _, ok := s.users[AdminUserID]; !ok {
+			s.addUser(&sdkws.UserInfo{UserID: AdminUserID, Nickname: AdminUserID, AppMangerLevel: pconstant.AppAdmin})
+		}
+		return &auth.GetAdminTokenResp{Token: s.newToken(AdminUserID, pconstant.AdminPlatformID), ExpireTimeSeconds: tokenExpireSeconds}, nil
+	})
+	handle(s, api.GetUsersToken, false, func(c *caller, req *auth.GetUserTokenReq) (*auth.GetUserTokenResp, error) {
+		if !c.isAdmin() {
+			return nil, errs.ErrNoPermission.WrapMsg("only the admin gets user tokens")
+		}
+		if _, err := s.user(req.UserID); err != nil {
+			return nil, err
+		}
+		return &auth.GetUserTokenResp{Token: s.newToken(req.UserID, req.PlatformID), ExpireTimeSeconds: tokenExpireSeconds}, nil
+	})
+	handle(s, api.ParseToken, false, func(c *caller, req *auth.ParseTokenReq) (*auth.ParseTokenResp, error) {
+		t, ok := s.tokens[req.Token]
+		if !ok {
+			return nil, errs.ErrTokenNotExist.WrapMsg("token not exist")
+		}
+		return &auth.ParseTokenResp{UserID: t.userID, PlatformID: t.platformID, ExpireTimeSeconds: tokenExpireSeconds}, nil
+	})
+}
+
+func (s *Server) registerUser() {
+	handle(s, api.UserRegister, false, func(c *caller, req *user.UserRegisterReq) (*user.UserRegisterResp, error) {
+		if !c.isAdmin() {
+			return nil, errs.ErrNoPermission.WrapMsg("only the admin registers users")
+		}
+		for _, u := range req.Users {
+			if u.UserID == "" {
+				return nil, errs.ErrArgs.WrapMsg("userID is empty")
+			}
+			if _, ok := s.users[u.UserID]; ok {
+				return nil, errs.ErrDuplicateKey.WrapMsg("user registered already", "userID", u.UserID)
+			}
+		}
+		for _, u := range req.Users {
+			s.addUser(u)
+		}
+		return &user.UserRegisterResp{}, nil
+	})
+	handle(s, api.GetUsersInfo, false, func(c *caller, req *user.GetDesignateUsersReq) (*user.GetDesignateUsersResp, error) {
+		resp := &user.GetDesignateUsersResp{}
+		for _, userID := range req.UserIDs {
+			if u, ok := s.users[userID]; ok {
+				resp.UsersInfo = append(resp.UsersInfo, u)
+			}
+		}
+		return resp, nil
+	})
+	handle(s, api.UpdateUserInfo, false, func(c *caller, req *user.UpdateUserInfoReq) (*user.UpdateUserInfoResp, error) {
+		if req.UserInfo == nil {
+			return nil, errs.ErrArgs.WrapMsg("userInfo is empty")
+		}
+		if err := c.check(req.UserInfo.UserID); err != nil {
+			return nil, err
+		}
+		u, err := s.user(req.UserInfo.UserID)
+		if err != nil {
+			return nil, err
+		}
+		// the zero fields are not updated, the same as the server
+		if req.UserInfo.Nickname != "" {
+			u.Nickname = req.UserInfo.Nickname
+		}
+		if req.UserInfo.FaceURL != "" {
+			u.FaceURL = req.UserInfo.FaceURL
+		}
+		if req.UserInfo.Ex != "" {
+			u.Ex = req.UserInfo.Ex
+		}
+		s.userUpdated(u.UserID)
+		return &user.UpdateUserInfoResp{}, nil
+	})
+	handle(s, api.UpdateUserInfoEx, false, func(c *caller, req *user.UpdateUserInfoExReq) (*user.UpdateUserInfoExResp, error) {
+		if req.UserInfo == nil {
+			return nil, errs.ErrArgs.WrapMsg("userInfo is empty")
+		}
+		if err := c.check(req.UserInfo.UserID); err != nil {
+			return nil, err
+		}
+		u, err := s.user(req.UserInfo.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if req.UserInfo.Nickname != nil {
+			u.Nickname = req.UserInfo.Nickname.Value
+		}
+		if req.UserInfo.FaceURL != nil {
+			u.FaceURL = req.UserInfo.FaceURL.Value
+		}
+		if req.UserInfo.Ex != nil {
+			u.Ex = req.UserInfo.Ex.Value
+		}
+		if req.UserInfo.GlobalRecvMsgOpt != nil {
+			u.GlobalRecvMsgOpt = req.UserInfo.GlobalRecvMsgOpt.Value
+		}
+		s.userUpdated(u.UserID)
+		return &user.UpdateUserInfoExResp{}, nil
+	})
+	handle(s, api.ProcessUserCommandAdd, false, func(c *caller, req *user.ProcessUserCommandAddReq) (*user.ProcessUserCommandAddResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		commands, ok := s.commands[req.UserID]
+		if !ok {
+			commands = make(map[string]*command)
+			s.commands[req.UserID] = commands
+		}
+		if _, ok := commands[req.Uuid]; ok {
+			return nil, errs.ErrArgs.WrapMsg("command exists already", "uuid", req.Uuid)
+		}
+		cmd := &command{Type: req.Type, Uuid: req.Uuid, CreateTime: now()}
+		cmd.Value, cmd.Ex = req.Value.GetValue(), req.Ex.GetValue()
+		commands[req.Uuid] = cmd
+		s.notify(req.UserID, req.UserID, constant.UserCommandAddNotification,
+			&sdkws.UserCommandAddTips{FromUserID: req.UserID, ToUserID: req.UserID})
+		return &user.ProcessUserCommandAddResp{}, nil
+	})
+	handle(s, api.ProcessUserCommandDelete, false, func(c *caller, req *user.ProcessUserCommandDeleteReq) (*user.ProcessUserCommandDeleteResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		if cmd, ok := s.commands[req.UserID][req.Uuid]; !ok || cmd.Type != req.Type {
+			return nil, errs.ErrRecordNotFound.WrapMsg("command not found", "uuid", req.Uuid)
+		}
+		delete(s.commands[req.UserID], req.Uuid)
+		s.notify(req.UserID, req.UserID, constant.UserCommandDeleteNotification,
+			&sdkws.UserCommandDeleteTips{FromUserID: req.UserID, ToUserID: req.UserID})
+		return &user.ProcessUserCommandDeleteResp{}, nil
+	})
+	handle(s, api.ProcessUserCommandUpdate, false, func(c *caller, req *user.ProcessUserCommandUpdateReq) (*user.ProcessUserCommandUpdateResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		cmd, ok := s.commands[req.UserID][req.Uuid]
+		if !ok || cmd.Type != req.Type {
+			return nil, errs.ErrRecordNotFound.WrapMsg("command not found", "uuid", req.Uuid)
+		}
+		if req.Value != nil {
+			cmd.Value = req.Value.Value
+		}
+		if req.Ex != nil {
+			cmd.Ex = req.Ex.Value
+		}
+		s.notify(req.UserID, req.UserID, constant.UserCommandUpdateNotification,
+			&sdkws.UserCommandUpdateTips{FromUserID: req.UserID, ToUserID: req.UserID})
+		return &user.ProcessUserCommandUpdateResp{}, nil
+	})
+	handle(s, api.ProcessUserCommandGet, false, func(c *caller, req *user.ProcessUserCommandGetReq) (*user.ProcessUserCommandGetResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		resp := &user.ProcessUserCommandGetResp{}
+		for _, cmd := range s.userCommands(req.UserID, req.Type) {
+			resp.CommandResp = append(resp.CommandResp, &user.CommandInfoResp{
+				Type: cmd.Type, CreateTime: cmd.CreateTime, Uuid: cmd.Uuid, Value: cmd.Value, Ex: cmd.Ex,
+			})
+		}
+		return resp, nil
+	})
+	handle(s, api.ProcessUserCommandGetAll, false, func(c *caller, req *user.ProcessUserCommandGetAllReq) (*user.ProcessUserCommandGetAllResp, error) {
+		if err := c.check(req.UserID); err != nil {
+			return nil, err
+		}
+		resp := &user.ProcessUserCommandGetAllResp{}
+		for _, cmd := range s.userCommands(req.UserID, 0) {
+			resp.CommandResp = append(resp.CommandResp, &user.AllCommandInfoResp{
+				Type: cmd.Type, CreateTime: cmd.CreateTime, Uuid: cmd.Uuid, Value: cmd.Value, Ex: cmd.Ex,
+			})
+		}
+		return resp, nil
+	})
+}
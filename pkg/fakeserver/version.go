@@ -0,0 +1,153 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeserver
+
+import (
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"google.golang.org/protobuf/proto"
+)
+
+// versionList is a list kept with the log of its changes for the incremental sync APIs: a
+// client at an older version of the same versionID gets the changes since, any other client
+// a full sync.
+type versionList[T any] struct {
+	versionID string
+	version   uint64
+	ids       []string
+	elems     map[string]T
+	logs      []versionLog
+}
+
+type versionLog struct {
+	id      string
+	version uint64
+	insert  bool
+	delete  bool
+}
+
+func newVersionList[T any]() *versionList[T] {
+	return &versionList[T]{versionID: utils.OperationIDGenerator(), elems: make(map[string]T)}
+}
+
+func (l *versionList[T]) get(id string) (T, bool) {
+	elem, ok := l.elems[id]
+	return elem, ok
+}
+
+// set inserts or updates the element id.
+func (l *versionList[T]) set(id string, elem T) {
+	_, ok := l.elems[id]
+	if !ok {
+		l.ids = append(l.ids, id)
+	}
+	l.elems[id] = elem
+	l.log(id, !ok, false)
+}
+
+// touch logs an update of the element id changed in place.
+func (l *versionList[T]) touch(id string) {
+	if _, ok := l.elems[id]; ok {
+		l.log(id, false, false)
+	}
+}
+
+// bump logs a change of the owner of the list rather than of an element, such as the info of
+// the group of a member list, so the clients at an older version sync again.
+func (l *versionList[T]) bump() {
+	l.log("", false, false)
+}
+
+func (l *versionList[T]) delete(id string) bool {
+	if _, ok := l.elems[id]; !ok {
+		return false
+	}
+	delete(l.elems, id)
+	for i := range l.ids {
+		if l.ids[i] == id {
+			l.ids = append(l.ids[:i], l.ids[i+1:]...)
+			break
+		}
+	}
+	l.log(id, false, true)
+	return true
+}
+
+func (l *versionList[T]) log(id string, insert, delete bool) {
+	l.version++
+	l.logs = append(l.logs, versionLog{id: id, version: l.version, insert: insert, delete: delete})
+}
+
+func (l *versionList[T]) len() int {
+	return len(l.ids)
+}
+
+// list returns the elements in insertion order.
+func (l *versionList[T]) list() []T {
+	list := make([]T, 0, len(l.ids))
+	for _, id := range l.ids {
+		list = append(list, l.elems[id])
+	}
+	return list
+}
+
+func (l *versionList[T]) idList() []string {
+	return append([]string{}, l.ids...)
+}
+
+// changes returns the changes since version of versionID, full when the client has to
+// sync the whole list.
+func (l *versionList[T]) changes(versionID string, version uint64) (full bool, insert, update []T, del []string) {
+	if versionID != l.versionID || version == 0 || version > l.version {
+		return true, nil, nil, nil
+	}
+	type change struct {
+		insert, delete bool
+	}
+	var (
+		ids     []string
+		changed = make(map[string]*change)
+	)
+	for _, log := range l.logs {
+		if log.version <= version || log.id == "" {
+			continue
+		}
+		c, ok := changed[log.id]
+		if !ok {
+			// the first change tells whether the client has the element
+			c = &change{insert: log.insert}
+			changed[log.id] = c
+			ids = append(ids, log.id)
+		}
+		c.delete = log.delete
+	}
+	for _, id := range ids {
+		c := changed[id]
+		switch {
+		case c.delete && !c.insert:
+			del = append(del, id)
+		case c.delete:
+		case c.insert:
+			insert = append(insert, l.elems[id])
+		default:
+			update = append(update, l.elems[id])
+		}
+	}
+	return false, insert, update, del
+}
+
+// copyMsg returns a deep copy of m, the server never hands out its own state.
+func copyMsg[T proto.Message](m T) T {
+	return proto.Clone(m).(T)
+}
@@ -0,0 +1,434 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build faultinject
+
+package network
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/internal/interaction"
+)
+
+// FaultKind is what a FaultRule does to the frames or the API calls it hits.
+type FaultKind int
+
+const (
+	// FaultDrop loses the frame. An API call is handled by the server but its response is lost.
+	FaultDrop FaultKind = iota + 1
+	// FaultDelay holds the frame or the API call for FaultRule.Delay.
+	FaultDelay
+	// FaultDuplicate delivers the frame twice. An API call is sent twice.
+	FaultDuplicate
+	// FaultReorder delivers the frame after the next one. An API call is held until another call
+	// completes, at most FaultRule.Delay.
+	FaultReorder
+	// FaultCorrupt truncates the frame or the response body and inverts its first byte, so the
+	// receiver can not decode it.
+	FaultCorrupt
+	// FaultDisconnect closes the long connection instead of the frame. An API call fails without
+	// reaching the server.
+	FaultDisconnect
+)
+
+func (k FaultKind) String() string {
+	switch k {
+	case FaultDrop:
+		return "drop"
+	case FaultDelay:
+		return "delay"
+	case FaultDuplicate:
+		return "duplicate"
+	case FaultReorder:
+		return "reorder"
+	case FaultCorrupt:
+		return "corrupt"
+	case FaultDisconnect:
+		return "disconnect"
+	default:
+		return "unknown"
+	}
+}
+
+// FaultTarget selects the traffic of a FaultRule.
+type FaultTarget int
+
+const (
+	// FaultRead is the data frames read from the long connection.
+	FaultRead FaultTarget = iota + 1
+	// FaultWrite is the data frames written to the long connection.
+	FaultWrite
+	// FaultHTTP is the API calls of ApiPost.
+	FaultHTTP
+)
+
+// Frame is a frame of the long connection or an API call, as seen by FaultRule.Match.
+type Frame struct {
+	Target FaultTarget
+	// Path is the route of an API call.
+	Path string
+	// Data is the frame, or the request body of an API call.
+	Data []byte
+}
+
+// FaultRule injects Kind into the frames of Target it hits.
+type FaultRule struct {
+	Target FaultTarget
+	Kind   FaultKind
+	// Match selects the frames of the rule, nil selects all of them.
+	Match func(frame Frame) bool
+	// Probability is the chance of hitting a selected frame, decided by the seed of the script.
+	// 1 hits every frame.
+	Probability float64
+	// Skip is the number of selected frames passed before the rule applies.
+	Skip int
+	// Limit is the largest number of hits, 0 is unlimited.
+	Limit int
+	// Delay is the delay of FaultDelay and the longest hold of FaultReorder on API calls.
+	Delay time.Duration
+}
+
+// FaultScript is a seeded list of rules, a frame gets the fault of the first rule hitting it. The
+// hits only depend on the seed and the order of the frames, a script replays the same faults
+// against the same traffic.
+type FaultScript struct {
+	Seed  int64
+	Rules []FaultRule
+}
+
+// FaultEvent records a fault injected into the frame number Frame of the rule Rule.
+type FaultEvent struct {
+	Rule   int
+	Target FaultTarget
+	Kind   FaultKind
+	Frame  int
+}
+
+// FaultInjector applies a FaultScript to long connections and to the API client.
+type FaultInjector struct {
+	script FaultScript
+
+	mu       sync.Mutex
+	selected []int
+	hits     []int
+	events   []FaultEvent
+	// callDone is closed when an API call completes, it releases the reordered calls.
+	callDone chan struct{}
+}
+
+var errFaultInjected = errors.New("fault injected")
+
+func NewFaultInjector(script FaultScript) *FaultInjector {
+	return &FaultInjector{
+		script:   script,
+		selected: make([]int, len(script.Rules)),
+		hits:     make([]int, len(script.Rules)),
+		callDone: make(chan struct{}),
+	}
+}
+
+// Events returns the faults injected so far, in order.
+func (f *FaultInjector) Events() []FaultEvent {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]FaultEvent(nil), f.events...)
+}
+
+// fault returns the rule hitting frame.
+func (f *FaultInjector) fault(frame Frame) (FaultRule, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i, rule := range f.script.Rules {
+		if rule.Target != frame.Target || (rule.Match != nil && !rule.Match(frame)) {
+			continue
+		}
+		n := f.selected[i]
+		f.selected[i]++
+		if n < rule.Skip || (rule.Limit > 0 && f.hits[i] >= rule.Limit) {
+			continue
+		}
+		if chance(f.script.Seed, i, n) >= rule.Probability {
+			continue
+		}
+		f.hits[i]++
+		f.events = append(f.events, FaultEvent{Rule: i, Target: frame.Target, Kind: rule.Kind, Frame: n})
+		return rule, true
+	}
+	return FaultRule{}, false
+}
+
+// chance returns a number in [0, 1) derived from seed, rule and frame with splitmix64.
+func chance(seed int64, rule, frame int) float64 {
+	x := uint64(seed) + uint64(rule)<<32 + uint64(frame)
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	x ^= x >> 31
+	return float64(x>>11) / (1 << 53)
+}
+
+func corrupt(data []byte) []byte {
+	out := append([]byte(nil), data[:(len(data)+1)/2]...)
+	if len(out) > 0 {
+		out[0] ^= 0xff
+	}
+	return out
+}
+
+// InjectFaults applies f to the API client and to the long connections of the LongConnMgrs
+// created afterwards, until restore is called. The injector only exists in builds with the
+// faultinject tag, so it never ships in the SDK.
+func InjectFaults(f *FaultInjector) (restore func()) {
+	transport := apiClient.Transport
+	next := transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	apiClient.Transport = f.RoundTripper(next)
+	wrapper := interaction.SetLongConnWrapper(f.WrapConn)
+	return func() {
+		apiClient.Transport = transport
+		interaction.SetLongConnWrapper(wrapper)
+	}
+}
+
+// WrapConn returns conn with the faults of FaultRead and FaultWrite, control frames pass through.
+func (f *FaultInjector) WrapConn(conn interaction.LongConn) interaction.LongConn {
+	return &faultConn{LongConn: conn, f: f}
+}
+
+type frame struct {
+	messageType int
+	data        []byte
+}
+
+type faultConn struct {
+	interaction.LongConn
+	f *FaultInjector
+
+	// pending are the frames read before, returned ahead of the connection.
+	pending []frame
+	// heldRead and heldWrite are the reordered frames, delivered after the next one.
+	heldRead  *frame
+	writeMu   sync.Mutex
+	heldWrite *frame
+}
+
+func isDataFrame(messageType int) bool {
+	return messageType == interaction.MessageBinary || messageType == interaction.MessageText
+}
+
+func (c *faultConn) ReadMessage() (int, []byte, error) {
+	if len(c.pending) > 0 {
+		next := c.pending[0]
+		c.pending = c.pending[1:]
+		return next.messageType, next.data, nil
+	}
+	for {
+		messageType, data, err := c.LongConn.ReadMessage()
+		if err != nil {
+			// a reordered frame is lost with the connection
+			c.heldRead = nil
+			return messageType, data, err
+		}
+		if !isDataFrame(messageType) {
+			return messageType, data, nil
+		}
+		rule, ok := c.f.fault(Frame{Target: FaultRead, Data: data})
+		if !ok {
+			c.release(&c.heldRead)
+			return messageType, data, nil
+		}
+		switch rule.Kind {
+		case FaultDrop:
+			continue
+		case FaultDelay:
+			time.Sleep(rule.Delay)
+		case FaultDuplicate:
+			c.pending = append(c.pending, frame{messageType: messageType, data: append([]byte(nil), data...)})
+		case FaultReorder:
+			if c.heldRead == nil {
+				c.heldRead = &frame{messageType: messageType, data: data}
+				continue
+			}
+		case FaultCorrupt:
+			data = corrupt(data)
+		case FaultDisconnect:
+			c.heldRead = nil
+			_ = c.LongConn.Close()
+			return 0, nil, errFaultInjected
+		}
+		c.release(&c.heldRead)
+		return messageType, data, nil
+	}
+}
+
+// release queues the held frame after the frame being returned.
+func (c *faultConn) release(held **frame) {
+	if *held != nil {
+		c.pending = append(c.pending, **held)
+		*held = nil
+	}
+}
+
+func (c *faultConn) WriteMessage(messageType int, message []byte) error {
+	if !isDataFrame(messageType) {
+		return c.LongConn.WriteMessage(messageType, message)
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	rule, ok := c.f.fault(Frame{Target: FaultWrite, Data: message})
+	if !ok {
+		return c.writeHeld(messageType, message)
+	}
+	switch rule.Kind {
+	case FaultDrop:
+		return nil
+	case FaultDelay:
+		time.Sleep(rule.Delay)
+	case FaultDuplicate:
+		if err := c.LongConn.WriteMessage(messageType, message); err != nil {
+			return err
+		}
+	case FaultReorder:
+		if c.heldWrite == nil {
+			c.heldWrite = &frame{messageType: messageType, data: append([]byte(nil), message...)}
+			return nil
+		}
+	case FaultCorrupt:
+		message = corrupt(message)
+	case FaultDisconnect:
+		c.heldWrite = nil
+		_ = c.LongConn.Close()
+		return errFaultInjected
+	}
+	return c.writeHeld(messageType, message)
+}
+
+// writeHeld writes message and then the reordered frame.
+func (c *faultConn) writeHeld(messageType int, message []byte) error {
+	if err := c.LongConn.WriteMessage(messageType, message); err != nil {
+		return err
+	}
+	if held := c.heldWrite; held != nil {
+		c.heldWrite = nil
+		return c.LongConn.WriteMessage(held.messageType, held.data)
+	}
+	return nil
+}
+
+// RoundTripper returns next with the faults of FaultHTTP.
+func (f *FaultInjector) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	return &faultTransport{f: f, next: next}
+}
+
+type faultTransport struct {
+	f    *FaultInjector
+	next http.RoundTripper
+}
+
+func (t *faultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	rule, ok := t.f.fault(Frame{Target: FaultHTTP, Path: req.URL.Path, Data: body})
+	if !ok {
+		return t.do(req, body)
+	}
+	switch rule.Kind {
+	case FaultDrop:
+		resp, err := t.do(req, body)
+		if err != nil {
+			return nil, err
+		}
+		_ = resp.Body.Close()
+		return nil, errFaultInjected
+	case FaultDelay:
+		if err := sleep(req, rule.Delay); err != nil {
+			return nil, err
+		}
+	case FaultDuplicate:
+		resp, err := t.do(req.Clone(req.Context()), body)
+		if err != nil {
+			return nil, err
+		}
+		_ = resp.Body.Close()
+	case FaultReorder:
+		t.f.mu.Lock()
+		done := t.f.callDone
+		t.f.mu.Unlock()
+		select {
+		case <-done:
+		case <-time.After(rule.Delay):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	case FaultCorrupt:
+		resp, err := t.do(req, body)
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		// the corrupted body is served as is
+		resp.Header.Del("Content-Encoding")
+		data = corrupt(data)
+		resp.Body, resp.ContentLength = io.NopCloser(bytes.NewReader(data)), int64(len(data))
+		return resp, nil
+	case FaultDisconnect:
+		return nil, errFaultInjected
+	}
+	return t.do(req, body)
+}
+
+// do sends req with body and releases the reordered calls.
+func (t *faultTransport) do(req *http.Request, body []byte) (*http.Response, error) {
+	if body != nil {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	defer func() {
+		t.f.mu.Lock()
+		close(t.f.callDone)
+		t.f.callDone = make(chan struct{})
+		t.f.mu.Unlock()
+	}()
+	return t.next.RoundTrip(req)
+}
+
+func sleep(req *http.Request, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
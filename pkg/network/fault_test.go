@@ -0,0 +1,213 @@
+//go:build faultinject && !js
+
+package network
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/openim-sdk-core/v3/internal/interaction"
+)
+
+// memConn is a LongConn reading the frames of reads and recording the frames written.
+type memConn struct {
+	interaction.LongConn
+	reads  []string
+	writes []string
+	closed bool
+}
+
+func (c *memConn) ReadMessage() (int, []byte, error) {
+	if c.closed || len(c.reads) == 0 {
+		return 0, nil, io.EOF
+	}
+	data := c.reads[0]
+	c.reads = c.reads[1:]
+	return interaction.MessageBinary, []byte(data), nil
+}
+
+func (c *memConn) WriteMessage(messageType int, message []byte) error {
+	c.writes = append(c.writes, string(message))
+	return nil
+}
+
+func (c *memConn) Close() error {
+	c.closed = true
+	return nil
+}
+
+func readAll(conn interaction.LongConn) []string {
+	var frames []string
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return frames
+		}
+		frames = append(frames, string(data))
+	}
+}
+
+// nth hits the frames of the indexes.
+func nth(indexes ...int) func(Frame) bool {
+	n := 0
+	return func(Frame) bool {
+		defer func() { n++ }()
+		for _, i := range indexes {
+			if i == n {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func TestFaultConnRead(t *testing.T) {
+	frames := []string{"1", "2", "3", "4", "5"}
+	tests := []struct {
+		kind FaultKind
+		want []string
+	}{
+		{FaultDrop, []string{"1", "3", "4", "5"}},
+		{FaultDelay, []string{"1", "2", "3", "4", "5"}},
+		{FaultDuplicate, []string{"1", "2", "2", "3", "4", "5"}},
+		{FaultReorder, []string{"1", "3", "2", "4", "5"}},
+		{FaultCorrupt, []string{"1", "\xcd", "3", "4", "5"}},
+		{FaultDisconnect, []string{"1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			f := NewFaultInjector(FaultScript{Rules: []FaultRule{
+				{Target: FaultRead, Kind: tt.kind, Match: nth(1), Probability: 1, Delay: time.Millisecond},
+			}})
+			conn := &memConn{reads: append([]string(nil), frames...)}
+			if got := readAll(f.WrapConn(conn)); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("read %q, want %q", got, tt.want)
+			}
+			if events := f.Events(); len(events) != 1 || events[0].Kind != tt.kind {
+				t.Fatalf("events %v", events)
+			}
+		})
+	}
+}
+
+func TestFaultConnWrite(t *testing.T) {
+	tests := []struct {
+		kind FaultKind
+		want []string
+	}{
+		{FaultDrop, []string{"1", "3"}},
+		{FaultDuplicate, []string{"1", "2", "2", "3"}},
+		{FaultReorder, []string{"1", "3", "2"}},
+		{FaultCorrupt, []string{"1", "\xcd", "3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			f := NewFaultInjector(FaultScript{Rules: []FaultRule{
+				{Target: FaultWrite, Kind: tt.kind, Skip: 1, Limit: 1, Probability: 1},
+			}})
+			conn := &memConn{}
+			wrapped := f.WrapConn(conn)
+			for _, data := range []string{"1", "2", "3"} {
+				if err := wrapped.WriteMessage(interaction.MessageBinary, []byte(data)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if !reflect.DeepEqual(conn.writes, tt.want) {
+				t.Fatalf("wrote %q, want %q", conn.writes, tt.want)
+			}
+		})
+	}
+}
+
+func TestFaultScriptReplay(t *testing.T) {
+	script := FaultScript{Seed: 42, Rules: []FaultRule{
+		{Target: FaultRead, Kind: FaultDrop, Probability: 0.3},
+		{Target: FaultRead, Kind: FaultDuplicate, Probability: 0.2, Limit: 3},
+	}}
+	run := func(seed int64) ([]string, []FaultEvent) {
+		script.Seed = seed
+		f := NewFaultInjector(script)
+		conn := &memConn{}
+		for i := 0; i < 100; i++ {
+			conn.reads = append(conn.reads, strings.Repeat("x", i+1))
+		}
+		return readAll(f.WrapConn(conn)), f.Events()
+	}
+	frames, events := run(42)
+	again, eventsAgain := run(42)
+	if !reflect.DeepEqual(frames, again) || !reflect.DeepEqual(events, eventsAgain) {
+		t.Fatal("the script does not replay")
+	}
+	var drops, duplicates int
+	for _, event := range events {
+		switch event.Kind {
+		case FaultDrop:
+			drops++
+		case FaultDuplicate:
+			duplicates++
+		}
+	}
+	if drops < 15 || drops > 45 || duplicates != 3 {
+		t.Fatalf("%d drops and %d duplicates", drops, duplicates)
+	}
+	if other, _ := run(43); reflect.DeepEqual(frames, other) {
+		t.Fatal("another seed injects the same faults")
+	}
+}
+
+func TestFaultTransport(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+	tests := []struct {
+		kind  FaultKind
+		calls int32
+		body  string
+		err   bool
+	}{
+		{FaultDrop, 1, "", true},
+		{FaultDelay, 1, "hello", false},
+		{FaultDuplicate, 2, "hello", false},
+		{FaultReorder, 1, "hello", false},
+		{FaultCorrupt, 1, "\x97el", false},
+		{FaultDisconnect, 0, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			calls.Store(0)
+			f := NewFaultInjector(FaultScript{Rules: []FaultRule{
+				{Target: FaultHTTP, Kind: tt.kind, Probability: 1, Delay: time.Millisecond,
+					Match: func(frame Frame) bool { return frame.Path == "/api" }},
+			}})
+			client := &http.Client{Transport: f.RoundTripper(http.DefaultTransport)}
+			resp, err := client.Post(server.URL+"/api", "text/plain", strings.NewReader("hello"))
+			if tt.err {
+				if err == nil {
+					t.Fatal("no error")
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				body, _ := io.ReadAll(resp.Body)
+				_ = resp.Body.Close()
+				if string(body) != tt.body {
+					t.Fatalf("body %q, want %q", body, tt.body)
+				}
+			}
+			if calls.Load() != tt.calls {
+				t.Fatalf("%d calls, want %d", calls.Load(), tt.calls)
+			}
+		})
+	}
+}
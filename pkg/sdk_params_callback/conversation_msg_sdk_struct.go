@@ -15,6 +15,7 @@
 package sdk_params_callback
 
 import (
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
 	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
 )
 
@@ -42,6 +43,51 @@ type GetAdvancedHistoryMessageListCallback struct {
 	ErrMsg      string                  `json:"errMsg"`
 }
 
+type GetThreadMessagesParams struct {
+	ConversationID   string `json:"conversationID"`
+	RootClientMsgID  string `json:"rootClientMsgID"`
+	StartClientMsgID string `json:"startClientMsgID"`
+	Count            int    `json:"count"`
+}
+
+type GetThreadMessagesCallback struct {
+	RootMessage *sdk_struct.MsgStruct   `json:"rootMessage,omitempty"`
+	MessageList []*sdk_struct.MsgStruct `json:"messageList"`
+	IsEnd       bool                    `json:"isEnd"`
+}
+
+type ConversationLabel struct {
+	LabelID         string   `json:"labelID"`
+	Name            string   `json:"name"`
+	Order           int32    `json:"order"`
+	CreateTime      int64    `json:"createTime"`
+	ConversationIDs []string `json:"conversationIDs"`
+	// UnreadCount sums the unread counts of the conversations in the label, leaving out the
+	// ones not notifying, as the total unread count does.
+	UnreadCount int32 `json:"unreadCount"`
+}
+
+type GetMentionMessagesParams struct {
+	// StartConversationID and StartClientMsgID are the last mention of the previous page, empty
+	// for the latest mentions.
+	StartConversationID string `json:"startConversationID"`
+	StartClientMsgID    string `json:"startClientMsgID"`
+	Count               int    `json:"count"`
+	UnreadOnly          bool   `json:"unreadOnly"`
+}
+
+type MentionMessage struct {
+	ConversationID string                `json:"conversationID"`
+	MentionType    int32                 `json:"mentionType"`
+	IsRead         bool                  `json:"isRead"`
+	Message        *sdk_struct.MsgStruct `json:"message"`
+}
+
+type GetMentionMessagesCallback struct {
+	MentionList []*MentionMessage `json:"mentionList"`
+	IsEnd       bool              `json:"isEnd"`
+}
+
 type FetchSurroundingMessagesReq struct {
 	StartMessage *sdk_struct.MsgStruct `json:"startMessage"`
 	ViewType     int                   `json:"viewType"`
@@ -77,4 +123,51 @@ type SearchByConversationResult struct {
 	LatestMsgSendTime int64                   `json:"latestMsgSendTime,omitempty"`
 	MessageCount      int                     `json:"messageCount"`
 	MessageList       []*sdk_struct.MsgStruct `json:"messageList"`
+	SnippetList       []*MessageSnippet       `json:"snippetList,omitempty"`
+}
+
+type ScheduledMessage struct {
+	Message    *sdk_struct.MsgStruct `json:"message"`
+	RecvID     string                `json:"recvID"`
+	GroupID    string                `json:"groupID"`
+	SendAt     int64                 `json:"sendAt"`
+	CreateTime int64                 `json:"createTime"`
+}
+
+type MessageReactions struct {
+	ClientMsgID  string                     `json:"clientMsgID"`
+	ReactionList []*sdk_struct.ReactionElem `json:"reactionList"`
+}
+
+type PinnedMessage struct {
+	Message        *sdk_struct.MsgStruct `json:"message"`
+	OperatorUserID string                `json:"operatorUserID"`
+	PinTime        int64                 `json:"pinTime"`
+}
+
+type MessagePinChange struct {
+	ConversationID string `json:"conversationID"`
+	ClientMsgID    string `json:"clientMsgID"`
+	IsPinned       bool   `json:"isPinned"`
+	OperatorUserID string `json:"operatorUserID"`
+	OperateTime    int64  `json:"operateTime"`
+}
+
+type GroupMessageReaderList struct {
+	TotalCount      int                              `json:"totalCount"`
+	GroupMemberList []*model_struct.LocalGroupMember `json:"groupMemberList"`
+}
+
+type ImportArchiveResp struct {
+	ConversationCount int `json:"conversationCount"`
+	InsertedCount     int `json:"insertedCount"`
+	SkippedCount      int `json:"skippedCount"`
+}
+
+// MessageSnippet is the part of a matched message around its first keyword match.
+// Offsets are [start, end) rune offsets of every keyword match within Snippet.
+type MessageSnippet struct {
+	ClientMsgID string   `json:"clientMsgID"`
+	Snippet     string   `json:"snippet"`
+	Offsets     [][2]int `json:"offsets"`
 }
@@ -40,6 +40,7 @@ const (
 	MsgContentTypeNotSupportError = 10205 // Message content type not supported
 	MsgHasNoSeqError              = 10206 // Message does not have a sequence number
 	MsgHasDeletedError            = 10207 // Message has been deleted
+	MsgExpiredError               = 10208 // Message expired in the outbox
 
 	// Conversation-related errors
 	NotSupportOptError  = 10301 // Operation not supported
@@ -49,4 +50,10 @@ const (
 	// Group-related errors
 	GroupIDNotFoundError = 10400 // GroupID not found
 	GroupTypeErr         = 10401 // Invalid group type
+
+	// Database-related errors
+	DBKeyError               = 10500 // Database key is missing or wrong
+	DBEncryptNotSupportError = 10501 // SQLite is built without encryption support
+	DBMigrateError           = 10502 // Database encryption migration failed
+	DBVersionError           = 10503 // Database was written by a newer SDK
 )
@@ -38,6 +38,7 @@ var (
 	ErrMsgContentTypeNotSupport = errs.NewCodeError(MsgContentTypeNotSupportError, "Message content type not supported")
 	ErrMsgHasNoSeq              = errs.NewCodeError(MsgHasNoSeqError, "Message has no sequence number")
 	ErrMsgHasDeleted            = errs.NewCodeError(MsgHasDeletedError, "Message has been deleted")
+	ErrMsgExpired               = errs.NewCodeError(MsgExpiredError, "Message expired in the outbox before it could be sent")
 
 	// Conversation-related errors
 	ErrNotSupportOpt  = errs.NewCodeError(NotSupportOptError, "Operation not supported for supergroup")
@@ -47,6 +48,12 @@ var (
 	// Group-related errors
 	ErrGroupType = errs.NewCodeError(GroupTypeErr, "Invalid group type")
 
+	// Database-related errors
+	ErrDBKey               = errs.NewCodeError(DBKeyError, "Database key is missing or wrong")
+	ErrDBEncryptNotSupport = errs.NewCodeError(DBEncryptNotSupportError, "Database encryption not supported")
+	ErrDBMigrate           = errs.NewCodeError(DBMigrateError, "Database encryption migration failed")
+	ErrDBVersion           = errs.NewCodeError(DBVersionError, "Database was written by a newer SDK version")
+
 	ErrLoginOut    = errs.NewCodeError(LoginOutError, "User has logged out")
 	ErrLoginRepeat = errs.NewCodeError(LoginRepeatError, "User has logged in repeatedly")
 )
@@ -0,0 +1,87 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_api_params
+
+type EditMsgReq struct {
+	UserID         string `json:"userID"`
+	ConversationID string `json:"conversationID"`
+	Seq            int64  `json:"seq"`
+	ClientMsgID    string `json:"clientMsgID"`
+	Content        string `json:"content"`
+}
+
+type EditMsgResp struct {
+	EditTime int64 `json:"editTime"`
+}
+
+// MsgEditedTips is the notification detail broadcast to every member of the
+// conversation (and the editor's other devices) after a message is edited.
+type MsgEditedTips struct {
+	ConversationID string `json:"conversationID"`
+	SessionType    int32  `json:"sessionType"`
+	ClientMsgID    string `json:"clientMsgID"`
+	Seq            int64  `json:"seq"`
+	EditorUserID   string `json:"editorUserID"`
+	Content        string `json:"content"`
+	EditTime       int64  `json:"editTime"`
+}
+
+type MsgReactionReq struct {
+	UserID         string `json:"userID"`
+	ConversationID string `json:"conversationID"`
+	Seq            int64  `json:"seq"`
+	ClientMsgID    string `json:"clientMsgID"`
+	ReactionType   int    `json:"reactionType"`
+	Info           string `json:"info,omitempty"`
+}
+
+type MsgReactionResp struct {
+	OperateTime int64 `json:"operateTime"`
+}
+
+// MsgReactionTips is the notification detail broadcast to every member of the
+// conversation (and the reacting user's other devices) after a reaction is added or removed.
+type MsgReactionTips struct {
+	ConversationID string `json:"conversationID"`
+	ClientMsgID    string `json:"clientMsgID"`
+	Seq            int64  `json:"seq"`
+	UserID         string `json:"userID"`
+	ReactionType   int    `json:"reactionType"`
+	Info           string `json:"info,omitempty"`
+	IsRemoved      bool   `json:"isRemoved"`
+	OperateTime    int64  `json:"operateTime"`
+}
+
+type MsgPinReq struct {
+	UserID         string `json:"userID"`
+	ConversationID string `json:"conversationID"`
+	Seq            int64  `json:"seq"`
+	ClientMsgID    string `json:"clientMsgID"`
+}
+
+type MsgPinResp struct {
+	OperateTime int64 `json:"operateTime"`
+}
+
+// MsgPinTips is the notification detail broadcast to every member of the
+// conversation (and the operator's other devices) after a message is pinned or unpinned.
+type MsgPinTips struct {
+	ConversationID string `json:"conversationID"`
+	ClientMsgID    string `json:"clientMsgID"`
+	Seq            int64  `json:"seq"`
+	OperatorUserID string `json:"operatorUserID"`
+	IsPinned       bool   `json:"isPinned"`
+	OperateTime    int64  `json:"operateTime"`
+}
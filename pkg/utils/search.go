@@ -0,0 +1,92 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+// SearchableText returns the text of a message that keyword search matches against,
+// or "" when the content type is not searchable.
+func SearchableText(contentType int32, content string) string {
+	switch contentType {
+	case constant.Text:
+		var elem sdk_struct.TextElem
+		_ = JsonStringToStruct(content, &elem)
+		return elem.Content
+	case constant.AtText:
+		var elem sdk_struct.AtTextElem
+		_ = JsonStringToStruct(content, &elem)
+		return elem.Text
+	case constant.AdvancedText:
+		var elem sdk_struct.AdvancedTextElem
+		_ = JsonStringToStruct(content, &elem)
+		return elem.Text
+	case constant.File:
+		var elem sdk_struct.FileElem
+		_ = JsonStringToStruct(content, &elem)
+		return elem.FileName
+	case constant.Quote:
+		var elem sdk_struct.QuoteElem
+		_ = JsonStringToStruct(content, &elem)
+		return elem.Text
+	case constant.Merger:
+		var elem sdk_struct.MergeElem
+		_ = JsonStringToStruct(content, &elem)
+		return strings.Join(append([]string{elem.Title}, elem.AbstractList...), " ")
+	case constant.Card:
+		var elem sdk_struct.CardElem
+		_ = JsonStringToStruct(content, &elem)
+		return elem.Nickname
+	case constant.Location:
+		var elem sdk_struct.LocationElem
+		_ = JsonStringToStruct(content, &elem)
+		return elem.Description
+	case constant.Custom:
+		var elem sdk_struct.CustomElem
+		_ = JsonStringToStruct(content, &elem)
+		return elem.Description
+	default:
+		return ""
+	}
+}
+
+// IsCJK reports whether r belongs to a script written without spaces between words.
+func IsCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
+
+// SegmentSearchText separates every CJK character with spaces so that a word based tokenizer
+// indexes them one by one. A CJK keyword segmented the same way then matches as a phrase,
+// which keeps substring semantics for text that has no word boundaries.
+func SegmentSearchText(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) * 2)
+	for _, r := range s {
+		if IsCJK(r) {
+			b.WriteByte(' ')
+			b.WriteRune(r)
+			b.WriteByte(' ')
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
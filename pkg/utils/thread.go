@@ -0,0 +1,39 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/constant"
+	"github.com/openimsdk/openim-sdk-core/v3/sdk_struct"
+)
+
+// ThreadRootID returns the client message ID of the thread root a message replies to, or ""
+// when the message is not a reply. Quotes written before threads existed reply to the quoted message.
+func ThreadRootID(contentType int32, content string) string {
+	if contentType != constant.Quote {
+		return ""
+	}
+	var elem sdk_struct.QuoteElem
+	if err := JsonStringToStruct(content, &elem); err != nil {
+		return ""
+	}
+	if elem.ThreadRootID != "" {
+		return elem.ThreadRootID
+	}
+	if elem.QuoteMessage != nil {
+		return elem.QuoteMessage.ClientMsgID
+	}
+	return ""
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.33.0
+// 	protoc-gen-go v1.35.1
 // 	protoc        v5.26.0
 // source: init.proto
 
@@ -104,15 +104,19 @@ type IMConfig struct {
 	IsLogStandardOutput  bool       `protobuf:"varint,7,opt,name=isLogStandardOutput,proto3" json:"isLogStandardOutput,omitempty"`
 	LogFilePath          string     `protobuf:"bytes,8,opt,name=logFilePath,proto3" json:"logFilePath,omitempty"`
 	IsExternalExtensions bool       `protobuf:"varint,9,opt,name=isExternalExtensions,proto3" json:"isExternalExtensions,omitempty"`
+	WsCodec              string     `protobuf:"bytes,10,opt,name=wsCodec,proto3" json:"wsCodec,omitempty"`
+	Compression          string     `protobuf:"bytes,11,opt,name=compression,proto3" json:"compression,omitempty"`
+	CompressionThreshold int32      `protobuf:"varint,12,opt,name=compressionThreshold,proto3" json:"compressionThreshold,omitempty"`
+	Transport            string     `protobuf:"bytes,13,opt,name=transport,proto3" json:"transport,omitempty"`
+	DbKey                string     `protobuf:"bytes,14,opt,name=dbKey,proto3" json:"dbKey,omitempty"`
+	Storage              string     `protobuf:"bytes,15,opt,name=storage,proto3" json:"storage,omitempty"`
 }
 
 func (x *IMConfig) Reset() {
 	*x = IMConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_init_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_init_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *IMConfig) String() string {
@@ -123,7 +127,7 @@ func (*IMConfig) ProtoMessage() {}
 
 func (x *IMConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_init_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -201,6 +205,48 @@ func (x *IMConfig) GetIsExternalExtensions() bool {
 	return false
 }
 
+func (x *IMConfig) GetWsCodec() string {
+	if x != nil {
+		return x.WsCodec
+	}
+	return ""
+}
+
+func (x *IMConfig) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *IMConfig) GetCompressionThreshold() int32 {
+	if x != nil {
+		return x.CompressionThreshold
+	}
+	return 0
+}
+
+func (x *IMConfig) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
+}
+
+func (x *IMConfig) GetDbKey() string {
+	if x != nil {
+		return x.DbKey
+	}
+	return ""
+}
+
+func (x *IMConfig) GetStorage() string {
+	if x != nil {
+		return x.Storage
+	}
+	return ""
+}
+
 type InitSDKReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -211,11 +257,9 @@ type InitSDKReq struct {
 
 func (x *InitSDKReq) Reset() {
 	*x = InitSDKReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_init_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_init_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *InitSDKReq) String() string {
@@ -226,7 +270,7 @@ func (*InitSDKReq) ProtoMessage() {}
 
 func (x *InitSDKReq) ProtoReflect() protoreflect.Message {
 	mi := &file_init_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -258,11 +302,9 @@ type InitSDKResp struct {
 
 func (x *InitSDKResp) Reset() {
 	*x = InitSDKResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_init_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_init_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *InitSDKResp) String() string {
@@ -273,7 +315,7 @@ func (*InitSDKResp) ProtoMessage() {}
 
 func (x *InitSDKResp) ProtoReflect() protoreflect.Message {
 	mi := &file_init_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -306,11 +348,9 @@ type LoginReq struct {
 
 func (x *LoginReq) Reset() {
 	*x = LoginReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_init_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_init_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *LoginReq) String() string {
@@ -321,7 +361,7 @@ func (*LoginReq) ProtoMessage() {}
 
 func (x *LoginReq) ProtoReflect() protoreflect.Message {
 	mi := &file_init_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -358,11 +398,9 @@ type LoginResp struct {
 
 func (x *LoginResp) Reset() {
 	*x = LoginResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_init_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_init_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *LoginResp) String() string {
@@ -373,7 +411,7 @@ func (*LoginResp) ProtoMessage() {}
 
 func (x *LoginResp) ProtoReflect() protoreflect.Message {
 	mi := &file_init_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -388,11 +426,164 @@ func (*LoginResp) Descriptor() ([]byte, []int) {
 	return file_init_proto_rawDescGZIP(), []int{4}
 }
 
+type LogoutReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LogoutReq) Reset() {
+	*x = LogoutReq{}
+	mi := &file_init_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutReq) ProtoMessage() {}
+
+func (x *LogoutReq) ProtoReflect() protoreflect.Message {
+	mi := &file_init_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutReq.ProtoReflect.Descriptor instead.
+func (*LogoutReq) Descriptor() ([]byte, []int) {
+	return file_init_proto_rawDescGZIP(), []int{5}
+}
+
+type LogoutResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LogoutResp) Reset() {
+	*x = LogoutResp{}
+	mi := &file_init_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResp) ProtoMessage() {}
+
+func (x *LogoutResp) ProtoReflect() protoreflect.Message {
+	mi := &file_init_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResp.ProtoReflect.Descriptor instead.
+func (*LogoutResp) Descriptor() ([]byte, []int) {
+	return file_init_proto_rawDescGZIP(), []int{6}
+}
+
+type GetLoginStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetLoginStatusReq) Reset() {
+	*x = GetLoginStatusReq{}
+	mi := &file_init_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoginStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoginStatusReq) ProtoMessage() {}
+
+func (x *GetLoginStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_init_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoginStatusReq.ProtoReflect.Descriptor instead.
+func (*GetLoginStatusReq) Descriptor() ([]byte, []int) {
+	return file_init_proto_rawDescGZIP(), []int{7}
+}
+
+type GetLoginStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetLoginStatusResp) Reset() {
+	*x = GetLoginStatusResp{}
+	mi := &file_init_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoginStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoginStatusResp) ProtoMessage() {}
+
+func (x *GetLoginStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_init_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoginStatusResp.ProtoReflect.Descriptor instead.
+func (*GetLoginStatusResp) Descriptor() ([]byte, []int) {
+	return file_init_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetLoginStatusResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
 var File_init_proto protoreflect.FileDescriptor
 
 var file_init_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x22, 0xd3, 0x02, 0x0a, 0x08, 0x49, 0x4d,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x22, 0x91, 0x04, 0x0a, 0x08, 0x49, 0x4d,
 	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
 	0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74,
 	0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
@@ -413,29 +604,47 @@ var file_init_proto_rawDesc = []byte{
 	0x6c, 0x6f, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x32, 0x0a, 0x14, 0x69,
 	0x73, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
 	0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x3b, 0x0a, 0x0a, 0x49, 0x6e, 0x69, 0x74, 0x53, 0x44, 0x4b, 0x52, 0x65, 0x71, 0x12, 0x2d, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x49, 0x4d, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x1f, 0x0a, 0x0b,
-	0x49, 0x6e, 0x69, 0x74, 0x53, 0x44, 0x4b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x73,
-	0x75, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x73, 0x75, 0x63, 0x22, 0x38, 0x0a,
-	0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x0b, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x2a, 0x7f, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x49, 0x64, 0x12, 0x08, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
-	0x69, 0x6f, 0x73, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64,
-	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x10, 0x03, 0x12,
-	0x07, 0x0a, 0x03, 0x78, 0x6f, 0x73, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x77, 0x65, 0x62, 0x10,
-	0x05, 0x12, 0x0c, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x69, 0x5f, 0x77, 0x65, 0x62, 0x10, 0x06, 0x12,
-	0x09, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x61, 0x6e,
-	0x64, 0x72, 0x6f, 0x69, 0x64, 0x5f, 0x70, 0x61, 0x64, 0x10, 0x08, 0x12, 0x08, 0x0a, 0x04, 0x69,
-	0x70, 0x61, 0x64, 0x10, 0x09, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2d, 0x73, 0x64, 0x6b, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x33,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x77, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x77, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x14, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x63, 0x6f, 0x6d, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x62,
+	0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x22, 0x3b, 0x0a,
+	0x0a, 0x49, 0x6e, 0x69, 0x74, 0x53, 0x44, 0x4b, 0x52, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x2e, 0x49, 0x4d, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x1f, 0x0a, 0x0b, 0x49, 0x6e,
+	0x69, 0x74, 0x53, 0x44, 0x4b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x75, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x73, 0x75, 0x63, 0x22, 0x38, 0x0a, 0x08, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x0b, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x0b, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x22,
+	0x0c, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x13, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2a, 0x7f, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x64, 0x12, 0x08,
+	0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x69, 0x6f, 0x73, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x10, 0x02, 0x12, 0x0b,
+	0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x78,
+	0x6f, 0x73, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x77, 0x65, 0x62, 0x10, 0x05, 0x12, 0x0c, 0x0a,
+	0x08, 0x6d, 0x69, 0x6e, 0x69, 0x5f, 0x77, 0x65, 0x62, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x69,
+	0x64, 0x5f, 0x70, 0x61, 0x64, 0x10, 0x08, 0x12, 0x08, 0x0a, 0x04, 0x69, 0x70, 0x61, 0x64, 0x10,
+	0x09, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2d, 0x73, 0x64, 0x6b, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -451,14 +660,18 @@ func file_init_proto_rawDescGZIP() []byte {
 }
 
 var file_init_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_init_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_init_proto_goTypes = []interface{}{
-	(PlatformId)(0),     // 0: openim.init.PlatformId
-	(*IMConfig)(nil),    // 1: openim.init.IMConfig
-	(*InitSDKReq)(nil),  // 2: openim.init.InitSDKReq
-	(*InitSDKResp)(nil), // 3: openim.init.InitSDKResp
-	(*LoginReq)(nil),    // 4: openim.init.LoginReq
-	(*LoginResp)(nil),   // 5: openim.init.LoginResp
+var file_init_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_init_proto_goTypes = []any{
+	(PlatformId)(0),            // 0: openim.init.PlatformId
+	(*IMConfig)(nil),           // 1: openim.init.IMConfig
+	(*InitSDKReq)(nil),         // 2: openim.init.InitSDKReq
+	(*InitSDKResp)(nil),        // 3: openim.init.InitSDKResp
+	(*LoginReq)(nil),           // 4: openim.init.LoginReq
+	(*LoginResp)(nil),          // 5: openim.init.LoginResp
+	(*LogoutReq)(nil),          // 6: openim.init.LogoutReq
+	(*LogoutResp)(nil),         // 7: openim.init.LogoutResp
+	(*GetLoginStatusReq)(nil),  // 8: openim.init.GetLoginStatusReq
+	(*GetLoginStatusResp)(nil), // 9: openim.init.GetLoginStatusResp
 }
 var file_init_proto_depIdxs = []int32{
 	0, // 0: openim.init.IMConfig.platformID:type_name -> openim.init.PlatformId
@@ -475,75 +688,13 @@ func file_init_proto_init() {
 	if File_init_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_init_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IMConfig); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_init_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitSDKReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_init_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitSDKResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_init_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_init_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginResp); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_init_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
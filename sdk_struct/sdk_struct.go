@@ -43,6 +43,11 @@ type MessageRevoked struct {
 	Ex                          string `json:"ex"`
 	IsAdminRevoke               bool   `json:"isAdminRevoke"`
 }
+type InputStatesChangedData struct {
+	ConversationID string  `json:"conversationID"`
+	UserID         string  `json:"userID"`
+	PlatformIDs    []int32 `json:"platformIDs"`
+}
 type MessageReaction struct {
 	ClientMsgID  string `json:"clientMsgID"`
 	ReactionType int    `json:"reactionType"`
@@ -186,6 +191,15 @@ type QuoteElem struct {
 	Text              string           `json:"text,omitempty"`
 	QuoteMessage      *MsgStruct       `json:"quoteMessage,omitempty"`
 	MessageEntityList []*MessageEntity `json:"messageEntityList,omitempty"`
+	// ThreadRootID is the message starting the thread the quote replies in, the quoted message
+	// itself unless that is a reply too.
+	ThreadRootID string `json:"threadRootID,omitempty"`
+}
+
+// ThreadInfo summarizes the replies to a thread root, revoked and deleted replies excluded.
+type ThreadInfo struct {
+	ReplyCount  int64      `json:"replyCount"`
+	LatestReply *MsgStruct `json:"latestReply,omitempty"`
 }
 
 type NotificationElem struct {
@@ -247,6 +261,8 @@ type MsgStruct struct {
 	TypingElem       *TypingElem            `json:"typingElem,omitempty"`
 	StreamElem       *StreamElem            `json:"streamElem,omitempty"`
 	AttachedInfoElem *AttachedInfoElem      `json:"attachedInfoElem,omitempty"`
+	ThreadRootID     string                 `json:"threadRootID,omitempty"`
+	ThreadInfo       *ThreadInfo            `json:"threadInfo,omitempty"`
 }
 
 type AtInfo struct {
@@ -262,7 +278,9 @@ type AttachedInfoElem struct {
 	IsEncryption      bool             `json:"isEncryption"`
 	InEncryptStatus   bool             `json:"inEncryptStatus"`
 	//MessageReactionElem       []*ReactionElem  `json:"messageReactionElem,omitempty"`
-	Progress *UploadProgress `json:"uploadProgress,omitempty"`
+	Progress     *UploadProgress `json:"uploadProgress,omitempty"`
+	EditCount    int32           `json:"editCount,omitempty"`
+	LastEditTime int64           `json:"lastEditTime,omitempty"`
 }
 
 type UploadProgress struct {
@@ -295,6 +313,8 @@ type GroupHasReadInfo struct {
 	HasReadUserIDList []string `json:"hasReadUserIDList,omitempty"`
 	HasReadCount      int32    `json:"hasReadCount"`
 	GroupMemberCount  int32    `json:"groupMemberCount"`
+	// NeedReadReceipt is set by the sender to track which members read the message.
+	NeedReadReceipt bool `json:"needReadReceipt,omitempty"`
 }
 type NewMsgList []*MsgStruct
 
@@ -323,6 +343,63 @@ type IMConfig struct {
 	IsLogStandardOutput  bool   `json:"isLogStandardOutput"`
 	LogFilePath          string `json:"logFilePath"`
 	IsExternalExtensions bool   `json:"isExternalExtensions"`
+	// WsCodec selects the long connection envelope codec: "gob" (default), "json" or "protobuf".
+	WsCodec string `json:"wsCodec,omitempty"`
+	// Compression selects the long connection compressor: "gzip" (default), "zstd" or "none".
+	Compression string `json:"compression,omitempty"`
+	// CompressionThreshold is the smallest frame size in bytes that is compressed, 0 compresses every frame.
+	CompressionThreshold int `json:"compressionThreshold,omitempty"`
+	// Transport selects the long connection transport: "websocket" (default) or "tcp". The tcp
+	// transport dials WsAddr as tcp://host:port, or tls://host:port for an encrypted connection.
+	Transport string `json:"transport,omitempty"`
+	// DBKey encrypts the local database with SQLCipher, an existing plaintext database is
+	// encrypted on the next login. Empty keeps it plaintext. A DBKeyProvider takes precedence.
+	DBKey string `json:"dbKey,omitempty"`
+	// Storage selects the local store: "persistent" (default) keeps it in DataDir, or IndexedDB
+	// in the browser, "memory" keeps it in memory only for tests and stateless bots.
+	Storage string `json:"storage,omitempty"`
+	// Outbox tunes the queue of messages sent while disconnected.
+	Outbox OutboxConfig `json:"outbox"`
+	// Reconnect tunes the backoff of the long connection between reconnect attempts.
+	Reconnect ReconnectConfig `json:"reconnect"`
+}
+
+// OutboxConfig tunes the outbox, zero values use the defaults. Messages sent while disconnected
+// are queued and sent in order once connected, retrying network failures with a doubling backoff.
+type OutboxConfig struct {
+	// Disable fails messages sent while disconnected right away, as before the outbox.
+	Disable bool `json:"disable,omitempty"`
+	// Wait makes SendMessage of a queued message wait until the outbox sends it or gives up on
+	// it. By default SendMessage returns the queued message, still sending, right away and
+	// OnOutboxMessageStatusChanged reports the outcome.
+	Wait bool `json:"wait,omitempty"`
+	// MaxAttempts bounds the sends of a queued message, 5 by default.
+	MaxAttempts int32 `json:"maxAttempts,omitempty"`
+	// RetryInterval is the wait in milliseconds after the first failed send, 1s by default.
+	RetryInterval int64 `json:"retryInterval,omitempty"`
+	// MaxRetryInterval caps the doubled wait in milliseconds, 30s by default.
+	MaxRetryInterval int64 `json:"maxRetryInterval,omitempty"`
+	// Expire is the age in milliseconds after which a queued message fails instead of being
+	// sent, 24h by default.
+	Expire int64 `json:"expire,omitempty"`
+}
+
+// ReconnectConfig tunes the reconnect backoff, zero values use the defaults. The wait starts at
+// Base and is multiplied per failed attempt up to Cap, a network change or a successful
+// connection starts over from Base. Token errors are not retried.
+type ReconnectConfig struct {
+	// Base is the wait in milliseconds after the first failed attempt, 1s by default.
+	Base int64 `json:"base,omitempty"`
+	// Cap caps the wait in milliseconds, 16s by default.
+	Cap int64 `json:"cap,omitempty"`
+	// Multiplier grows the wait per failed attempt, 2 by default.
+	Multiplier float64 `json:"multiplier,omitempty"`
+	// Jitter randomizes the wait so clients dropped together do not reconnect in lockstep:
+	// "none" (default), "full" or "decorrelated".
+	Jitter string `json:"jitter,omitempty"`
+	// MaxAttempts bounds the failed attempts before the SDK waits for a network change, 0 retries
+	// forever.
+	MaxAttempts int32 `json:"maxAttempts,omitempty"`
 }
 
 type CmdNewMsgComeToConversation struct {
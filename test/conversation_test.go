@@ -39,6 +39,30 @@ func Test_GetAllConversationList(t *testing.T) {
 	time.Sleep(time.Second * 100)
 }
 
+func Test_GetConversationLabels(t *testing.T) {
+	labels, err := open_im_sdk.UserForSDK.Conversation().GetConversationLabels(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, label := range labels {
+		conversations, err := open_im_sdk.UserForSDK.Conversation().GetConversationListByLabel(ctx, label.LabelID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Log(label.Name, label.UnreadCount, len(conversations))
+	}
+}
+
+func Test_CreateConversationLabel(t *testing.T) {
+	label, err := open_im_sdk.UserForSDK.Conversation().CreateConversationLabel(ctx, "Work")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := open_im_sdk.UserForSDK.Conversation().AddConversationsToLabel(ctx, label.LabelID, []string{"si_2975755104_6386894923"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func Test_GetConversationListSplit(t *testing.T) {
 	conversations, err := open_im_sdk.UserForSDK.Conversation().GetConversationListSplit(ctx, 0, 20)
 	if err != nil {
@@ -141,6 +165,20 @@ func Test_GetAdvancedHistoryMessageList(t *testing.T) {
 	}
 }
 
+func Test_GetThreadMessages(t *testing.T) {
+	msgs, err := open_im_sdk.UserForSDK.Conversation().GetThreadMessages(ctx, sdk_params_callback.GetThreadMessagesParams{
+		ConversationID:  "si_5318543822_9511766539",
+		RootClientMsgID: "53ca4b3be29f7ea231a5e82e7af8a43f",
+		Count:           40,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range msgs.MessageList {
+		t.Log(v)
+	}
+}
+
 func Test_GetAdvancedHistoryMessageListReverse(t *testing.T) {
 	msgs, err := open_im_sdk.UserForSDK.Conversation().GetAdvancedHistoryMessageListReverse(ctx, sdk_params_callback.GetAdvancedHistoryMessageListParams{
 		ConversationID:   "si_3325086438_5054969402",
@@ -205,6 +243,62 @@ func Test_SetMessageLocalEx(t *testing.T) {
 	}
 }
 
+func Test_EditMessage(t *testing.T) {
+	msg, err := open_im_sdk.UserForSDK.Conversation().EditMessage(ctx, "si_2975755104_6386894923", "53ca4b3be29f7ea231a5e82e7af8a43f", `{"content":"edited"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(msg.AttachedInfoElem.EditCount, msg.AttachedInfoElem.LastEditTime)
+}
+
+func Test_AddMessageReaction(t *testing.T) {
+	reactions, err := open_im_sdk.UserForSDK.Conversation().AddMessageReaction(ctx, "si_2975755104_6386894923", "53ca4b3be29f7ea231a5e82e7af8a43f", 1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(reactions)
+}
+
+func Test_GetMessageReactions(t *testing.T) {
+	reactions, err := open_im_sdk.UserForSDK.Conversation().GetMessageReactions(ctx, "si_2975755104_6386894923", []string{"53ca4b3be29f7ea231a5e82e7af8a43f"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(reactions)
+}
+
+func Test_PinMessage(t *testing.T) {
+	err := open_im_sdk.UserForSDK.Conversation().PinMessage(ctx, "si_2975755104_6386894923", "53ca4b3be29f7ea231a5e82e7af8a43f")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_GetPinnedMessages(t *testing.T) {
+	pinned, err := open_im_sdk.UserForSDK.Conversation().GetPinnedMessages(ctx, "si_2975755104_6386894923")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Log(pinned)
+}
+
+func Test_GetMentionMessages(t *testing.T) {
+	mentions, err := open_im_sdk.UserForSDK.Conversation().GetMentionMessages(ctx, sdk_params_callback.GetMentionMessagesParams{Count: 20, UnreadOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range mentions.MentionList {
+		t.Log(v.ConversationID, v.MentionType, v.Message)
+	}
+}
+
+func Test_MarkMentionsRead(t *testing.T) {
+	err := open_im_sdk.UserForSDK.Conversation().MarkMentionsRead(ctx, "sg_3559225408", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func Test_DeleteAllMsgFromLocalAndSvr(t *testing.T) {
 	err := open_im_sdk.UserForSDK.Conversation().DeleteAllMsgFromLocalAndServer(ctx)
 	if err != nil {
@@ -44,6 +44,10 @@ func (c *OnConnListener) OnUserTokenExpired() {
 	// fmt.Println("OnUserTokenExpired")
 }
 
+func (c *OnConnListener) OnReconnecting(attempt int32, delayMs int64) {
+	// fmt.Println("OnReconnecting")
+}
+
 type onConversationListener struct {
 	ctx context.Context
 	ch  chan error
@@ -83,6 +87,10 @@ func (o *onConversationListener) OnConversationUserInputStatusChanged(change str
 	log.ZInfo(o.ctx, "OnConversationUserInputStatusChanged", "change", change)
 }
 
+func (o *onConversationListener) OnUnreadMentionCountChanged(totalUnreadCount int32) {
+	log.ZInfo(o.ctx, "OnUnreadMentionCountChanged", "totalUnreadCount", totalUnreadCount)
+}
+
 type onGroupListener struct {
 	ctx context.Context
 }
@@ -152,6 +160,22 @@ func (o *onAdvancedMsgListener) OnMsgEdited(message string) {
 	log.ZInfo(o.ctx, "OnMsgEdited", "######## message", message)
 }
 
+func (o *onAdvancedMsgListener) OnScheduledMessageSent(message string) {
+	log.ZInfo(o.ctx, "OnScheduledMessageSent", "message", message)
+}
+
+func (o *onAdvancedMsgListener) OnScheduledMessageFailed(message string, errCode int32, errMsg string) {
+	log.ZInfo(o.ctx, "OnScheduledMessageFailed", "message", message, "errCode", errCode, "errMsg", errMsg)
+}
+
+func (o *onAdvancedMsgListener) OnOutboxMessageStatusChanged(message string, status int32, attempts int32) {
+	log.ZInfo(o.ctx, "OnOutboxMessageStatusChanged", "message", message, "status", status, "attempts", attempts)
+}
+
+func (o *onAdvancedMsgListener) OnMsgPinChanged(pinChange string) {
+	log.ZInfo(o.ctx, "OnMsgPinChanged", "pinChange", pinChange)
+}
+
 func (o *onAdvancedMsgListener) OnRecvOfflineNewMessages(messageList string) {
 	log.ZInfo(o.ctx, "OnRecvOfflineNewMessages", "messageList", messageList)
 }
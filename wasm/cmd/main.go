@@ -82,9 +82,18 @@ func registerFunc() {
 	//js.Global().Set("getMessageListSomeReactionExtensions", js.FuncOf(wrapperConMsg.GetMessageListSomeReactionExtensions))
 	js.Global().Set("getAllConversationList", js.FuncOf(wrapperConMsg.GetAllConversationList))
 	js.Global().Set("getConversationListSplit", js.FuncOf(wrapperConMsg.GetConversationListSplit))
+	js.Global().Set("createConversationLabel", js.FuncOf(wrapperConMsg.CreateConversationLabel))
+	js.Global().Set("renameConversationLabel", js.FuncOf(wrapperConMsg.RenameConversationLabel))
+	js.Global().Set("deleteConversationLabel", js.FuncOf(wrapperConMsg.DeleteConversationLabel))
+	js.Global().Set("reorderConversationLabels", js.FuncOf(wrapperConMsg.ReorderConversationLabels))
+	js.Global().Set("addConversationsToLabel", js.FuncOf(wrapperConMsg.AddConversationsToLabel))
+	js.Global().Set("removeConversationsFromLabel", js.FuncOf(wrapperConMsg.RemoveConversationsFromLabel))
+	js.Global().Set("getConversationLabels", js.FuncOf(wrapperConMsg.GetConversationLabels))
+	js.Global().Set("getConversationListByLabel", js.FuncOf(wrapperConMsg.GetConversationListByLabel))
 	js.Global().Set("getOneConversation", js.FuncOf(wrapperConMsg.GetOneConversation))
 	js.Global().Set("deleteConversationAndDeleteAllMsg", js.FuncOf(wrapperConMsg.DeleteConversationAndDeleteAllMsg))
 	js.Global().Set("getAdvancedHistoryMessageList", js.FuncOf(wrapperConMsg.GetAdvancedHistoryMessageList))
+	js.Global().Set("getThreadMessages", js.FuncOf(wrapperConMsg.GetThreadMessages))
 	js.Global().Set("getAdvancedHistoryMessageListReverse", js.FuncOf(wrapperConMsg.GetAdvancedHistoryMessageListReverse))
 	js.Global().Set("getMultipleConversation", js.FuncOf(wrapperConMsg.GetMultipleConversation))
 	js.Global().Set("hideConversation", js.FuncOf(wrapperConMsg.HideConversation))
@@ -95,6 +104,20 @@ func registerFunc() {
 	js.Global().Set("findMessageList", js.FuncOf(wrapperConMsg.FindMessageList))
 
 	js.Global().Set("revokeMessage", js.FuncOf(wrapperConMsg.RevokeMessage))
+	js.Global().Set("editMessage", js.FuncOf(wrapperConMsg.EditMessage))
+	js.Global().Set("addMessageReaction", js.FuncOf(wrapperConMsg.AddMessageReaction))
+	js.Global().Set("removeMessageReaction", js.FuncOf(wrapperConMsg.RemoveMessageReaction))
+	js.Global().Set("getMessageReactions", js.FuncOf(wrapperConMsg.GetMessageReactions))
+	js.Global().Set("pinMessage", js.FuncOf(wrapperConMsg.PinMessage))
+	js.Global().Set("unpinMessage", js.FuncOf(wrapperConMsg.UnpinMessage))
+	js.Global().Set("getPinnedMessages", js.FuncOf(wrapperConMsg.GetPinnedMessages))
+	js.Global().Set("getMentionMessages", js.FuncOf(wrapperConMsg.GetMentionMessages))
+	js.Global().Set("markMentionsRead", js.FuncOf(wrapperConMsg.MarkMentionsRead))
+	js.Global().Set("getUnreadMentionCount", js.FuncOf(wrapperConMsg.GetUnreadMentionCount))
+	js.Global().Set("getGroupMessageReaderList", js.FuncOf(wrapperConMsg.GetGroupMessageReaderList))
+	js.Global().Set("scheduleMessage", js.FuncOf(wrapperConMsg.ScheduleMessage))
+	js.Global().Set("cancelScheduledMessage", js.FuncOf(wrapperConMsg.CancelScheduledMessage))
+	js.Global().Set("getScheduledMessages", js.FuncOf(wrapperConMsg.GetScheduledMessages))
 	js.Global().Set("typingStatusUpdate", js.FuncOf(wrapperConMsg.TypingStatusUpdate))
 	js.Global().Set("deleteMessageFromLocalStorage", js.FuncOf(wrapperConMsg.DeleteMessageFromLocalStorage))
 	js.Global().Set("deleteMessage", js.FuncOf(wrapperConMsg.DeleteMessage))
@@ -58,6 +58,12 @@ func (i *ConnCallback) OnUserTokenExpired() {
 func (i *ConnCallback) OnUserTokenInvalid(errMsg string) {
 	i.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(errMsg).SendMessage()
 }
+func (i *ConnCallback) OnReconnecting(attempt int32, delayMs int64) {
+	m := make(map[string]interface{})
+	m["attempt"] = attempt
+	m["delayMs"] = delayMs
+	i.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(utils.StructToJsonString(m)).SendMessage()
+}
 func (i *ConnCallback) OnUserCommandAdd(userInfo string) {
 	i.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(userInfo).SendMessage()
 }
@@ -110,6 +116,10 @@ func (c ConversationCallback) OnConversationUserInputStatusChanged(change string
 	c.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(change).SendMessage()
 }
 
+func (c ConversationCallback) OnUnreadMentionCountChanged(totalUnreadCount int32) {
+	c.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(totalUnreadCount).SendMessage()
+}
+
 type AdvancedMsgCallback struct {
 	CallbackWriter
 }
@@ -174,6 +184,30 @@ func (a AdvancedMsgCallback) OnMsgEdited(message string) {
 	a.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(message).SendMessage()
 }
 
+func (a AdvancedMsgCallback) OnMsgPinChanged(pinChange string) {
+	a.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(pinChange).SendMessage()
+}
+
+func (a AdvancedMsgCallback) OnScheduledMessageSent(message string) {
+	a.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(message).SendMessage()
+}
+
+func (a AdvancedMsgCallback) OnScheduledMessageFailed(message string, errCode int32, errMsg string) {
+	m := make(map[string]interface{})
+	m["message"] = message
+	m["errCode"] = errCode
+	m["errMsg"] = errMsg
+	a.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(utils.StructToJsonString(m)).SendMessage()
+}
+
+func (a AdvancedMsgCallback) OnOutboxMessageStatusChanged(message string, status int32, attempts int32) {
+	m := make(map[string]interface{})
+	m["message"] = message
+	m["status"] = status
+	m["attempts"] = attempts
+	a.CallbackWriter.SetEvent(utils.GetSelfFuncName()).SetData(utils.StructToJsonString(m)).SendMessage()
+}
+
 type BaseCallback struct {
 	CallbackWriter
 }
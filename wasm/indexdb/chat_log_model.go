@@ -705,3 +705,33 @@ func (i *LocalChatLogs) DeleteConversationMsgsBySeqs(ctx context.Context, conver
 	_, err := exec.Exec(conversationID, utils.StructToJsonString(seqs))
 	return err
 }
+
+// GetThreadMessages gets the replies to a thread root after the one sent at startTime as startClientMsgID
+func (i *LocalChatLogs) GetThreadMessages(ctx context.Context, conversationID, rootClientMsgID string, startTime int64, startClientMsgID string, count int) (result []*model_struct.LocalChatLog, err error) {
+	msgs, err := exec.Exec(conversationID, rootClientMsgID, startTime, startClientMsgID, count)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := msgs.(string); ok {
+		if err := utils.JsonStringToStruct(v, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	return nil, exec.ErrType
+}
+
+// GetThreadSummaries gets the reply counts and latest replies of thread roots
+func (i *LocalChatLogs) GetThreadSummaries(ctx context.Context, conversationID string, rootClientMsgIDs []string) (result []*model_struct.LocalThreadSummary, err error) {
+	summaries, err := exec.Exec(conversationID, utils.StructToJsonString(rootClientMsgIDs))
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := summaries.(string); ok {
+		if err := utils.JsonStringToStruct(v, &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	return nil, exec.ErrType
+}
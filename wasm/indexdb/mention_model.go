@@ -0,0 +1,108 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build js && wasm
+// +build js,wasm
+
+package indexdb
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/wasm/exec"
+)
+
+type LocalMentions struct {
+}
+
+func NewLocalMentions() *LocalMentions {
+	return &LocalMentions{}
+}
+
+func (i *LocalMentions) InsertMentions(ctx context.Context, mentions []*model_struct.LocalMention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+	_, err := exec.Exec(utils.StructToJsonString(mentions))
+	return err
+}
+
+func (i *LocalMentions) GetMention(ctx context.Context, conversationID, clientMsgID string) (*model_struct.LocalMention, error) {
+	mention, err := exec.Exec(conversationID, clientMsgID)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := mention.(string); ok {
+		result := model_struct.LocalMention{}
+		if err := utils.JsonStringToStruct(v, &result); err != nil {
+			return nil, err
+		}
+		return &result, nil
+	}
+	return nil, exec.ErrType
+}
+
+func (i *LocalMentions) GetMentions(ctx context.Context, startTime int64, startConversationID, startClientMsgID string, unreadOnly bool, count int) (result []*model_struct.LocalMention, err error) {
+	list, err := exec.Exec(startTime, startConversationID, startClientMsgID, unreadOnly, count)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := list.(string)
+	if !ok {
+		return nil, exec.ErrType
+	}
+	var temp []model_struct.LocalMention
+	if err := utils.JsonStringToStruct(v, &temp); err != nil {
+		return nil, err
+	}
+	for _, v := range temp {
+		v1 := v
+		result = append(result, &v1)
+	}
+	return result, nil
+}
+
+func (i *LocalMentions) MarkMentionsRead(ctx context.Context, conversationID string, clientMsgIDs []string) (int64, error) {
+	rows, err := exec.Exec(conversationID, utils.StructToJsonString(clientMsgIDs))
+	if err != nil {
+		return 0, err
+	}
+	if v, ok := rows.(float64); ok {
+		return int64(v), nil
+	}
+	return 0, exec.ErrType
+}
+
+func (i *LocalMentions) GetUnreadMentionCount(ctx context.Context) (int32, error) {
+	count, err := exec.Exec()
+	if err != nil {
+		return 0, err
+	}
+	if v, ok := count.(float64); ok {
+		return int32(v), nil
+	}
+	return 0, exec.ErrType
+}
+
+func (i *LocalMentions) DeleteMentions(ctx context.Context, conversationID string, clientMsgIDs []string) error {
+	_, err := exec.Exec(conversationID, utils.StructToJsonString(clientMsgIDs))
+	return err
+}
+
+func (i *LocalMentions) DeleteConversationMentions(ctx context.Context, conversationID string) error {
+	_, err := exec.Exec(conversationID)
+	return err
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build js && wasm
+// +build js,wasm
+
+package indexdb
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/wasm/exec"
+)
+
+type LocalOutboxMessages struct {
+}
+
+func NewLocalOutboxMessages() *LocalOutboxMessages {
+	return &LocalOutboxMessages{}
+}
+
+func (i *LocalOutboxMessages) InsertOutboxMessage(ctx context.Context, message *model_struct.LocalOutboxMessage) error {
+	_, err := exec.Exec(utils.StructToJsonString(message))
+	return err
+}
+
+func (i *LocalOutboxMessages) UpdateOutboxMessage(ctx context.Context, message *model_struct.LocalOutboxMessage) error {
+	_, err := exec.Exec(utils.StructToJsonString(message))
+	return err
+}
+
+func (i *LocalOutboxMessages) DeleteOutboxMessage(ctx context.Context, clientMsgID string) error {
+	_, err := exec.Exec(clientMsgID)
+	return err
+}
+
+func (i *LocalOutboxMessages) GetOutboxMessage(ctx context.Context, clientMsgID string) (*model_struct.LocalOutboxMessage, error) {
+	message, err := exec.Exec(clientMsgID)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := message.(string); ok {
+		result := model_struct.LocalOutboxMessage{}
+		if err := utils.JsonStringToStruct(v, &result); err != nil {
+			return nil, err
+		}
+		return &result, nil
+	}
+	return nil, exec.ErrType
+}
+
+func (i *LocalOutboxMessages) GetAllOutboxMessages(ctx context.Context) ([]*model_struct.LocalOutboxMessage, error) {
+	return outboxMessages(exec.Exec())
+}
+
+func (i *LocalOutboxMessages) GetConversationOutboxMessages(ctx context.Context, conversationID string) ([]*model_struct.LocalOutboxMessage, error) {
+	return outboxMessages(exec.Exec(conversationID))
+}
+
+func outboxMessages(list any, err error) (result []*model_struct.LocalOutboxMessage, _ error) {
+	if err != nil {
+		return nil, err
+	}
+	v, ok := list.(string)
+	if !ok {
+		return nil, exec.ErrType
+	}
+	var temp []model_struct.LocalOutboxMessage
+	if err := utils.JsonStringToStruct(v, &temp); err != nil {
+		return nil, err
+	}
+	for _, v := range temp {
+		v1 := v
+		result = append(result, &v1)
+	}
+	return result, nil
+}
@@ -0,0 +1,83 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build js && wasm
+// +build js,wasm
+
+package indexdb
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/wasm/exec"
+)
+
+type LocalPinnedMessages struct {
+}
+
+func NewLocalPinnedMessages() *LocalPinnedMessages {
+	return &LocalPinnedMessages{}
+}
+
+func (i *LocalPinnedMessages) SetPinnedMessage(ctx context.Context, pinned *model_struct.LocalPinnedMessage) error {
+	_, err := exec.Exec(utils.StructToJsonString(pinned))
+	return err
+}
+
+func (i *LocalPinnedMessages) GetPinnedMessage(ctx context.Context, conversationID, clientMsgID string) (*model_struct.LocalPinnedMessage, error) {
+	pinned, err := exec.Exec(conversationID, clientMsgID)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := pinned.(string); ok {
+		result := model_struct.LocalPinnedMessage{}
+		if err := utils.JsonStringToStruct(v, &result); err != nil {
+			return nil, err
+		}
+		return &result, nil
+	}
+	return nil, exec.ErrType
+}
+
+func (i *LocalPinnedMessages) GetPinnedMessages(ctx context.Context, conversationID string) (result []*model_struct.LocalPinnedMessage, err error) {
+	list, err := exec.Exec(conversationID)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := list.(string)
+	if !ok {
+		return nil, exec.ErrType
+	}
+	var temp []model_struct.LocalPinnedMessage
+	if err := utils.JsonStringToStruct(v, &temp); err != nil {
+		return nil, err
+	}
+	for _, v := range temp {
+		v1 := v
+		result = append(result, &v1)
+	}
+	return result, nil
+}
+
+func (i *LocalPinnedMessages) DeletePinnedMessages(ctx context.Context, conversationID string, clientMsgIDs []string) error {
+	_, err := exec.Exec(conversationID, utils.StructToJsonString(clientMsgIDs))
+	return err
+}
+
+func (i *LocalPinnedMessages) DeleteConversationPinnedMessages(ctx context.Context, conversationID string) error {
+	_, err := exec.Exec(conversationID)
+	return err
+}
@@ -0,0 +1,58 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build js && wasm
+// +build js,wasm
+
+package indexdb
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/wasm/exec"
+)
+
+type LocalChatLogReactionExtensions struct {
+}
+
+func NewLocalChatLogReactionExtensions() *LocalChatLogReactionExtensions {
+	return &LocalChatLogReactionExtensions{}
+}
+
+func (i *LocalChatLogReactionExtensions) GetMessageReactionExtensions(ctx context.Context, clientMsgIDs []string) (result []*model_struct.LocalChatLogReactionExtensions, err error) {
+	list, err := exec.Exec(utils.StructToJsonString(clientMsgIDs))
+	if err != nil {
+		return nil, err
+	}
+	v, ok := list.(string)
+	if !ok {
+		return nil, exec.ErrType
+	}
+	var temp []model_struct.LocalChatLogReactionExtensions
+	if err := utils.JsonStringToStruct(v, &temp); err != nil {
+		return nil, err
+	}
+	for _, v := range temp {
+		v1 := v
+		result = append(result, &v1)
+	}
+	return result, nil
+}
+
+func (i *LocalChatLogReactionExtensions) SetMessageReactionExtension(ctx context.Context, extension *model_struct.LocalChatLogReactionExtensions) error {
+	_, err := exec.Exec(utils.StructToJsonString(extension))
+	return err
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 OpenIM SDK. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build js && wasm
+// +build js,wasm
+
+package indexdb
+
+import (
+	"context"
+
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/db/model_struct"
+	"github.com/openimsdk/openim-sdk-core/v3/pkg/utils"
+	"github.com/openimsdk/openim-sdk-core/v3/wasm/exec"
+)
+
+type LocalScheduledMessages struct {
+}
+
+func NewLocalScheduledMessages() *LocalScheduledMessages {
+	return &LocalScheduledMessages{}
+}
+
+func (i *LocalScheduledMessages) InsertScheduledMessage(ctx context.Context, message *model_struct.LocalScheduledMessage) error {
+	_, err := exec.Exec(utils.StructToJsonString(message))
+	return err
+}
+
+func (i *LocalScheduledMessages) UpdateScheduledMessage(ctx context.Context, message *model_struct.LocalScheduledMessage) error {
+	_, err := exec.Exec(utils.StructToJsonString(message))
+	return err
+}
+
+func (i *LocalScheduledMessages) DeleteScheduledMessage(ctx context.Context, clientMsgID string) error {
+	_, err := exec.Exec(clientMsgID)
+	return err
+}
+
+func (i *LocalScheduledMessages) GetScheduledMessage(ctx context.Context, clientMsgID string) (*model_struct.LocalScheduledMessage, error) {
+	message, err := exec.Exec(clientMsgID)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := message.(string); ok {
+		result := model_struct.LocalScheduledMessage{}
+		if err := utils.JsonStringToStruct(v, &result); err != nil {
+			return nil, err
+		}
+		return &result, nil
+	}
+	return nil, exec.ErrType
+}
+
+func (i *LocalScheduledMessages) GetAllScheduledMessages(ctx context.Context) ([]*model_struct.LocalScheduledMessage, error) {
+	return scheduledMessages(exec.Exec())
+}
+
+func (i *LocalScheduledMessages) GetDueScheduledMessages(ctx context.Context, sendAt int64) ([]*model_struct.LocalScheduledMessage, error) {
+	return scheduledMessages(exec.Exec(sendAt))
+}
+
+func scheduledMessages(list any, err error) (result []*model_struct.LocalScheduledMessage, _ error) {
+	if err != nil {
+		return nil, err
+	}
+	v, ok := list.(string)
+	if !ok {
+		return nil, exec.ErrType
+	}
+	var temp []model_struct.LocalScheduledMessage
+	if err := utils.JsonStringToStruct(v, &temp); err != nil {
+		return nil, err
+	}
+	for _, v := range temp {
+		v1 := v
+		result = append(result, &v1)
+	}
+	return result, nil
+}
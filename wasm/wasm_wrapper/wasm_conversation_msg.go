@@ -144,6 +144,46 @@ func (w *WrapperConMsg) GetConversationListSplit(_ js.Value, args []js.Value) in
 	return event_listener.NewCaller(open_im_sdk.GetConversationListSplit, callback, &args).AsyncCallWithCallback()
 }
 
+func (w *WrapperConMsg) CreateConversationLabel(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.CreateConversationLabel, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) RenameConversationLabel(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.RenameConversationLabel, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) DeleteConversationLabel(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.DeleteConversationLabel, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) ReorderConversationLabels(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.ReorderConversationLabels, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) AddConversationsToLabel(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.AddConversationsToLabel, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) RemoveConversationsFromLabel(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.RemoveConversationsFromLabel, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) GetConversationLabels(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetConversationLabels, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) GetConversationListByLabel(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetConversationListByLabel, callback, &args).AsyncCallWithCallback()
+}
+
 func (w *WrapperConMsg) GetOneConversation(_ js.Value, args []js.Value) interface{} {
 	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
 	return event_listener.NewCaller(open_im_sdk.GetOneConversation, callback, &args).AsyncCallWithCallback()
@@ -154,6 +194,11 @@ func (w *WrapperConMsg) GetAdvancedHistoryMessageList(_ js.Value, args []js.Valu
 	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
 	return event_listener.NewCaller(open_im_sdk.GetAdvancedHistoryMessageList, callback, &args).AsyncCallWithCallback()
 }
+
+func (w *WrapperConMsg) GetThreadMessages(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetThreadMessages, callback, &args).AsyncCallWithCallback()
+}
 func (w *WrapperConMsg) GetAdvancedHistoryMessageListReverse(_ js.Value, args []js.Value) interface{} {
 	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
 	return event_listener.NewCaller(open_im_sdk.GetAdvancedHistoryMessageListReverse, callback, &args).AsyncCallWithCallback()
@@ -179,6 +224,76 @@ func (w *WrapperConMsg) RevokeMessage(_ js.Value, args []js.Value) interface{} {
 	return event_listener.NewCaller(open_im_sdk.RevokeMessage, callback, &args).AsyncCallWithCallback()
 }
 
+func (w *WrapperConMsg) EditMessage(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.EditMessage, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) AddMessageReaction(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.AddMessageReaction, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) RemoveMessageReaction(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.RemoveMessageReaction, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) GetMessageReactions(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetMessageReactions, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) PinMessage(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.PinMessage, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) UnpinMessage(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.UnpinMessage, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) GetPinnedMessages(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetPinnedMessages, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) GetMentionMessages(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetMentionMessages, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) MarkMentionsRead(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.MarkMentionsRead, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) GetUnreadMentionCount(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetUnreadMentionCount, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) GetGroupMessageReaderList(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetGroupMessageReaderList, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) ScheduleMessage(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.ScheduleMessage, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) CancelScheduledMessage(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.CancelScheduledMessage, callback, &args).AsyncCallWithCallback()
+}
+
+func (w *WrapperConMsg) GetScheduledMessages(_ js.Value, args []js.Value) interface{} {
+	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
+	return event_listener.NewCaller(open_im_sdk.GetScheduledMessages, callback, &args).AsyncCallWithCallback()
+}
+
 func (w *WrapperConMsg) TypingStatusUpdate(_ js.Value, args []js.Value) interface{} {
 	callback := event_listener.NewBaseCallback(utils.FirstLower(utils.GetSelfFuncName()), w.commonFunc)
 	return event_listener.NewCaller(open_im_sdk.TypingStatusUpdate, callback, &args).AsyncCallWithCallback()